@@ -283,6 +283,9 @@ func writeToolDoc(buf *strings.Builder, tool inventory.ServerTool) {
 				} else {
 					typeStr = "array"
 				}
+			case "":
+				// Nullable parameters list their types instead, e.g. "string or null".
+				typeStr = strings.Join(prop.Types, " or ")
 			default:
 				typeStr = prop.Type
 			}
@@ -85,6 +85,19 @@ var (
 				}
 			}
 
+			// Parse repository allow/deny patterns (similar to tools)
+			var allowedRepos, deniedRepos []string
+			if viper.IsSet("allowed_repos") {
+				if err := viper.UnmarshalKey("allowed_repos", &allowedRepos); err != nil {
+					return fmt.Errorf("failed to unmarshal allowed-repos: %w", err)
+				}
+			}
+			if viper.IsSet("denied_repos") {
+				if err := viper.UnmarshalKey("denied_repos", &deniedRepos); err != nil {
+					return fmt.Errorf("failed to unmarshal denied-repos: %w", err)
+				}
+			}
+
 			// Parse enabled features (similar to toolsets)
 			var enabledFeatures []string
 			if viper.IsSet("features") {
@@ -106,7 +119,13 @@ var (
 				EnableCommandLogging: viper.GetBool("enable-command-logging"),
 				LogFilePath:          viper.GetString("log-file"),
 				ContentWindowSize:    viper.GetInt("content-window-size"),
+				DefaultPageSize:      viper.GetInt("default-page-size"),
+				MaxResponseBytes:     viper.GetInt("max-response-bytes"),
+				AllowedRepos:         allowedRepos,
+				DeniedRepos:          deniedRepos,
 				LockdownMode:         viper.GetBool("lockdown-mode"),
+				EnableMetricsTool:    viper.GetBool("enable-metrics-tool"),
+				VerboseErrors:        viper.GetBool("verbose-errors"),
 				InsidersMode:         viper.GetBool("insiders"),
 				ExcludeTools:         excludeTools,
 				RepoAccessCacheTTL:   &ttl,
@@ -165,6 +184,18 @@ var (
 				}
 			}
 
+			var allowedRepos, deniedRepos []string
+			if viper.IsSet("allowed_repos") {
+				if err := viper.UnmarshalKey("allowed_repos", &allowedRepos); err != nil {
+					return fmt.Errorf("failed to unmarshal allowed-repos: %w", err)
+				}
+			}
+			if viper.IsSet("denied_repos") {
+				if err := viper.UnmarshalKey("denied_repos", &deniedRepos); err != nil {
+					return fmt.Errorf("failed to unmarshal denied-repos: %w", err)
+				}
+			}
+
 			var enabledFeatures []string
 			if viper.IsSet("features") {
 				if err := viper.UnmarshalKey("features", &enabledFeatures); err != nil {
@@ -184,6 +215,10 @@ var (
 				EnableCommandLogging: viper.GetBool("enable-command-logging"),
 				LogFilePath:          viper.GetString("log-file"),
 				ContentWindowSize:    viper.GetInt("content-window-size"),
+				DefaultPageSize:      viper.GetInt("default-page-size"),
+				MaxResponseBytes:     viper.GetInt("max-response-bytes"),
+				AllowedRepos:         allowedRepos,
+				DeniedRepos:          deniedRepos,
 				LockdownMode:         viper.GetBool("lockdown-mode"),
 				RepoAccessCacheTTL:   &ttl,
 				ScopeChallenge:       viper.GetBool("scope-challenge"),
@@ -218,6 +253,10 @@ func init() {
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
 	rootCmd.PersistentFlags().String("gh-host", "", "Specify the GitHub hostname (for GitHub Enterprise etc.)")
 	rootCmd.PersistentFlags().Int("content-window-size", 5000, "Specify the content window size")
+	rootCmd.PersistentFlags().Int("default-page-size", 0, "Default page size for list tools when perPage is omitted (0 keeps each tool's own default)")
+	rootCmd.PersistentFlags().Int("max-response-bytes", 0, "Largest serialized result list_issues, search_issues and list_project_items return before replacing it with a truncation notice (0 uses the 1 MiB default)")
+	rootCmd.PersistentFlags().StringSlice("allowed-repos", nil, "Comma-separated owner/repo patterns (e.g. octo-org/*) tools may operate on; other repositories are refused")
+	rootCmd.PersistentFlags().StringSlice("denied-repos", nil, "Comma-separated owner/repo patterns (e.g. octo-org/secret-*) tools may never operate on")
 	rootCmd.PersistentFlags().Bool("lockdown-mode", false, "Enable lockdown mode")
 	rootCmd.PersistentFlags().Bool("insiders", false, "Enable insiders features")
 	rootCmd.PersistentFlags().Duration("repo-access-cache-ttl", 5*time.Minute, "Override the repo access cache TTL (e.g. 1m, 0s to disable)")
@@ -228,6 +267,8 @@ func init() {
 	stdioCmd.Flags().String("oauth-client-id", "", "OAuth App or GitHub App client ID, enabling interactive OAuth login when no token is set")
 	stdioCmd.Flags().String("oauth-client-secret", "", "OAuth client secret, if the app requires one (it is a public, non-confidential credential for distributed clients)")
 	stdioCmd.Flags().StringSlice("oauth-scopes", nil, "Comma-separated OAuth scopes to request; also filters tools to those scopes. Defaults to the full supported set")
+	stdioCmd.Flags().Bool("enable-metrics-tool", false, "Register the get_server_metrics debug tool, which reports per-tool call counts, errors and durations")
+	stdioCmd.Flags().Bool("verbose-errors", false, "Include the path, query locations and extensions of each GraphQL error in tool error results, for debugging")
 	stdioCmd.Flags().Int("oauth-callback-port", 0, "Fixed local port for the OAuth callback server. Defaults to a random port; set a fixed port when mapping it through Docker")
 
 	// HTTP-specific flags
@@ -249,6 +290,10 @@ func init() {
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
 	_ = viper.BindPFlag("host", rootCmd.PersistentFlags().Lookup("gh-host"))
 	_ = viper.BindPFlag("content-window-size", rootCmd.PersistentFlags().Lookup("content-window-size"))
+	_ = viper.BindPFlag("default-page-size", rootCmd.PersistentFlags().Lookup("default-page-size"))
+	_ = viper.BindPFlag("max-response-bytes", rootCmd.PersistentFlags().Lookup("max-response-bytes"))
+	_ = viper.BindPFlag("allowed_repos", rootCmd.PersistentFlags().Lookup("allowed-repos"))
+	_ = viper.BindPFlag("denied_repos", rootCmd.PersistentFlags().Lookup("denied-repos"))
 	_ = viper.BindPFlag("lockdown-mode", rootCmd.PersistentFlags().Lookup("lockdown-mode"))
 	_ = viper.BindPFlag("insiders", rootCmd.PersistentFlags().Lookup("insiders"))
 	_ = viper.BindPFlag("repo-access-cache-ttl", rootCmd.PersistentFlags().Lookup("repo-access-cache-ttl"))
@@ -256,6 +301,8 @@ func init() {
 	_ = viper.BindPFlag("oauth-client-secret", stdioCmd.Flags().Lookup("oauth-client-secret"))
 	_ = viper.BindPFlag("oauth-scopes", stdioCmd.Flags().Lookup("oauth-scopes"))
 	_ = viper.BindPFlag("oauth-callback-port", stdioCmd.Flags().Lookup("oauth-callback-port"))
+	_ = viper.BindPFlag("enable-metrics-tool", stdioCmd.Flags().Lookup("enable-metrics-tool"))
+	_ = viper.BindPFlag("verbose-errors", stdioCmd.Flags().Lookup("verbose-errors"))
 	_ = viper.BindPFlag("port", httpCmd.Flags().Lookup("port"))
 	_ = viper.BindPFlag("listen-host", httpCmd.Flags().Lookup("listen-host"))
 	_ = viper.BindPFlag("base-url", httpCmd.Flags().Lookup("base-url"))
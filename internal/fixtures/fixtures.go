@@ -0,0 +1,159 @@
+// Package fixtures records GitHub API traffic to JSON files and replays it in
+// tests, so tool handlers can be tested against real responses without
+// building go-github or githubv4mock fixtures by hand.
+//
+// Tests obtain a transport with NewTransport and build their REST and GraphQL
+// clients on top of it. By default the transport replays the interactions
+// stored in __fixtures__/<name>.json. When RECORD_FIXTURES is "true" it instead
+// forwards requests to the GitHub API using GITHUB_PERSONAL_ACCESS_TOKEN and
+// rewrites the fixture file when the test finishes.
+//
+// Recording redacts sensitive headers but stores response bodies as they are,
+// so fixtures should only be recorded against public repositories. Requests go
+// to whichever host the test's clients are built for, which for tests using
+// the default clients is api.github.com.
+package fixtures
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/http/transport"
+)
+
+// Dir is the directory, relative to the test's package, that holds fixtures.
+const Dir = "__fixtures__"
+
+// Fixture is the on-disk format of a recording: the interactions in the order
+// they were made.
+type Fixture struct {
+	// Comment describes where the fixture came from, for example to mark a
+	// hand-written placeholder. Recording a fixture clears it.
+	Comment      string        `json:"comment,omitempty"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request and the response it received.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request. URL holds the path and query only, so a
+// fixture can be replayed against any API host.
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    Body        `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    Body        `json:"body,omitempty"`
+}
+
+// Body is a request or response body. JSON objects and arrays are stored
+// inline so fixtures stay readable and diffable; any other body is stored as
+// a string.
+type Body []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if len(b) == 0 {
+		return []byte("null"), nil
+	}
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		return trimmed, nil
+	}
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON string is always read
+// back as raw text, which round-trips bodies that were not JSON.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*b = nil
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*b = Body(s)
+		return nil
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+// Load reads a fixture file.
+func Load(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // fixture paths are controlled by the test suite.
+	if err != nil {
+		return nil, err
+	}
+	var f Fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %s: %w", path, err)
+	}
+	return &f, nil
+}
+
+// Save writes a fixture file, creating its directory if needed.
+func (f *Fixture) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal fixture: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+	return os.WriteFile(path, append(data, '\n'), 0600)
+}
+
+// NewTransport returns the transport a test should build its GitHub clients
+// with. It replays __fixtures__/<name>.json and fails the test if any recorded
+// interaction goes unused. If RECORD_FIXTURES is "true" it records real
+// traffic to that file instead.
+func NewTransport(t testing.TB, name string) http.RoundTripper {
+	t.Helper()
+	path := filepath.Join(Dir, name+".json")
+
+	if os.Getenv("RECORD_FIXTURES") == "true" {
+		token := os.Getenv("GITHUB_PERSONAL_ACCESS_TOKEN")
+		if token == "" {
+			t.Fatalf("recording fixture %s requires GITHUB_PERSONAL_ACCESS_TOKEN", name)
+		}
+		recorder := NewRecorder(&transport.BearerAuthTransport{
+			Transport: http.DefaultTransport,
+			Token:     token,
+		})
+		t.Cleanup(func() {
+			if err := recorder.Fixture().Save(path); err != nil {
+				t.Errorf("failed to save fixture %s: %v", name, err)
+			}
+		})
+		return recorder
+	}
+
+	fixture, err := Load(path)
+	if err != nil {
+		t.Fatalf("failed to load fixture %s (run the test with RECORD_FIXTURES=true to record it): %v", name, err)
+	}
+	replayer := NewReplayer(fixture)
+	t.Cleanup(func() {
+		for _, unused := range replayer.Unused() {
+			t.Errorf("fixture %s: recorded request %s %s was not made", name, unused.Method, unused.URL)
+		}
+	})
+	return replayer
+}
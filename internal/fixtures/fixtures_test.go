@@ -0,0 +1,89 @@
+package fixtures
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Set-Cookie", "session=secret")
+		switch r.URL.Path {
+		case "/graphql":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"data":{"viewer":{"login":"octocat"}}}`)
+		case "/repos/owner/repo/pulls/1":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = io.WriteString(w, "diff --git a/README.md b/README.md\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"message":"Not Found"}`)
+		}
+	}))
+	defer server.Close()
+
+	recorder := NewRecorder(http.DefaultTransport)
+	client := &http.Client{Transport: recorder}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/graphql", strings.NewReader(`{"query":"{viewer{login}}","variables":{"a":1,"b":2}}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer ghp_secret")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	assert.JSONEq(t, `{"data":{"viewer":{"login":"octocat"}}}`, string(body), "recording must not consume the response")
+
+	resp, err = client.Get(server.URL + "/repos/owner/repo/pulls/1?per_page=5&page=2")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	path := filepath.Join(t.TempDir(), Dir, "example.json")
+	require.NoError(t, recorder.Fixture().Save(path))
+	fixture, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, fixture.Interactions, 2)
+
+	graphql := fixture.Interactions[0]
+	assert.Equal(t, RedactedValue, graphql.Request.Headers.Get("Authorization"))
+	assert.Equal(t, RedactedValue, graphql.Response.Headers.Get("Set-Cookie"))
+	assert.Equal(t, "/graphql", graphql.Request.URL)
+	assert.Equal(t, "/repos/owner/repo/pulls/1?per_page=5&page=2", fixture.Interactions[1].Request.URL)
+
+	replayer := NewReplayer(fixture)
+	replayClient := &http.Client{Transport: replayer}
+
+	t.Run("matches JSON bodies by value and queries in any order", func(t *testing.T) {
+		resp, err := replayClient.Get("https://api.github.com/repos/owner/repo/pulls/1?page=2&per_page=5")
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		assert.Equal(t, "diff --git a/README.md b/README.md\n", string(body))
+		assert.Equal(t, "text/plain", resp.Header.Get("Content-Type"))
+
+		resp, err = replayClient.Post("https://api.github.com/graphql", "application/json", strings.NewReader(`{"variables":{"b":2,"a":1},"query":"{viewer{login}}"}`))
+		require.NoError(t, err)
+		body, _ = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.JSONEq(t, `{"data":{"viewer":{"login":"octocat"}}}`, string(body))
+		assert.Empty(t, replayer.Unused())
+	})
+
+	t.Run("each interaction is served once", func(t *testing.T) {
+		_, err := replayClient.Get("https://api.github.com/repos/owner/repo/pulls/1?page=2&per_page=5")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no recorded response for GET /repos/owner/repo/pulls/1")
+	})
+
+	t.Run("reports unused interactions", func(t *testing.T) {
+		assert.Len(t, NewReplayer(fixture).Unused(), 2)
+	})
+}
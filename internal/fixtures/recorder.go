@@ -0,0 +1,104 @@
+package fixtures
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// RedactedValue replaces the value of sensitive headers in recordings.
+const RedactedValue = "REDACTED"
+
+// sensitiveHeaders are never written to a fixture.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+	"X-GitHub-SSO",
+}
+
+// Recorder is an http.RoundTripper that forwards requests to Transport and
+// records each request and response pair.
+type Recorder struct {
+	Transport http.RoundTripper
+
+	mu       sync.Mutex
+	recorded []Interaction
+}
+
+// NewRecorder returns a Recorder that forwards requests to transport.
+func NewRecorder(transport http.RoundTripper) *Recorder {
+	return &Recorder{Transport: transport}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recorded = append(r.recorded, Interaction{
+		Request: Request{
+			Method:  req.Method,
+			URL:     req.URL.RequestURI(),
+			Headers: sanitizeHeaders(req.Header),
+			Body:    reqBody,
+		},
+		Response: Response{
+			Status:  resp.StatusCode,
+			Headers: sanitizeHeaders(resp.Header),
+			Body:    respBody,
+		},
+	})
+	return resp, nil
+}
+
+// Fixture returns the interactions recorded so far.
+func (r *Recorder) Fixture() *Fixture {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &Fixture{Interactions: append([]Interaction(nil), r.recorded...)}
+}
+
+// readBody drains *body and replaces it with a reader over the same bytes so
+// the caller can still consume it.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// sanitizeHeaders returns a copy of h with sensitive values redacted.
+func sanitizeHeaders(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	sanitized := h.Clone()
+	for _, name := range sensitiveHeaders {
+		if _, ok := sanitized[http.CanonicalHeaderKey(name)]; ok {
+			sanitized.Set(name, RedactedValue)
+		}
+	}
+	return sanitized
+}
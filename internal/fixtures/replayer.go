@@ -0,0 +1,111 @@
+package fixtures
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+)
+
+// Replayer is an http.RoundTripper that answers requests from a Fixture
+// without making network calls. A request matches an interaction when its
+// method, path, query parameters and body are the same; JSON bodies are
+// compared by value, so key order and whitespace do not matter. Each
+// interaction is served once, in recorded order, so repeated identical
+// requests replay successive responses.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewReplayer returns a Replayer serving the interactions in f.
+func NewReplayer(f *Fixture) *Replayer {
+	return &Replayer{
+		interactions: f.Interactions,
+		used:         make([]bool, len(f.Interactions)),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.interactions {
+		if r.used[i] || !matches(interaction.Request, req, body) {
+			continue
+		}
+		r.used[i] = true
+		recorded := interaction.Response
+		header := recorded.Headers.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+			StatusCode:    recorded.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("fixtures: no recorded response for %s %s with body %s", req.Method, req.URL.RequestURI(), body)
+}
+
+// Unused returns the recorded requests that have not been replayed.
+func (r *Replayer) Unused() []Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unused []Request
+	for i, interaction := range r.interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction.Request)
+		}
+	}
+	return unused
+}
+
+func matches(recorded Request, req *http.Request, body []byte) bool {
+	if recorded.Method != req.Method {
+		return false
+	}
+	recordedURL, err := url.ParseRequestURI(recorded.URL)
+	if err != nil || recordedURL.Path != req.URL.Path {
+		return false
+	}
+	if !reflect.DeepEqual(normalizeQuery(recordedURL.Query()), normalizeQuery(req.URL.Query())) {
+		return false
+	}
+	return bodiesEqual(recorded.Body, body)
+}
+
+func normalizeQuery(q url.Values) url.Values {
+	if len(q) == 0 {
+		return nil
+	}
+	return q
+}
+
+func bodiesEqual(a, b []byte) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	var av, bv any
+	if json.Unmarshal(a, &av) == nil && json.Unmarshal(b, &bv) == nil {
+		return reflect.DeepEqual(av, bv)
+	}
+	return bytes.Equal(a, b)
+}
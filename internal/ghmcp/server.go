@@ -95,7 +95,11 @@ func createGitHubClients(cfg github.MCPServerConfig, apiHost utils.APIHostResolv
 	gqlHTTPClient := &http.Client{
 		Transport: &transport.BearerAuthTransport{
 			Transport: &transport.GraphQLFeaturesTransport{
-				Transport: http.DefaultTransport,
+				Transport: &transport.GraphQLRateLimitTransport{
+					Transport: &transport.GraphQLErrorsTransport{
+						Transport: http.DefaultTransport,
+					},
+				},
 			},
 			Token:         cfg.Token,
 			TokenProvider: cfg.TokenProvider,
@@ -146,8 +150,18 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 	// Create feature checker — resolves explicit features + insiders expansion
 	featureChecker := createFeatureChecker(cfg.EnabledFeatures, cfg.InsidersMode)
 
-	// Create dependencies for tool handlers
-	obs, err := observability.NewExporters(cfg.Logger, metrics.NewNoopMetrics())
+	repoPolicy, err := github.NewRepoPolicy(cfg.AllowedRepos, cfg.DeniedRepos)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create dependencies for tool handlers. With the metrics tool enabled,
+	// tool call metrics go to the counters it reports.
+	var metricsSink metrics.Metrics = metrics.NewNoopMetrics()
+	if cfg.ToolMetrics != nil {
+		metricsSink = cfg.ToolMetrics
+	}
+	obs, err := observability.NewExporters(cfg.Logger, metricsSink)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create observability exporters: %w", err)
 	}
@@ -161,6 +175,9 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 			LockdownMode: cfg.LockdownMode,
 		},
 		cfg.ContentWindowSize,
+		cfg.DefaultPageSize,
+		cfg.MaxResponseBytes,
+		repoPolicy,
 		featureChecker,
 		obs,
 	)
@@ -232,9 +249,32 @@ type StdioServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// DefaultPageSize overrides the default page size of list tools when the
+	// caller omits perPage. Zero keeps each tool's own default.
+	DefaultPageSize int
+
+	// MaxResponseBytes caps the serialized size of large list and search
+	// results; bigger results are replaced by a notice suggesting pagination.
+	// Zero uses DefaultMaxResponseBytes.
+	MaxResponseBytes int
+
+	// AllowedRepos and DeniedRepos restrict the repositories tools may operate
+	// on, as owner/repo patterns where either part may be a glob (e.g.
+	// octo-org/*). Empty lists permit every repository the token can reach.
+	AllowedRepos []string
+	DeniedRepos  []string
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
+	// EnableMetricsTool registers the get_server_metrics debug tool, which
+	// reports per-tool invocation, error and duration counters.
+	EnableMetricsTool bool
+
+	// VerboseErrors includes the path, query locations and extensions of each
+	// GraphQL error in tool error results.
+	VerboseErrors bool
+
 	// InsidersMode expands to the curated set of feature flags enabled for insiders.
 	InsidersMode bool
 
@@ -318,6 +358,11 @@ func RunStdioServer(cfg StdioServerConfig) error {
 		tokenProvider = cfg.OAuthManager.AccessToken
 	}
 
+	var toolMetrics *github.ToolMetrics
+	if cfg.EnableMetricsTool {
+		toolMetrics = github.NewToolMetrics()
+	}
+
 	ghServer, err := NewStdioMCPServer(ctx, github.MCPServerConfig{
 		Version:           cfg.Version,
 		Host:              cfg.Host,
@@ -328,7 +373,13 @@ func RunStdioServer(cfg StdioServerConfig) error {
 		ReadOnly:          cfg.ReadOnly,
 		Translator:        t,
 		ContentWindowSize: cfg.ContentWindowSize,
+		DefaultPageSize:   cfg.DefaultPageSize,
+		MaxResponseBytes:  cfg.MaxResponseBytes,
+		AllowedRepos:      cfg.AllowedRepos,
+		DeniedRepos:       cfg.DeniedRepos,
 		LockdownMode:      cfg.LockdownMode,
+		ToolMetrics:       toolMetrics,
+		VerboseErrors:     cfg.VerboseErrors,
 		InsidersMode:      cfg.InsidersMode,
 		ExcludeTools:      cfg.ExcludeTools,
 		Logger:            logger,
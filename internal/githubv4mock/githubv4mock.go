@@ -90,6 +90,14 @@ func ErrorResponse(errorMsg string) GQLResponse {
 	}
 }
 
+// PartialResponse is the constructor for a mocked GraphQL response that carries
+// data alongside an error, as GraphQL does when only some fields failed.
+func PartialResponse(data map[string]any, errorMsg string) GQLResponse {
+	response := ErrorResponse(errorMsg)
+	response.Data = data
+	return response
+}
+
 // githubv4InputStructToMap converts a struct to a map[string]any, it uses JSON marshalling rather than reflection
 // to do so, because the json struct tags are used in the real implementation to produce the variable key names,
 // and we need to ensure that when variable matching occurs in the http handler, the keys correctly match.
@@ -2,9 +2,13 @@ package errors
 
 import (
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/github/github-mcp-server/pkg/utils"
@@ -178,27 +182,107 @@ func NewGitHubAPIErrorResponse(ctx context.Context, message string, resp *github
 
 	var abuseErr *github.AbuseRateLimitError
 	if stderrors.As(err, &abuseErr) {
+		var retryAfter time.Duration
 		if abuseErr.RetryAfter != nil {
-			retryAfter := abuseErr.RetryAfter.Round(time.Second)
-			if retryAfter > 0 {
-				return utils.NewToolResultError(fmt.Sprintf(
-					"%s: GitHub secondary rate limit exceeded. Retry after %v.",
-					message, retryAfter))
-			}
+			retryAfter = *abuseErr.RetryAfter
+		}
+		return newSecondaryRateLimitResponse(message, retryAfter)
+	}
+
+	// GitHub answers issue endpoints with 410 Gone when the repository has
+	// issues turned off; say so instead of surfacing a bare "410".
+	if resp != nil && resp.Response != nil && err != nil &&
+		resp.StatusCode == http.StatusGone && isIssuesDisabledMessage(err.Error()) {
+		return utils.NewToolResultError(fmt.Sprintf("%s: issues are disabled for this repository", message))
+	}
+
+	// Responses that bypassed go-github's classification, such as the
+	// synthetic errors built by NewGitHubAPIStatusErrorResponse.
+	if resp != nil && resp.Response != nil && err != nil &&
+		(resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests) &&
+		isSecondaryRateLimitMessage(err.Error()) {
+		return newSecondaryRateLimitResponse(message, ParseRetryAfter(resp.Header))
+	}
+
+	if resp != nil && err != nil {
+		if hint := insufficientPermissionMessage(resp.Response, err.Error()); hint != "" {
+			return utils.NewToolResultError(fmt.Sprintf("%s: %s", message, hint))
 		}
-		return utils.NewToolResultError(fmt.Sprintf(
-			"%s: GitHub secondary rate limit exceeded. Wait before retrying.", message))
 	}
 
 	return utils.NewToolResultErrorFromErr(message, err)
 }
 
+// defaultSecondaryRateLimitWait is suggested when GitHub reports a secondary
+// rate limit without a Retry-After header; GitHub asks clients to wait at
+// least a minute in that case.
+const defaultSecondaryRateLimitWait = time.Minute
+
+// SecondaryRateLimitError is the JSON payload of the tool error returned when
+// GitHub's abuse detection ("secondary rate limit") rejects a request.
+type SecondaryRateLimitError struct {
+	Error             string `json:"error"`
+	RetryAfterSeconds int    `json:"retry_after_seconds"`
+}
+
+// newSecondaryRateLimitResponse builds a tool error telling the caller how long
+// to back off. A non-positive retryAfter falls back to defaultSecondaryRateLimitWait.
+func newSecondaryRateLimitResponse(message string, retryAfter time.Duration) *mcp.CallToolResult {
+	if retryAfter <= 0 {
+		retryAfter = defaultSecondaryRateLimitWait
+	}
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	payload := SecondaryRateLimitError{
+		Error:             fmt.Sprintf("%s: secondary rate limit hit; wait %d seconds before retrying", message, seconds),
+		RetryAfterSeconds: seconds,
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return utils.NewToolResultError(payload.Error)
+	}
+	return utils.NewToolResultError(string(b))
+}
+
+// isSecondaryRateLimitMessage reports whether msg carries GitHub's secondary
+// rate limit wording.
+func isSecondaryRateLimitMessage(msg string) bool {
+	return strings.Contains(strings.ToLower(msg), "secondary rate limit")
+}
+
+// isIssuesDisabledMessage reports whether msg carries GitHub's wording for a
+// repository with issues turned off.
+func isIssuesDisabledMessage(msg string) bool {
+	return strings.Contains(strings.ToLower(msg), "issues are disabled")
+}
+
+// ParseRetryAfter reads a Retry-After header expressed in seconds, returning
+// zero when it is absent or malformed.
+func ParseRetryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
 // NewGitHubGraphQLErrorResponse returns an mcp.NewToolResultError and retains the error in the context for access via middleware
 func NewGitHubGraphQLErrorResponse(ctx context.Context, message string, err error) *mcp.CallToolResult {
 	graphQLErr := newGitHubGraphQLError(message, err)
 	if ctx != nil {
 		_, _ = addGitHubGraphQLErrorToContext(ctx, graphQLErr) // Explicitly ignore error for graceful handling
 	}
+	var rateLimitErr *GraphQLRateLimitError
+	if stderrors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter > 0 {
+		return newSecondaryRateLimitResponse(message, rateLimitErr.RetryAfter)
+	}
+	// Without a Retry-After recorded by the transport there are no response
+	// headers to read, so the wait falls back to the default.
+	if err != nil && isSecondaryRateLimitMessage(err.Error()) {
+		return newSecondaryRateLimitResponse(message, 0)
+	}
+	if result := newGraphQLErrorResult(ctx, message, err); result != nil {
+		return result
+	}
 	return utils.NewToolResultErrorFromErr(message, err)
 }
 
@@ -208,6 +292,11 @@ func NewGitHubRawAPIErrorResponse(ctx context.Context, message string, resp *htt
 	if ctx != nil {
 		_, _ = addRawAPIErrorToContext(ctx, rawErr) // Explicitly ignore error for graceful handling
 	}
+	if err != nil {
+		if hint := insufficientPermissionMessage(resp, err.Error()); hint != "" {
+			return utils.NewToolResultError(fmt.Sprintf("%s: %s", message, hint))
+		}
+	}
 	return utils.NewToolResultErrorFromErr(message, err)
 }
 
@@ -2,12 +2,15 @@ package errors
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/google/go-github/v89/github"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -528,9 +531,11 @@ func TestNewGitHubAPIErrorResponse_RateLimits(t *testing.T) {
 		// When we create an API error response for a secondary rate limit error
 		result := NewGitHubAPIErrorResponse(ctx, "create issue", resp, abuseErr)
 
-		// And the message should include the specific retry duration
+		// And the payload should include the specific retry duration
 		text := requireErrorText(t, result)
-		assert.Contains(t, text, "GitHub secondary rate limit exceeded. Retry after 47s.")
+		payload := requireSecondaryRateLimitPayload(t, text)
+		assert.Equal(t, "create issue: secondary rate limit hit; wait 47 seconds before retrying", payload.Error)
+		assert.Equal(t, 47, payload.RetryAfterSeconds)
 		assert.NotContains(t, text, "https://")
 		assert.NotContains(t, text, "403")
 
@@ -538,7 +543,7 @@ func TestNewGitHubAPIErrorResponse_RateLimits(t *testing.T) {
 		assertContextHasError(t, ctx, abuseErr)
 	})
 
-	t.Run("AbuseRateLimitError without RetryAfter produces clean message without wait time", func(t *testing.T) {
+	t.Run("AbuseRateLimitError without RetryAfter suggests the default wait", func(t *testing.T) {
 		// Given a context with GitHub error tracking enabled
 		ctx := ContextWithGitHubErrors(context.Background())
 
@@ -552,9 +557,11 @@ func TestNewGitHubAPIErrorResponse_RateLimits(t *testing.T) {
 		// When we create an API error response for a secondary rate limit error without retry info
 		result := NewGitHubAPIErrorResponse(ctx, "create issue", resp, abuseErr)
 
-		// And the message should be clean and actionable
+		// And the payload should fall back to waiting a minute
 		text := requireErrorText(t, result)
-		assert.Contains(t, text, "GitHub secondary rate limit exceeded. Wait before retrying.")
+		payload := requireSecondaryRateLimitPayload(t, text)
+		assert.Equal(t, "create issue: secondary rate limit hit; wait 60 seconds before retrying", payload.Error)
+		assert.Equal(t, 60, payload.RetryAfterSeconds)
 		assert.NotContains(t, text, "https://")
 		assert.NotContains(t, text, "403")
 
@@ -562,10 +569,10 @@ func TestNewGitHubAPIErrorResponse_RateLimits(t *testing.T) {
 		assertContextHasError(t, ctx, abuseErr)
 	})
 
-	t.Run("AbuseRateLimitError with sub-second RetryAfter falls back to wait message", func(t *testing.T) {
+	t.Run("AbuseRateLimitError with sub-second RetryAfter rounds up to a full second", func(t *testing.T) {
 		ctx := ContextWithGitHubErrors(context.Background())
 
-		// 200ms rounds to 0s, so should fall back to the generic wait message
+		// 200ms must not be reported as zero, or callers would retry immediately
 		retryAfter := 200 * time.Millisecond
 		abuseErr := &github.AbuseRateLimitError{
 			Response:   &http.Response{StatusCode: 403},
@@ -576,8 +583,8 @@ func TestNewGitHubAPIErrorResponse_RateLimits(t *testing.T) {
 
 		result := NewGitHubAPIErrorResponse(ctx, "create issue", resp, abuseErr)
 
-		text := requireErrorText(t, result)
-		assert.Contains(t, text, "GitHub secondary rate limit exceeded. Wait before retrying.")
+		payload := requireSecondaryRateLimitPayload(t, requireErrorText(t, result))
+		assert.Equal(t, 1, payload.RetryAfterSeconds)
 	})
 
 	t.Run("RateLimitError with reset time in the past falls back to wait message", func(t *testing.T) {
@@ -668,10 +675,38 @@ func TestNewGitHubAPIErrorResponse_RateLimits(t *testing.T) {
 		result := NewGitHubAPIErrorResponse(ctx, "create issue", resp, wrappedErr)
 
 		text := requireErrorText(t, result)
-		assert.Contains(t, text, "GitHub secondary rate limit exceeded. Retry after 30s.")
+		payload := requireSecondaryRateLimitPayload(t, text)
+		assert.Equal(t, 30, payload.RetryAfterSeconds)
 		assert.NotContains(t, text, "https://")
 	})
 
+	t.Run("403 status response with secondary rate limit body uses Retry-After header", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		resp := &github.Response{Response: &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     http.Header{"Retry-After": []string{"12"}},
+		}}
+		body := []byte(`{"message":"You have exceeded a secondary rate limit and have been temporarily blocked from content creation."}`)
+
+		result := NewGitHubAPIStatusErrorResponse(ctx, "failed to create issue", resp, body)
+
+		payload := requireSecondaryRateLimitPayload(t, requireErrorText(t, result))
+		assert.Equal(t, "failed to create issue: secondary rate limit hit; wait 12 seconds before retrying", payload.Error)
+		assert.Equal(t, 12, payload.RetryAfterSeconds)
+	})
+
+	t.Run("403 without secondary rate limit wording passes through", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}
+		result := NewGitHubAPIErrorResponse(ctx, "failed to create issue", resp, fmt.Errorf("Protected branch update failed"))
+
+		text := requireErrorText(t, result)
+		assert.Contains(t, text, "Protected branch update failed")
+		assert.NotContains(t, text, "retry_after_seconds")
+	})
+
 	t.Run("non-rate-limit GitHub API error passes through the original error message", func(t *testing.T) {
 		// Given a context with GitHub error tracking enabled
 		ctx := ContextWithGitHubErrors(context.Background())
@@ -687,3 +722,291 @@ func TestNewGitHubAPIErrorResponse_RateLimits(t *testing.T) {
 		assert.Contains(t, text, "validation failed")
 	})
 }
+
+func TestNewGitHubAPIErrorResponse_IssuesDisabled(t *testing.T) {
+	t.Run("410 with issues disabled wording is classified", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusGone}}
+		body := []byte(`{"message":"Issues are disabled for this repo","documentation_url":"https://docs.github.com/v3/issues/"}`)
+
+		result := NewGitHubAPIStatusErrorResponse(ctx, "failed to create issue", resp, body)
+
+		text := requireErrorText(t, result)
+		assert.Equal(t, "failed to create issue: issues are disabled for this repository", text)
+		apiErrors, err := GetGitHubAPIErrors(ctx)
+		require.NoError(t, err)
+		require.Len(t, apiErrors, 1)
+	})
+
+	t.Run("410 for a deleted issue passes through", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusGone}}
+		result := NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, fmt.Errorf("This issue was deleted"))
+
+		text := requireErrorText(t, result)
+		assert.Contains(t, text, "This issue was deleted")
+		assert.NotContains(t, text, "issues are disabled")
+	})
+}
+
+func TestNewGitHubAPIErrorResponse_InsufficientPermissions(t *testing.T) {
+	newResponse := func(method, path string, header http.Header) *http.Response {
+		req, err := http.NewRequest(method, "https://api.github.com"+path, nil)
+		require.NoError(t, err)
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{StatusCode: http.StatusForbidden, Header: header, Request: req}
+	}
+
+	tests := []struct {
+		name     string
+		resp     *http.Response
+		err      error
+		expected string
+	}{
+		{
+			name: "fine-grained token names the accepted permission",
+			resp: newResponse(http.MethodPost, "/repos/owner/repo/issues", http.Header{
+				"X-Accepted-Github-Permissions": []string{"issues=write"},
+			}),
+			err:      fmt.Errorf("POST https://api.github.com/repos/owner/repo/issues: 403 Resource not accessible by personal access token []"),
+			expected: "failed to create issue: token lacks 'issues: write' permission for owner/repo; grant it and retry",
+		},
+		{
+			name: "fine-grained token lists alternative permission sets",
+			resp: newResponse(http.MethodPost, "/api/v3/repos/owner/repo/issues/1/labels", http.Header{
+				"X-Accepted-Github-Permissions": []string{"issues=write; pull_requests=write,metadata=read"},
+			}),
+			err:      fmt.Errorf("403 Resource not accessible by integration"),
+			expected: "failed to create issue: token lacks 'issues: write' or 'pull_requests: write' and 'metadata: read' permission for owner/repo; grant it and retry",
+		},
+		{
+			name:     "fine-grained token without permissions header",
+			resp:     newResponse(http.MethodPost, "/orgs/octo-org/projectsV2/1/items", nil),
+			err:      fmt.Errorf("403 Resource not accessible by personal access token"),
+			expected: "failed to create issue: token lacks a permission this endpoint requires for organization octo-org; grant it and retry",
+		},
+		{
+			name: "classic token missing the accepted scope",
+			resp: newResponse(http.MethodPost, "/repos/owner/repo/issues", http.Header{
+				"X-Accepted-Oauth-Scopes": []string{"repo, public_repo"},
+				"X-Oauth-Scopes":          []string{"read:org, gist"},
+			}),
+			err:      fmt.Errorf("403 Must have admin rights to Repository."),
+			expected: "failed to create issue: token lacks the 'repo' or 'public_repo' scope for owner/repo; grant it and retry (endpoint accepts: repo, public_repo; token has: read:org, gist)",
+		},
+		{
+			name: "classic token with no scopes",
+			resp: newResponse(http.MethodPost, "/repos/owner/repo/issues", http.Header{
+				"X-Accepted-Oauth-Scopes": []string{"repo"},
+				"X-Oauth-Scopes":          []string{""},
+			}),
+			err:      fmt.Errorf("403 Forbidden"),
+			expected: "failed to create issue: token lacks the 'repo' scope for owner/repo; grant it and retry (endpoint accepts: repo; token has: no scopes)",
+		},
+		{
+			name: "classic token with the scope passes the 403 through",
+			resp: newResponse(http.MethodPost, "/repos/owner/repo/issues", http.Header{
+				"X-Accepted-Oauth-Scopes": []string{"repo"},
+				"X-Oauth-Scopes":          []string{"repo, workflow"},
+			}),
+			err:      fmt.Errorf("403 Protected branch update failed"),
+			expected: "failed to create issue: 403 Protected branch update failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := ContextWithGitHubErrors(context.Background())
+
+			result := NewGitHubAPIErrorResponse(ctx, "failed to create issue", &github.Response{Response: tc.resp}, tc.err)
+
+			assert.Equal(t, tc.expected, requireErrorText(t, result))
+			apiErrors, err := GetGitHubAPIErrors(ctx)
+			require.NoError(t, err)
+			require.Len(t, apiErrors, 1)
+		})
+	}
+
+	t.Run("raw API errors are classified too", func(t *testing.T) {
+		resp := newResponse(http.MethodGet, "/repos/owner/repo/contents/README.md", http.Header{
+			"X-Accepted-Github-Permissions": []string{"contents=read"},
+		})
+		result := NewGitHubRawAPIErrorResponse(context.Background(), "failed to get file", resp, fmt.Errorf("Resource not accessible by personal access token"))
+		assert.Equal(t, "failed to get file: token lacks 'contents: read' permission for owner/repo; grant it and retry", requireErrorText(t, result))
+	})
+
+	t.Run("404 passes through", func(t *testing.T) {
+		resp := newResponse(http.MethodGet, "/repos/owner/repo/issues/1", nil)
+		resp.StatusCode = http.StatusNotFound
+		result := NewGitHubAPIErrorResponse(context.Background(), "failed to get issue", &github.Response{Response: resp}, fmt.Errorf("404 Not Found"))
+		assert.Equal(t, "failed to get issue: 404 Not Found", requireErrorText(t, result))
+	})
+}
+
+func TestNewGitHubGraphQLErrorResponse_SecondaryRateLimit(t *testing.T) {
+	t.Run("secondary rate limit error produces structured payload", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		gqlErr := fmt.Errorf("non-200 OK status code: 403 Forbidden body: \"You have exceeded a secondary rate limit. Please wait a few minutes before you try again.\"")
+		result := NewGitHubGraphQLErrorResponse(ctx, "failed to add sub-issue", gqlErr)
+
+		payload := requireSecondaryRateLimitPayload(t, requireErrorText(t, result))
+		assert.Equal(t, "failed to add sub-issue: secondary rate limit hit; wait 60 seconds before retrying", payload.Error)
+		assert.Equal(t, 60, payload.RetryAfterSeconds)
+
+		gqlErrors, err := GetGitHubGraphQLErrors(ctx)
+		require.NoError(t, err)
+		require.Len(t, gqlErrors, 1)
+		assert.Equal(t, gqlErr, gqlErrors[0].Err)
+	})
+
+	t.Run("other GraphQL errors pass through", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		result := NewGitHubGraphQLErrorResponse(ctx, "failed to add sub-issue", fmt.Errorf("Could not resolve to a node"))
+
+		text := requireErrorText(t, result)
+		assert.Equal(t, "failed to add sub-issue: Could not resolve to a node", text)
+	})
+}
+
+// requireSecondaryRateLimitPayload decodes a secondary rate limit tool error payload.
+func requireSecondaryRateLimitPayload(t *testing.T, text string) SecondaryRateLimitError {
+	t.Helper()
+	var payload SecondaryRateLimitError
+	require.NoError(t, json.Unmarshal([]byte(text), &payload), "expected JSON payload, got %q", text)
+	return payload
+}
+
+// queryGraphQLError runs a query against a server answering with the given
+// status and body, returning the error produced by the GraphQL client.
+func queryGraphQLError(t *testing.T, status int, body string) error {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	var q struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	err := githubv4.NewEnterpriseClient(server.URL, server.Client()).Query(context.Background(), &q, nil)
+	require.Error(t, err)
+	return err
+}
+
+func TestParseGraphQLErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected []GraphQLErrorDetail
+	}{
+		{
+			name: "multiple errors from a 200 response",
+			err: queryGraphQLError(t, http.StatusOK, `{"data":null,"errors":[
+				{"type":"NOT_FOUND","path":["repository"],"locations":[{"line":1,"column":2}],"message":"Could not resolve to a Repository with the name 'octo/missing'."},
+				{"message":"Resource not accessible by integration"},
+				{"message":"Something else went wrong"}
+			]}`),
+			expected: []GraphQLErrorDetail{
+				{Type: GraphQLErrorTypeNotFound, Message: "Could not resolve to a Repository with the name 'octo/missing'.", Locations: []GraphQLErrorLocation{{Line: 1, Column: 2}}},
+				{Type: GraphQLErrorTypeForbidden, Message: "Resource not accessible by integration"},
+				{Message: "Something else went wrong"},
+			},
+		},
+		{
+			name: "rate limit message",
+			err:  queryGraphQLError(t, http.StatusOK, `{"errors":[{"type":"RATE_LIMITED","message":"API rate limit exceeded for user ID 1."}]}`),
+			expected: []GraphQLErrorDetail{
+				{Type: GraphQLErrorTypeRateLimited, Message: "API rate limit exceeded for user ID 1."},
+			},
+		},
+		{
+			name: "non-200 response keeps the body's type and path",
+			err:  queryGraphQLError(t, http.StatusForbidden, `{"errors":[{"type":"FORBIDDEN","path":["repository","issue"],"message":"Not allowed"}]}`),
+			expected: []GraphQLErrorDetail{
+				{Type: GraphQLErrorTypeForbidden, Message: "Not allowed", Path: []any{"repository", "issue"}},
+			},
+		},
+		{
+			name: "non-200 response with a REST style body",
+			err:  queryGraphQLError(t, http.StatusNotFound, `{"message":"Not Found"}`),
+			expected: []GraphQLErrorDetail{
+				{Type: GraphQLErrorTypeNotFound, Message: "Not Found"},
+			},
+		},
+		{
+			name: "rate limit error from the transport",
+			err: &GraphQLRateLimitError{
+				StatusCode: http.StatusOK,
+				Errors:     []GraphQLErrorDetail{{Type: GraphQLErrorTypeRateLimited, Message: "API rate limit exceeded"}},
+			},
+			expected: []GraphQLErrorDetail{
+				{Type: GraphQLErrorTypeRateLimited, Message: "API rate limit exceeded"},
+			},
+		},
+		{
+			name:     "plain error",
+			err:      fmt.Errorf("connection refused"),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ParseGraphQLErrors(tc.err))
+		})
+	}
+}
+
+func TestNewGitHubGraphQLErrorResponse_Structured(t *testing.T) {
+	t.Run("multiple errors are listed", func(t *testing.T) {
+		err := queryGraphQLError(t, http.StatusOK, `{"errors":[
+			{"message":"Could not resolve to a User with the login of 'ghost'."},
+			{"message":"Field 'x' doesn't exist on type 'Issue'"}
+		]}`)
+
+		text := requireErrorText(t, NewGitHubGraphQLErrorResponse(context.Background(), "failed to update issue", err))
+		var payload GraphQLErrorPayload
+		require.NoError(t, json.Unmarshal([]byte(text), &payload), text)
+		assert.Equal(t, "failed to update issue: Could not resolve to a User with the login of 'ghost'. (and 1 more errors)", payload.Error)
+		require.Len(t, payload.Errors, 2)
+		assert.Equal(t, GraphQLErrorTypeNotFound, payload.Errors[0].Type)
+		assert.Equal(t, "Field 'x' doesn't exist on type 'Issue'", payload.Errors[1].Message)
+		assert.Empty(t, payload.ResetAt)
+	})
+
+	t.Run("rate limited errors include reset_at", func(t *testing.T) {
+		resetAt := time.Now().Add(90 * time.Second).Truncate(time.Second)
+		err := &GraphQLRateLimitError{
+			StatusCode: http.StatusOK,
+			ResetAt:    resetAt,
+			Errors:     []GraphQLErrorDetail{{Type: GraphQLErrorTypeRateLimited, Message: "API rate limit exceeded for user ID 1."}},
+		}
+
+		text := requireErrorText(t, NewGitHubGraphQLErrorResponse(context.Background(), "failed to assign copilot", err))
+		var payload GraphQLErrorPayload
+		require.NoError(t, json.Unmarshal([]byte(text), &payload), text)
+		assert.Contains(t, payload.Error, "failed to assign copilot: GitHub GraphQL rate limit exceeded. Retry after")
+		assert.Equal(t, resetAt.UTC().Format(time.RFC3339), payload.ResetAt)
+	})
+
+	t.Run("Retry-After from the transport is respected", func(t *testing.T) {
+		err := &GraphQLRateLimitError{
+			StatusCode: http.StatusForbidden,
+			RetryAfter: 30 * time.Second,
+			Errors:     []GraphQLErrorDetail{{Type: GraphQLErrorTypeRateLimited, Message: "You have exceeded a secondary rate limit."}},
+		}
+
+		payload := requireSecondaryRateLimitPayload(t, requireErrorText(t, NewGitHubGraphQLErrorResponse(context.Background(), "failed to add sub-issue", err)))
+		assert.Equal(t, 30, payload.RetryAfterSeconds)
+	})
+}
@@ -0,0 +1,277 @@
+package errors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Classified GraphQL error types. GitHub reports these in the "type" field of
+// each entry in the errors array; when the client drops that field the type
+// is inferred from the message.
+const (
+	GraphQLErrorTypeNotFound    = "NOT_FOUND"
+	GraphQLErrorTypeForbidden   = "FORBIDDEN"
+	GraphQLErrorTypeRateLimited = "RATE_LIMITED"
+)
+
+// GraphQLErrorLocation is a position in the query that an error refers to.
+type GraphQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// GraphQLErrorDetail is one entry of a GraphQL response's errors array.
+type GraphQLErrorDetail struct {
+	Type       string                 `json:"type,omitempty"`
+	Message    string                 `json:"message"`
+	Path       []any                  `json:"path,omitempty"`
+	Locations  []GraphQLErrorLocation `json:"locations,omitempty"`
+	Extensions map[string]any         `json:"extensions,omitempty"`
+}
+
+// GraphQLErrorPayload is the JSON payload of the tool error returned for a
+// failed GraphQL call whose errors could be parsed.
+type GraphQLErrorPayload struct {
+	Error   string               `json:"error"`
+	Errors  []GraphQLErrorDetail `json:"errors"`
+	ResetAt string               `json:"reset_at,omitempty"`
+}
+
+// GraphQLRateLimitError is returned by the GraphQL transport when GitHub
+// rejects a request for exceeding a rate limit. The GraphQL client does not
+// expose response headers, so the transport records the wait here.
+type GraphQLRateLimitError struct {
+	StatusCode int
+	// RetryAfter is set when GitHub sent a Retry-After header, which it does
+	// for secondary rate limits.
+	RetryAfter time.Duration
+	// ResetAt is when the primary rate limit window resets, if known.
+	ResetAt time.Time
+	// Errors holds the parsed errors array of the response body, if any.
+	Errors []GraphQLErrorDetail
+}
+
+func (e *GraphQLRateLimitError) Error() string {
+	if len(e.Errors) > 0 {
+		return e.Errors[0].Message
+	}
+	return fmt.Sprintf("GraphQL rate limit exceeded (status %d)", e.StatusCode)
+}
+
+// non200Pattern matches the error the GraphQL client returns for responses
+// with a status other than 200.
+var non200Pattern = regexp.MustCompile(`^non-200 OK status code: (\d+)[^"]* body: (".*")$`)
+
+// ParseGraphQLErrors extracts the individual errors from an error returned by
+// the GraphQL client and classifies each one. It returns nil when err carries
+// no structured errors, for example on network failures.
+func ParseGraphQLErrors(err error) []GraphQLErrorDetail {
+	if err == nil {
+		return nil
+	}
+
+	var details []GraphQLErrorDetail
+	var status int
+	var rateLimitErr *GraphQLRateLimitError
+	switch {
+	case stderrors.As(err, &rateLimitErr):
+		details = append(details, rateLimitErr.Errors...)
+		if len(details) == 0 {
+			details = []GraphQLErrorDetail{{Message: rateLimitErr.Error(), Type: GraphQLErrorTypeRateLimited}}
+		}
+	case reflect.ValueOf(err).Kind() == reflect.Slice:
+		// The client's errors type is an unexported slice of structs with
+		// Message and Locations fields; round-trip it through JSON.
+		b, marshalErr := json.Marshal(err)
+		if marshalErr != nil || json.Unmarshal(b, &details) != nil {
+			return nil
+		}
+	default:
+		m := non200Pattern.FindStringSubmatch(err.Error())
+		if m == nil {
+			return nil
+		}
+		status, _ = strconv.Atoi(m[1])
+		body, unquoteErr := strconv.Unquote(m[2])
+		if unquoteErr != nil {
+			return nil
+		}
+		details = ParseGraphQLErrorBody([]byte(body))
+		if len(details) == 0 {
+			details = []GraphQLErrorDetail{{Message: fmt.Sprintf("unexpected status %d: %s", status, body)}}
+		}
+	}
+
+	for i := range details {
+		if details[i].Type == "" {
+			details[i].Type = classifyGraphQLError(details[i].Message, status)
+		}
+	}
+	return details
+}
+
+// ParseGraphQLErrorBody parses the errors of a GraphQL response body. REST
+// style bodies that only carry a top-level message are returned as a single
+// error.
+func ParseGraphQLErrorBody(body []byte) []GraphQLErrorDetail {
+	var parsed struct {
+		Message string               `json:"message"`
+		Errors  []GraphQLErrorDetail `json:"errors"`
+	}
+	if json.Unmarshal(body, &parsed) != nil {
+		return nil
+	}
+	if len(parsed.Errors) > 0 {
+		return parsed.Errors
+	}
+	if parsed.Message != "" {
+		return []GraphQLErrorDetail{{Message: parsed.Message}}
+	}
+	return nil
+}
+
+// classifyGraphQLError infers an error type from GitHub's wording and, for
+// non-200 responses, the HTTP status.
+func classifyGraphQLError(message string, status int) string {
+	lower := strings.ToLower(message)
+	switch {
+	case strings.Contains(lower, "rate limit"):
+		return GraphQLErrorTypeRateLimited
+	case strings.HasPrefix(lower, "could not resolve to"), status == 404:
+		return GraphQLErrorTypeNotFound
+	case strings.Contains(lower, "resource not accessible"), strings.Contains(lower, "forbidden"), status == 403:
+		return GraphQLErrorTypeForbidden
+	default:
+		return ""
+	}
+}
+
+type verboseErrorsKey struct{}
+
+// graphQLErrorLog holds the errors array of the last failed GraphQL response
+// seen while handling a request.
+type graphQLErrorLog struct {
+	mu      sync.Mutex
+	details []GraphQLErrorDetail
+}
+
+// ContextWithVerboseErrors enables verbose GraphQL tool errors for ctx. They
+// list the path, query locations and extensions of each error, which are
+// useful while developing tools but expose query internals, so they are off
+// by default.
+func ContextWithVerboseErrors(ctx context.Context) context.Context {
+	return context.WithValue(ctx, verboseErrorsKey{}, &graphQLErrorLog{})
+}
+
+// VerboseErrorsEnabled reports whether ctx was set up by ContextWithVerboseErrors.
+func VerboseErrorsEnabled(ctx context.Context) bool {
+	_, ok := ctx.Value(verboseErrorsKey{}).(*graphQLErrorLog)
+	return ok
+}
+
+// RecordGraphQLErrorBody keeps the errors of a GraphQL response body when
+// verbose errors are enabled for ctx. The GraphQL client drops everything but
+// the message and locations of each error, so transports record the body
+// before handing it on.
+func RecordGraphQLErrorBody(ctx context.Context, body []byte) {
+	log, ok := ctx.Value(verboseErrorsKey{}).(*graphQLErrorLog)
+	if !ok {
+		return
+	}
+	details := ParseGraphQLErrorBody(body)
+	if len(details) == 0 {
+		return
+	}
+	log.mu.Lock()
+	log.details = details
+	log.mu.Unlock()
+}
+
+// takeRecordedGraphQLErrors returns and clears the errors recorded by
+// RecordGraphQLErrorBody.
+func takeRecordedGraphQLErrors(ctx context.Context) []GraphQLErrorDetail {
+	log, ok := ctx.Value(verboseErrorsKey{}).(*graphQLErrorLog)
+	if !ok {
+		return nil
+	}
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	details := log.details
+	log.details = nil
+	return details
+}
+
+// newGraphQLErrorResult builds the tool error for a failed GraphQL call,
+// listing every error GitHub returned. Unless verbose errors are enabled for
+// ctx only the type and message of each error are kept. It returns nil when
+// err carries no structured errors.
+func newGraphQLErrorResult(ctx context.Context, message string, err error) *mcp.CallToolResult {
+	details := ParseGraphQLErrors(err)
+	if len(details) == 0 {
+		return nil
+	}
+	if ctx != nil && VerboseErrorsEnabled(ctx) {
+		// The recorded body is the one behind err when both list the same
+		// number of errors; prefer it as it still carries path and extensions.
+		if recorded := takeRecordedGraphQLErrors(ctx); len(recorded) == len(details) {
+			for i := range recorded {
+				if recorded[i].Type == "" {
+					recorded[i].Type = details[i].Type
+				}
+			}
+			details = recorded
+		}
+	} else {
+		for i := range details {
+			details[i].Path = nil
+			details[i].Locations = nil
+			details[i].Extensions = nil
+		}
+	}
+
+	payload := GraphQLErrorPayload{
+		Error:  fmt.Sprintf("%s: %s", message, details[0].Message),
+		Errors: details,
+	}
+	if len(details) > 1 {
+		payload.Error += fmt.Sprintf(" (and %d more errors)", len(details)-1)
+	}
+	for _, d := range details {
+		if d.Type != GraphQLErrorTypeRateLimited {
+			continue
+		}
+		payload.Error = fmt.Sprintf("%s: GitHub GraphQL rate limit exceeded", message)
+		var rateLimitErr *GraphQLRateLimitError
+		if stderrors.As(err, &rateLimitErr) && !rateLimitErr.ResetAt.IsZero() {
+			payload.ResetAt = rateLimitErr.ResetAt.UTC().Format(time.RFC3339)
+			if retryIn := time.Until(rateLimitErr.ResetAt); retryIn > 0 {
+				payload.Error += fmt.Sprintf(". Retry after %d seconds.", int(math.Ceil(retryIn.Seconds())))
+			}
+		} else {
+			payload.Error += ". Wait before retrying."
+		}
+		break
+	}
+
+	// Messages often quote field names or contain markup; keep them readable.
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if encErr := enc.Encode(payload); encErr != nil {
+		return utils.NewToolResultError(payload.Error)
+	}
+	return utils.NewToolResultError(strings.TrimSuffix(buf.String(), "\n"))
+}
@@ -0,0 +1,108 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/scopes"
+)
+
+const (
+	// acceptedOAuthScopesHeader lists the classic OAuth scopes an endpoint
+	// accepts. GitHub sends it alongside X-OAuth-Scopes.
+	acceptedOAuthScopesHeader = "X-Accepted-OAuth-Scopes"
+	// acceptedGitHubPermissionsHeader lists the fine-grained permissions an
+	// endpoint accepts, e.g. "issues=write; pull_requests=write". Alternatives
+	// are separated by ";" and permissions needed together by ",".
+	acceptedGitHubPermissionsHeader = "X-Accepted-GitHub-Permissions"
+)
+
+// insufficientPermissionMessage explains a 403 caused by the token lacking a
+// classic OAuth scope or a fine-grained permission, naming what the endpoint
+// accepts when GitHub says so. It returns "" for any other response, so that
+// a 403 for another reason, such as branch protection, passes through.
+func insufficientPermissionMessage(resp *http.Response, errMsg string) string {
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		return ""
+	}
+	target := permissionTarget(resp.Request)
+
+	// Classic PATs and OAuth apps report both the scopes the endpoint accepts
+	// and the scopes the token has, so a missing scope can be confirmed.
+	accepted := scopes.ParseScopeHeader(resp.Header.Get(acceptedOAuthScopesHeader))
+	if _, hasTokenScopes := resp.Header[http.CanonicalHeaderKey(scopes.OAuthScopesHeader)]; hasTokenScopes && len(accepted) > 0 {
+		granted := scopes.ParseScopeHeader(resp.Header.Get(scopes.OAuthScopesHeader))
+		if !scopes.HasRequiredScopes(granted, accepted) {
+			has := "no scopes"
+			if len(granted) > 0 {
+				has = strings.Join(granted, ", ")
+			}
+			return fmt.Sprintf("token lacks the %s scope%s; grant it and retry (endpoint accepts: %s; token has: %s)",
+				quoteAlternatives(accepted), target, strings.Join(accepted, ", "), has)
+		}
+	}
+
+	// Fine-grained PATs and GitHub Apps get "Resource not accessible by
+	// personal access token" or "... by integration" instead.
+	if !strings.Contains(strings.ToLower(errMsg), "resource not accessible by") {
+		return ""
+	}
+	if permissions := parseAcceptedPermissions(resp.Header.Get(acceptedGitHubPermissionsHeader)); len(permissions) > 0 {
+		return fmt.Sprintf("token lacks %s permission%s; grant it and retry", strings.Join(permissions, " or "), target)
+	}
+	return fmt.Sprintf("token lacks a permission this endpoint requires%s; grant it and retry", target)
+}
+
+// parseAcceptedPermissions turns an X-Accepted-GitHub-Permissions value into
+// readable alternatives, e.g. "issues=write; contents=read,metadata=read"
+// becomes ["'issues: write'", "'contents: read' and 'metadata: read'"].
+func parseAcceptedPermissions(header string) []string {
+	var alternatives []string
+	for _, set := range strings.Split(header, ";") {
+		var permissions []string
+		for _, permission := range strings.Split(set, ",") {
+			name, level, ok := strings.Cut(strings.TrimSpace(permission), "=")
+			if !ok || name == "" {
+				continue
+			}
+			permissions = append(permissions, fmt.Sprintf("'%s: %s'", name, level))
+		}
+		if len(permissions) > 0 {
+			alternatives = append(alternatives, strings.Join(permissions, " and "))
+		}
+	}
+	return alternatives
+}
+
+// quoteAlternatives renders scopes as "'repo' or 'public_repo'".
+func quoteAlternatives(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = "'" + v + "'"
+	}
+	return strings.Join(quoted, " or ")
+}
+
+// permissionTarget names the repository or organization a request was for,
+// as " for owner/repo" or " for organization org", or "" if neither.
+func permissionTarget(req *http.Request) string {
+	if req == nil || req.URL == nil {
+		return ""
+	}
+	// Search the path rather than anchoring at its start, so GHES's /api/v3
+	// prefix is skipped.
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		switch segments[i] {
+		case "repos":
+			if i+2 < len(segments) {
+				return fmt.Sprintf(" for %s/%s", segments[i+1], segments[i+2])
+			}
+			return ""
+		case "orgs":
+			return fmt.Sprintf(" for organization %s", segments[i+1])
+		}
+	}
+	return ""
+}
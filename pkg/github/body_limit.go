@@ -0,0 +1,64 @@
+package github
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxBodyLength is the most characters GitHub accepts in an issue body or
+// comment. Longer bodies are rejected with a 422.
+const MaxBodyLength = 65536
+
+// bodyLimitTruncationMarker is appended to bodies cut by limitBodyLength.
+const bodyLimitTruncationMarker = "\n\n[truncated]"
+
+// autoTruncateDescription documents the auto_truncate parameter of tools that
+// write issue bodies or comments.
+var autoTruncateDescription = fmt.Sprintf("If the text is longer than GitHub's limit of %s characters, cut it and append a \"[truncated]\" marker instead of failing", formatThousands(MaxBodyLength))
+
+// limitBodyLength enforces MaxBodyLength on the text of field before it is
+// sent to GitHub. Characters are counted as Unicode code points, the way
+// GitHub counts them. reserved is the number of characters that will be added
+// to the text afterwards, such as a dedupe marker. Text over the limit is an
+// error unless autoTruncate is set, in which case it is cut at a character
+// boundary that does not split a combined character and marked as truncated.
+func limitBodyLength(field, text string, reserved int, autoTruncate bool) (string, error) {
+	length := utf8.RuneCountInString(text) + reserved
+	if length <= MaxBodyLength {
+		return text, nil
+	}
+	if !autoTruncate {
+		return "", fmt.Errorf("%s is %s characters; GitHub's limit is %s", field, formatThousands(length), formatThousands(MaxBodyLength))
+	}
+
+	runes := []rune(text)
+	cut := max(MaxBodyLength-reserved-utf8.RuneCountInString(bodyLimitTruncationMarker), 0)
+	for cut > 0 && (continuesCharacter(runes[cut]) || runes[cut-1] == zeroWidthJoiner) {
+		cut--
+	}
+	return string(runes[:cut]) + bodyLimitTruncationMarker, nil
+}
+
+const zeroWidthJoiner = '\u200d'
+
+// continuesCharacter reports whether r renders as part of the character
+// before it, so that cutting the text just before r would split it.
+func continuesCharacter(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Variation_Selector) ||
+		r == zeroWidthJoiner ||
+		(r >= 0x1F3FB && r <= 0x1F3FF) // emoji skin tone modifiers
+}
+
+// formatThousands formats n with comma thousands separators.
+func formatThousands(n int) string {
+	if n < 0 {
+		return "-" + formatThousands(-n)
+	}
+	s := strconv.Itoa(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
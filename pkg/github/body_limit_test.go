@@ -0,0 +1,149 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitBodyLength(t *testing.T) {
+	t.Run("within the limit", func(t *testing.T) {
+		// 65,536 three-byte characters are far more than 65,536 bytes but
+		// still within GitHub's character limit.
+		body := strings.Repeat("世", MaxBodyLength)
+		got, err := limitBodyLength("body", body, 0, false)
+		require.NoError(t, err)
+		assert.Equal(t, body, got)
+	})
+
+	t.Run("over the limit counts characters", func(t *testing.T) {
+		body := strings.Repeat("é", 70012)
+		_, err := limitBodyLength("body", body, 0, false)
+		assert.EqualError(t, err, "body is 70,012 characters; GitHub's limit is 65,536")
+	})
+
+	t.Run("reserved characters count toward the limit", func(t *testing.T) {
+		_, err := limitBodyLength("body", strings.Repeat("a", MaxBodyLength-10), 20, false)
+		assert.EqualError(t, err, "body is 65,546 characters; GitHub's limit is 65,536")
+	})
+
+	t.Run("auto truncate keeps valid UTF-8 within the limit", func(t *testing.T) {
+		body := strings.Repeat("🚀", MaxBodyLength+100)
+		got, err := limitBodyLength("body", body, 0, true)
+		require.NoError(t, err)
+		assert.True(t, utf8.ValidString(got))
+		assert.Equal(t, MaxBodyLength, utf8.RuneCountInString(got))
+		assert.True(t, strings.HasSuffix(got, "\n\n[truncated]"))
+	})
+
+	t.Run("auto truncate leaves room for reserved characters", func(t *testing.T) {
+		got, err := limitBodyLength("body", strings.Repeat("a", MaxBodyLength), 50, true)
+		require.NoError(t, err)
+		assert.Equal(t, MaxBodyLength-50, utf8.RuneCountInString(got))
+	})
+
+	t.Run("auto truncate does not split combined characters", func(t *testing.T) {
+		keep := MaxBodyLength - utf8.RuneCountInString(bodyLimitTruncationMarker)
+		// "e" + combining acute accent straddling the cut.
+		body := strings.Repeat("a", keep-1) + "e\u0301" + strings.Repeat("b", 100)
+		got, err := limitBodyLength("body", body, 0, true)
+		require.NoError(t, err)
+		assert.Equal(t, strings.Repeat("a", keep-1)+bodyLimitTruncationMarker, got)
+
+		// A family emoji joined with zero-width joiners straddling the cut.
+		family := "👩\u200d👩\u200d👧"
+		body = strings.Repeat("a", keep-2) + family + strings.Repeat("b", 100)
+		got, err = limitBodyLength("body", body, 0, true)
+		require.NoError(t, err)
+		assert.Equal(t, strings.Repeat("a", keep-2)+bodyLimitTruncationMarker, got)
+	})
+}
+
+func TestFormatThousands(t *testing.T) {
+	for n, expected := range map[int]string{0: "0", 999: "999", 1000: "1,000", 65536: "65,536", 1234567: "1,234,567", -70012: "-70,012"} {
+		assert.Equal(t, expected, formatThousands(n))
+	}
+}
+
+func Test_AddIssueComment_BodyLimit(t *testing.T) {
+	longBody := strings.Repeat("ü", MaxBodyLength+1)
+
+	t.Run("rejected before any API call", func(t *testing.T) {
+		var calls atomic.Int32
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandler(func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}))}
+		serverTool := AddIssueComment(translations.NullTranslationHelper)
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+			"body":         longBody,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Equal(t, "body is 65,537 characters; GitHub's limit is 65,536", getErrorResult(t, result).Text)
+		assert.Zero(t, calls.Load())
+	})
+
+	t.Run("auto_truncate posts a shortened comment", func(t *testing.T) {
+		var posted string
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PostReposIssuesCommentsByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+				var comment github.IssueComment
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+				posted = comment.GetBody()
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(&github.IssueComment{ID: github.Ptr(int64(1)), HTMLURL: github.Ptr("https://github.com/owner/repo/issues/42#issuecomment-1")})
+			},
+		}))}
+		serverTool := AddIssueComment(translations.NullTranslationHelper)
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":         "owner",
+			"repo":          "repo",
+			"issue_number":  float64(42),
+			"body":          longBody,
+			"auto_truncate": true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		assert.Equal(t, MaxBodyLength, utf8.RuneCountInString(posted))
+		assert.True(t, strings.HasSuffix(posted, "[truncated]"))
+	})
+}
+
+func Test_IssueWrite_BodyLimit(t *testing.T) {
+	var calls atomic.Int32
+	deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandler(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))}
+	serverTool := IssueWrite(translations.NullTranslationHelper)
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"method": "create",
+		"owner":  "owner",
+		"repo":   "repo",
+		"title":  "Crash report",
+		"body":   strings.Repeat("日本", 35006),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Equal(t, "body is 70,012 characters; GitHub's limit is 65,536", getErrorResult(t, result).Text)
+	assert.Zero(t, calls.Load())
+}
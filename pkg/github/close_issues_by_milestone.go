@@ -0,0 +1,284 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// MaxMilestoneCloseIssues caps how many issues a single
+// close_issues_by_milestone call lists and closes.
+const MaxMilestoneCloseIssues = 200
+
+// MilestoneCloseResult reports the outcome of closing one issue of a milestone.
+type MilestoneCloseResult struct {
+	IssueNumber int    `json:"issue_number"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	Closed      bool   `json:"closed"`
+	// Status is left empty in dry runs, where nothing is attempted.
+	Status     utils.BatchStatus `json:"status,omitempty"`
+	CommentURL string            `json:"comment_url,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// BatchStatus implements utils.BatchEntry.
+func (r MilestoneCloseResult) BatchStatus() utils.BatchStatus { return r.Status }
+
+// CloseIssuesByMilestone creates a tool that closes every open issue in a
+// milestone, optionally posting a comment on each first.
+func CloseIssuesByMilestone(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "close_issues_by_milestone",
+			Description: t("TOOL_CLOSE_ISSUES_BY_MILESTONE_DESCRIPTION", fmt.Sprintf("Close every open issue in a milestone as completed, e.g. when a release ships, optionally posting a comment on each first. Up to %d issues per call. Failures are reported per issue without aborting the batch. Use dry_run to preview the affected issues.", MaxMilestoneCloseIssues)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_CLOSE_ISSUES_BY_MILESTONE_USER_TITLE", "Close issues in milestone"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"milestone": {
+						Type:        "string",
+						Description: "The milestone number, or its title (matched case-insensitively)",
+					},
+					"comment": {
+						Type:        "string",
+						Description: "Comment to post on each issue before closing it. {number} and {title} are replaced with the issue's number and title. Omit to close without commenting.",
+					},
+					"dry_run": {
+						Type:        "boolean",
+						Description: "List the issues that would be closed without changing anything",
+						Default:     json.RawMessage(`false`),
+					},
+				},
+				Required: []string{"owner", "repo", "milestone"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			milestone, err := RequiredParam[string](args, "milestone")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			comment, err := OptionalParam[string](args, "comment")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			dryRun, err := OptionalParam[bool](args, "dry_run")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			milestoneNumber, result := resolveMilestoneNumber(ctx, client, owner, repo, milestone)
+			if result != nil {
+				return result, nil, nil
+			}
+
+			issues, truncated, result := listOpenMilestoneIssues(ctx, client, owner, repo, milestoneNumber)
+			if result != nil {
+				return result, nil, nil
+			}
+
+			results := make([]MilestoneCloseResult, 0, len(issues))
+			comments := make([]string, 0, len(issues))
+			for _, issue := range issues {
+				results = append(results, MilestoneCloseResult{
+					IssueNumber: issue.GetNumber(),
+					Title:       issue.GetTitle(),
+					URL:         issue.GetHTMLURL(),
+				})
+				// Check every comment before anything is written, so an
+				// over-long one does not fail the batch halfway through.
+				body, err := limitBodyLength("comment", milestoneIssueComment(comment, issue), 0, false)
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("issue #%d: %v", issue.GetNumber(), err)), nil, nil
+				}
+				comments = append(comments, body)
+			}
+			if dryRun {
+				return MarshalledTextResult(map[string]any{
+					"milestone": milestoneNumber,
+					"dry_run":   true,
+					"issues":    results,
+					"truncated": truncated,
+				}), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
+			}
+
+			batch := utils.NewBatchResult[MilestoneCloseResult](len(results))
+			for i, issue := range issues {
+				// Stop between issues once the client cancels the call and
+				// report what was done so far instead of closing the rest.
+				if ctx.Err() != nil {
+					notAttempted := make([]int, 0, len(issues)-i)
+					for _, rest := range issues[i:] {
+						notAttempted = append(notAttempted, rest.GetNumber())
+					}
+					return MarshalledTextResult(struct {
+						Milestone int `json:"milestone"`
+						*utils.BatchResult[MilestoneCloseResult]
+						Truncated    bool   `json:"truncated"`
+						Canceled     bool   `json:"canceled"`
+						NotAttempted []int  `json:"not_attempted"`
+						Summary      string `json:"summary"`
+					}{
+						Milestone:    milestoneNumber,
+						BatchResult:  batch,
+						Truncated:    truncated,
+						Canceled:     true,
+						NotAttempted: notAttempted,
+						Summary:      fmt.Sprintf("closed %d of %d issues before cancellation", batch.Succeeded, len(issues)),
+					}), nil, nil
+				}
+				batch.Add(closeMilestoneIssue(ctx, client, gqlClient, owner, repo, comments[i], issue, results[i]))
+			}
+
+			return MarshalledTextResult(struct {
+				Milestone int `json:"milestone"`
+				*utils.BatchResult[MilestoneCloseResult]
+				Truncated bool `json:"truncated"`
+			}{
+				Milestone:   milestoneNumber,
+				BatchResult: batch,
+				Truncated:   truncated,
+			}), nil, nil
+		})
+}
+
+// milestoneIssueComment fills the {number} and {title} placeholders of
+// comment for issue.
+func milestoneIssueComment(comment string, issue *github.Issue) string {
+	if comment == "" {
+		return ""
+	}
+	return strings.NewReplacer("{number}", strconv.Itoa(issue.GetNumber()), "{title}", issue.GetTitle()).Replace(comment)
+}
+
+// closeMilestoneIssue posts the optional comment on one issue of a milestone
+// and then closes it, recording the outcome on result.
+func closeMilestoneIssue(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner, repo, comment string, issue *github.Issue, result MilestoneCloseResult) MilestoneCloseResult {
+	result.Status = utils.BatchFailed
+	if comment != "" {
+		created, resp, err := client.Issues.CreateComment(ctx, owner, repo, result.IssueNumber, &github.IssueComment{Body: github.Ptr(comment)})
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		if err != nil {
+			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to comment on issue", resp, err)
+			result.Error = fmt.Sprintf("failed to post comment, issue left open: %v", err)
+			return result
+		}
+		result.CommentURL = created.GetHTMLURL()
+	}
+	if err := closeIssueByID(ctx, gqlClient, githubv4.ID(issue.GetNodeID()), "completed", ""); err != nil {
+		_, _ = ghErrors.NewGitHubGraphQLErrorToCtx(ctx, "failed to close issue", err)
+		result.Error = fmt.Sprintf("failed to close issue: %v", err)
+		return result
+	}
+	result.Closed = true
+	result.Status = utils.BatchSucceeded
+	return result
+}
+
+// resolveMilestoneNumber returns the number of the milestone given either as
+// a number or by title.
+func resolveMilestoneNumber(ctx context.Context, client *github.Client, owner, repo, milestone string) (int, *mcp.CallToolResult) {
+	if number, err := strconv.Atoi(strings.TrimSpace(milestone)); err == nil {
+		return number, nil
+	}
+
+	opts := &github.MilestoneListOptions{
+		State:       "all",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		milestones, resp, err := client.Issues.ListMilestones(ctx, owner, repo, opts)
+		if err != nil {
+			return 0, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list milestones", resp, err)
+		}
+		_ = resp.Body.Close()
+		for _, m := range milestones {
+			if strings.EqualFold(m.GetTitle(), strings.TrimSpace(milestone)) {
+				return m.GetNumber(), nil
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return 0, utils.NewToolResultError(fmt.Sprintf("milestone %q not found in %s/%s", milestone, owner, repo))
+}
+
+// listOpenMilestoneIssues lists the open issues in a milestone, skipping pull
+// requests, up to MaxMilestoneCloseIssues. truncated reports whether more
+// issues were left unlisted.
+func listOpenMilestoneIssues(ctx context.Context, client *github.Client, owner, repo string, milestoneNumber int) ([]*github.Issue, bool, *mcp.CallToolResult) {
+	opts := &github.IssueListByRepoOptions{
+		Milestone:   strconv.Itoa(milestoneNumber),
+		State:       "open",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	var issues []*github.Issue
+	for {
+		page, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, false, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list milestone issues", resp, err)
+		}
+		_ = resp.Body.Close()
+		for _, issue := range page {
+			if issue.IsPullRequest() {
+				continue
+			}
+			if len(issues) == MaxMilestoneCloseIssues {
+				return issues, true, nil
+			}
+			issues = append(issues, issue)
+		}
+		if resp.NextPage == 0 {
+			return issues, false, nil
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,218 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// closeIssueMutationServer answers closeIssue mutations, failing for the
+// issue node IDs in fail, and records the inputs it received.
+type closeIssueMutationServer struct {
+	fail   map[string]bool
+	inputs []map[string]any
+}
+
+func (s *closeIssueMutationServer) handle(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Variables struct {
+			Input map[string]any `json:"input"`
+		} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.inputs = append(s.inputs, body.Variables.Input)
+	if id, _ := body.Variables.Input["issueId"].(string); s.fail[id] {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"errors": []any{map[string]any{"message": "Issue is locked"}},
+		})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"data": map[string]any{
+			"closeIssue": map[string]any{
+				"issue": map[string]any{"id": body.Variables.Input["issueId"], "number": 1, "url": "", "state": "CLOSED"},
+			},
+		},
+	})
+}
+
+func Test_CloseIssuesByMilestone(t *testing.T) {
+	serverTool := CloseIssuesByMilestone(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "close_issues_by_milestone", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "milestone"})
+
+	milestoneIssues := []*github.Issue{
+		{Number: github.Ptr(1), NodeID: github.Ptr("I_1"), Title: github.Ptr("Crash on save"), HTMLURL: github.Ptr("https://github.com/owner/repo/issues/1")},
+		{Number: github.Ptr(2), NodeID: github.Ptr("PR_2"), Title: github.Ptr("Fix crash"), PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/owner/repo/pulls/2")}},
+		{Number: github.Ptr(3), NodeID: github.Ptr("I_3"), Title: github.Ptr("Slow startup"), HTMLURL: github.Ptr("https://github.com/owner/repo/issues/3")},
+	}
+
+	newDeps := func(t *testing.T, listed *[]string, comments map[int]string, server *closeIssueMutationServer) BaseDeps {
+		return BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/milestones": mockResponse(t, http.StatusOK, []*github.Milestone{
+					{Number: github.Ptr(2), Title: github.Ptr("v1.0")},
+					{Number: github.Ptr(3), Title: github.Ptr("v1.1")},
+				}),
+				"GET /repos/owner/repo/issues": func(w http.ResponseWriter, r *http.Request) {
+					*listed = append(*listed, r.URL.Query().Get("milestone")+"/"+r.URL.Query().Get("state"))
+					_ = json.NewEncoder(w).Encode(milestoneIssues)
+				},
+				PostReposIssuesCommentsByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+					var comment github.IssueComment
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+					number := map[string]int{"/repos/owner/repo/issues/1/comments": 1, "/repos/owner/repo/issues/3/comments": 3}[r.URL.Path]
+					comments[number] = comment.GetBody()
+					w.WriteHeader(http.StatusCreated)
+					_ = json.NewEncoder(w).Encode(&github.IssueComment{HTMLURL: github.Ptr(r.URL.Path)})
+				},
+			})),
+			GQLClient: githubv4.NewClient(MockHTTPClientWithHandler(server.handle)),
+		}
+	}
+
+	call := func(t *testing.T, deps BaseDeps, args map[string]any) map[string]any {
+		t.Helper()
+		handler := serverTool.Handler(deps)
+		args["owner"] = "owner"
+		args["repo"] = "repo"
+		request := createMCPRequest(args)
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		return response
+	}
+
+	t.Run("dry run resolves the title and writes nothing", func(t *testing.T) {
+		var listed []string
+		comments := map[int]string{}
+		server := &closeIssueMutationServer{}
+		response := call(t, newDeps(t, &listed, comments, server), map[string]any{
+			"milestone": "V1.1",
+			"comment":   "Fixed in v1.1",
+			"dry_run":   true,
+		})
+
+		assert.Equal(t, []string{"3/open"}, listed)
+		assert.Equal(t, float64(3), response["milestone"])
+		assert.Equal(t, true, response["dry_run"])
+		assert.Equal(t, false, response["truncated"])
+		issues := response["issues"].([]any)
+		require.Len(t, issues, 2)
+		assert.Equal(t, float64(1), issues[0].(map[string]any)["issue_number"])
+		assert.Equal(t, float64(3), issues[1].(map[string]any)["issue_number"])
+		assert.Empty(t, comments)
+		assert.Empty(t, server.inputs)
+	})
+
+	t.Run("comments and closes each issue, reporting partial failures", func(t *testing.T) {
+		var listed []string
+		comments := map[int]string{}
+		server := &closeIssueMutationServer{fail: map[string]bool{"I_3": true}}
+		response := call(t, newDeps(t, &listed, comments, server), map[string]any{
+			"milestone": "3",
+			"comment":   "#{number} ({title}) shipped in v1.1",
+		})
+
+		assert.Equal(t, []string{"3/open"}, listed)
+		assert.Equal(t, map[int]string{
+			1: "#1 (Crash on save) shipped in v1.1",
+			3: "#3 (Slow startup) shipped in v1.1",
+		}, comments)
+
+		require.Len(t, server.inputs, 2)
+		assert.Equal(t, "I_1", server.inputs[0]["issueId"])
+		assert.Equal(t, "COMPLETED", server.inputs[0]["stateReason"])
+		assert.Equal(t, "I_3", server.inputs[1]["issueId"])
+
+		assert.Equal(t, float64(2), response["total"])
+		assert.Equal(t, float64(1), response["succeeded"])
+		assert.Equal(t, float64(1), response["failed"])
+		assert.Equal(t, float64(0), response["skipped"])
+		results := response["results"].([]any)
+		require.Len(t, results, 2)
+		assert.Equal(t, "succeeded", results[0].(map[string]any)["status"])
+		assert.Equal(t, "failed", results[1].(map[string]any)["status"])
+		assert.Equal(t, true, results[0].(map[string]any)["closed"])
+		assert.Equal(t, "/repos/owner/repo/issues/1/comments", results[0].(map[string]any)["comment_url"])
+		assert.Equal(t, false, results[1].(map[string]any)["closed"])
+		assert.Contains(t, results[1].(map[string]any)["error"], "Issue is locked")
+	})
+
+	t.Run("over-long comment is refused before anything is written", func(t *testing.T) {
+		var listed []string
+		comments := map[int]string{}
+		server := &closeIssueMutationServer{}
+		deps := newDeps(t, &listed, comments, server)
+		handler := serverTool.Handler(deps)
+		// Fits on its own, but not once {title} is filled in.
+		request := createMCPRequest(map[string]any{
+			"owner":     "owner",
+			"repo":      "repo",
+			"milestone": "3",
+			"comment":   strings.Repeat("x", MaxBodyLength-10) + "{title}",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "issue #1: comment is")
+		assert.Empty(t, comments)
+		assert.Empty(t, server.inputs)
+	})
+
+	t.Run("stops on cancellation and reports the issues not attempted", func(t *testing.T) {
+		var listed []string
+		comments := map[int]string{}
+		server := &closeIssueMutationServer{}
+		deps := newDeps(t, &listed, comments, server)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		deps.GQLClient = githubv4.NewClient(MockHTTPClientWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			server.handle(w, r)
+			cancel()
+		}))
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "milestone": "3"})
+		result, err := handler(ContextWithDeps(ctx, deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, server.inputs, 1)
+		assert.Equal(t, true, response["canceled"])
+		assert.Equal(t, []any{float64(3)}, response["not_attempted"])
+		assert.Equal(t, float64(1), response["succeeded"])
+		assert.Equal(t, "closed 1 of 2 issues before cancellation", response["summary"])
+	})
+
+	t.Run("unknown milestone title", func(t *testing.T) {
+		var listed []string
+		deps := newDeps(t, &listed, map[int]string{}, &closeIssueMutationServer{})
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "milestone": "v2.0"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Equal(t, `milestone "v2.0" not found in owner/repo`, getErrorResult(t, result).Text)
+		assert.Empty(t, listed)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/github/github-mcp-server/pkg/scopes"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/shurcooL/githubv4"
@@ -112,6 +113,72 @@ func GetMe(t translations.TranslationHelperFunc) inventory.ServerTool {
 	)
 }
 
+// RateLimitBucket is the quota of one rate limit category.
+type RateLimitBucket struct {
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Reset     time.Time `json:"reset"`
+}
+
+// RateLimitStatus is the output of get_rate_limit.
+type RateLimitStatus struct {
+	Core    *RateLimitBucket `json:"core,omitempty"`
+	Search  *RateLimitBucket `json:"search,omitempty"`
+	GraphQL *RateLimitBucket `json:"graphql,omitempty"`
+}
+
+func toRateLimitBucket(rate *github.Rate) *RateLimitBucket {
+	if rate == nil {
+		return nil
+	}
+	return &RateLimitBucket{
+		Limit:     rate.Limit,
+		Remaining: rate.Remaining,
+		Reset:     rate.Reset.UTC(),
+	}
+}
+
+// GetRateLimit creates a tool to get the remaining API quota of the authenticated user.
+func GetRateLimit(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name:        "get_rate_limit",
+			Description: t("TOOL_GET_RATE_LIMIT_DESCRIPTION", "Get the remaining GitHub API quota for the core (REST), search and GraphQL rate limits, with the time each one resets. Use this to pace long-running or search-heavy work. Checking the rate limit does not count against it."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_RATE_LIMIT_USER_TITLE", "Get API rate limit"),
+				ReadOnlyHint: true,
+			},
+			// Use json.RawMessage to ensure "properties" is included even when empty.
+			// OpenAI strict mode requires the properties field to be present.
+			InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, _ map[string]any) (*mcp.CallToolResult, any, error) {
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			limits, res, err := client.RateLimit.Get(ctx)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get rate limit",
+					res,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = res.Body.Close() }()
+
+			return MarshalledTextResult(RateLimitStatus{
+				Core:    toRateLimitBucket(limits.Core),
+				Search:  toRateLimitBucket(limits.Search),
+				GraphQL: toRateLimitBucket(limits.GraphQL),
+			}), nil, nil
+		},
+	)
+}
+
 type TeamInfo struct {
 	Name        string `json:"name"`
 	Slug        string `json:"slug"`
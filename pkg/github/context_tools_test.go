@@ -159,6 +159,9 @@ func Test_GetMe_IFC_FeatureFlag(t *testing.T) {
 			translations.NullTranslationHelper,
 			FeatureFlags{},
 			0,
+			0,
+			0,
+			nil,
 			func(_ context.Context, flagName string) (bool, error) {
 				return flagName == FeatureFlagIFCLabels && enabled, nil
 			},
@@ -582,3 +585,88 @@ func Test_GetTeamMembers(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetRateLimit(t *testing.T) {
+	t.Parallel()
+
+	serverTool := GetRateLimit(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_rate_limit", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "get_rate_limit tool should be read-only")
+
+	reset := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		expectToolError    bool
+		expectedStatus     RateLimitStatus
+		expectedToolErrMsg string
+	}{
+		{
+			name: "returns core, search and graphql quotas",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /rate_limit": mockResponse(t, http.StatusOK, map[string]any{
+					"resources": map[string]any{
+						"core":    map[string]any{"limit": 5000, "remaining": 4321, "reset": reset.Unix()},
+						"search":  map[string]any{"limit": 30, "remaining": 12, "reset": reset.Unix()},
+						"graphql": map[string]any{"limit": 5000, "remaining": 4999, "reset": reset.Unix()},
+						"scim":    map[string]any{"limit": 15000, "remaining": 15000, "reset": reset.Unix()},
+					},
+				}),
+			}),
+			expectedStatus: RateLimitStatus{
+				Core:    &RateLimitBucket{Limit: 5000, Remaining: 4321, Reset: reset},
+				Search:  &RateLimitBucket{Limit: 30, Remaining: 12, Reset: reset},
+				GraphQL: &RateLimitBucket{Limit: 5000, Remaining: 4999, Reset: reset},
+			},
+		},
+		{
+			name: "omits categories the server does not report",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /rate_limit": mockResponse(t, http.StatusOK, map[string]any{
+					"resources": map[string]any{
+						"core": map[string]any{"limit": 60, "remaining": 59, "reset": reset.Unix()},
+					},
+				}),
+			}),
+			expectedStatus: RateLimitStatus{
+				Core: &RateLimitBucket{Limit: 60, Remaining: 59, Reset: reset},
+			},
+		},
+		{
+			name: "get rate limit fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /rate_limit": badRequestHandler("expected test failure"),
+			}),
+			expectToolError:    true,
+			expectedToolErrMsg: "expected test failure",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: mustNewGHClient(t, tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				require.True(t, result.IsError, "expected tool call result to be an error")
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedToolErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var status RateLimitStatus
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &status))
+			assert.Equal(t, tc.expectedStatus.Core, status.Core)
+			assert.Equal(t, tc.expectedStatus.Search, status.Search)
+			assert.Equal(t, tc.expectedStatus.GraphQL, status.GraphQL)
+		})
+	}
+}
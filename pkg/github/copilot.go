@@ -196,6 +196,10 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 						Type:        "string",
 						Description: "Optional custom instructions to guide the agent beyond the issue body. Use this to provide additional context, constraints, or guidance that is not captured in the issue description",
 					},
+					"instructions": {
+						Type:        "string",
+						Description: "Optional task guidance to post as an issue comment once Copilot is assigned, so it stays visible on the issue for the agent and for reviewers. The assignment is reported as successful even if the comment cannot be posted",
+					},
 				},
 				Required: []string{"owner", "repo", "issue_number"},
 			},
@@ -208,10 +212,16 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 				IssueNumber        int32  `mapstructure:"issue_number"`
 				BaseRef            string `mapstructure:"base_ref"`
 				CustomInstructions string `mapstructure:"custom_instructions"`
+				Instructions       string `mapstructure:"instructions"`
 			}
 			if err := mapstructure.WeakDecode(args, &params); err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			// The instructions comment is posted after the assignment, so an
+			// over-long one is refused before anything is changed.
+			if _, err := limitBodyLength("instructions", params.Instructions, 0, false); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			client, err := deps.GetGQLClient(ctx)
 			if err != nil {
@@ -247,13 +257,18 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 				"endCursor": (*githubv4.String)(nil),
 			}
 
+			// GraphQL returns the data it could resolve alongside errors for the
+			// fields it could not. Lookups whose data is still usable carry on
+			// and their errors are reported with the result.
+			var partialErrors []string
+
 			var copilotAssignee *botAssignee
 			for {
-				var query suggestedActorsQuery
-				err := client.Query(ctx, &query, variables)
-				if err != nil {
-					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get suggested actors", err), nil, nil
+				if err := ctx.Err(); err != nil {
+					return utils.NewToolResultErrorFromErr("failed to get suggested actors", err), nil, nil
 				}
+				var query suggestedActorsQuery
+				queryErr := client.Query(ctx, &query, variables)
 
 				// Iterate all the returned nodes looking for the copilot bot, which is supposed to have the
 				// same name on each host. We need this in order to get the ID for later assignment.
@@ -264,7 +279,14 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 					}
 				}
 
-				if !query.Repository.SuggestedActors.PageInfo.HasNextPage {
+				if queryErr != nil {
+					if copilotAssignee == nil {
+						return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get suggested actors", queryErr), nil, nil
+					}
+					partialErrors = append(partialErrors, partialDataError(ctx, "failed to get suggested actors", queryErr))
+				}
+
+				if copilotAssignee != nil || !query.Repository.SuggestedActors.PageInfo.HasNextPage {
 					break
 				}
 				variables["endCursor"] = githubv4.String(query.Repository.SuggestedActors.PageInfo.EndCursor)
@@ -281,12 +303,7 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 				Repository struct {
 					ID    githubv4.ID
 					Issue struct {
-						ID        githubv4.ID
-						Assignees struct {
-							Nodes []struct {
-								ID githubv4.ID
-							}
-						} `graphql:"assignees(first: 100)"`
+						ID githubv4.ID
 					} `graphql:"issue(number: $number)"`
 				} `graphql:"repository(owner: $owner, name: $name)"`
 			}
@@ -298,15 +315,14 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 			}
 
 			if err := client.Query(ctx, &getIssueQuery, variables); err != nil {
-				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get issue ID", err), nil, nil
-			}
-
-			// Build the assignee IDs list including copilot
-			actorIDs := make([]githubv4.ID, len(getIssueQuery.Repository.Issue.Assignees.Nodes)+1)
-			for i, node := range getIssueQuery.Repository.Issue.Assignees.Nodes {
-				actorIDs[i] = node.ID
+				if result := issueNotFoundResult(ctx, "failed to get issue ID", err); result != nil {
+					return result, nil, nil
+				}
+				if getIssueQuery.Repository.ID == nil || getIssueQuery.Repository.Issue.ID == nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get issue ID", err), nil, nil
+				}
+				partialErrors = append(partialErrors, partialDataError(ctx, "failed to get issue ID", err))
 			}
-			actorIDs[len(getIssueQuery.Repository.Issue.Assignees.Nodes)] = copilotAssignee.ID
 
 			// Prepare agent assignment input
 			emptyString := githubv4.String("")
@@ -328,16 +344,20 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 				agentAssignment.CustomInstructions = &customInstructions
 			}
 
-			// Execute the updateIssue mutation with the GraphQL-Features header
-			// This header is required for the agent assignment API which is not GA yet
-			var updateIssueMutation struct {
-				UpdateIssue struct {
-					Issue struct {
-						ID     githubv4.ID
-						Number githubv4.Int
-						URL    githubv4.String
+			// Execute the addAssigneesToAssignable mutation with the GraphQL-Features header
+			// This header is required for the agent assignment API which is not GA yet.
+			// The mutation adds Copilot to the existing assignees rather than replacing
+			// them, so assignees added concurrently by someone else are kept.
+			var addAssigneesMutation struct {
+				AddAssigneesToAssignable struct {
+					Assignable struct {
+						Issue struct {
+							ID     githubv4.ID
+							Number githubv4.Int
+							URL    githubv4.String
+						} `graphql:"... on Issue"`
 					}
-				} `graphql:"updateIssue(input: $input)"`
+				} `graphql:"addAssigneesToAssignable(input: $input)"`
 			}
 
 			// Add the GraphQL-Features header for the agent assignment API
@@ -349,10 +369,10 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 
 			if err := client.Mutate(
 				ctxWithFeatures,
-				&updateIssueMutation,
-				UpdateIssueInput{
-					ID:              getIssueQuery.Repository.Issue.ID,
-					AssigneeIDs:     actorIDs,
+				&addAssigneesMutation,
+				AddAssigneesToAssignableInput{
+					AssignableID:    getIssueQuery.Repository.Issue.ID,
+					AssigneeIDs:     []githubv4.ID{copilotAssignee.ID},
 					AgentAssignment: agentAssignment,
 				},
 				nil,
@@ -360,6 +380,14 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 				return nil, nil, fmt.Errorf("failed to update issue with agent assignment: %w", err)
 			}
 
+			// Copilot is assigned at this point, so a failure to post the
+			// instructions is reported alongside the success.
+			var instructionsComment *MinimalResponse
+			var instructionsCommentErr error
+			if strings.TrimSpace(params.Instructions) != "" {
+				instructionsComment, instructionsCommentErr = postCopilotInstructions(ctx, deps, params.Owner, params.Repo, int(params.IssueNumber), params.Instructions)
+			}
+
 			// Poll for a linked PR created by Copilot after the assignment
 			pollConfig := getPollConfig(ctx)
 
@@ -406,12 +434,23 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 			// Build the result
 			result := map[string]any{
 				"message":      "successfully assigned copilot to issue",
-				"issue_number": int(updateIssueMutation.UpdateIssue.Issue.Number),
-				"issue_url":    string(updateIssueMutation.UpdateIssue.Issue.URL),
+				"issue_number": int(addAssigneesMutation.AddAssigneesToAssignable.Assignable.Issue.Number),
+				"issue_url":    string(addAssigneesMutation.AddAssigneesToAssignable.Assignable.Issue.URL),
 				"owner":        params.Owner,
 				"repo":         params.Repo,
 			}
 
+			if instructionsComment != nil {
+				result["comment"] = instructionsComment
+			}
+			if instructionsCommentErr != nil {
+				result["comment_error"] = fmt.Sprintf("copilot was assigned but failed to post the instructions comment: %v", instructionsCommentErr)
+			}
+			if len(partialErrors) > 0 {
+				result["partial"] = true
+				result["partial_errors"] = partialErrors
+			}
+
 			// Add PR info if found during polling
 			if linkedPR != nil {
 				result["pull_request"] = map[string]any{
@@ -435,6 +474,28 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 		})
 }
 
+// postCopilotInstructions posts instructions as a comment on the issue
+// Copilot was assigned to.
+func postCopilotInstructions(ctx context.Context, deps ToolDependencies, owner, repo string, issueNumber int, instructions string) (*MinimalResponse, error) {
+	client, err := deps.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+	comment, resp, err := client.Issues.CreateComment(ctx, owner, repo, issueNumber, &github.IssueComment{
+		Body: github.Ptr(instructions),
+	})
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &MinimalResponse{
+		ID:  fmt.Sprintf("%d", comment.GetID()),
+		URL: comment.GetHTMLURL(),
+	}, nil
+}
+
 type ReplaceActorsForAssignableInput struct {
 	AssignableID githubv4.ID   `json:"assignableId"`
 	ActorIDs     []githubv4.ID `json:"actorIds"`
@@ -448,9 +509,10 @@ type AgentAssignmentInput struct {
 	TargetRepositoryID githubv4.ID      `json:"targetRepositoryId"`
 }
 
-// UpdateIssueInput represents the input for updating an issue with agent assignment.
-type UpdateIssueInput struct {
-	ID              githubv4.ID           `json:"id"`
+// AddAssigneesToAssignableInput represents the input for adding assignees to an
+// issue with agent assignment.
+type AddAssigneesToAssignableInput struct {
+	AssignableID    githubv4.ID           `json:"assignableId"`
 	AssigneeIDs     []githubv4.ID         `json:"assigneeIds"`
 	AgentAssignment *AgentAssignmentInput `json:"agentAssignment,omitempty"`
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
@@ -32,6 +33,7 @@ func TestAssignCopilotToIssue(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "issue_number")
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "base_ref")
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "custom_instructions")
+	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "instructions")
 	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
 
 	// Helper function to create pointer to githubv4.String
@@ -106,12 +108,7 @@ func TestAssignCopilotToIssue(t *testing.T) {
 						Repository struct {
 							ID    githubv4.ID
 							Issue struct {
-								ID        githubv4.ID
-								Assignees struct {
-									Nodes []struct {
-										ID githubv4.ID
-									}
-								} `graphql:"assignees(first: 100)"`
+								ID githubv4.ID
 							} `graphql:"issue(number: $number)"`
 						} `graphql:"repository(owner: $owner, name: $name)"`
 					}{},
@@ -125,26 +122,25 @@ func TestAssignCopilotToIssue(t *testing.T) {
 							"id": githubv4.ID("test-repo-id"),
 							"issue": map[string]any{
 								"id": githubv4.ID("test-issue-id"),
-								"assignees": map[string]any{
-									"nodes": []any{},
-								},
 							},
 						},
 					}),
 				),
 				githubv4mock.NewMutationMatcher(
 					struct {
-						UpdateIssue struct {
-							Issue struct {
-								ID     githubv4.ID
-								Number githubv4.Int
-								URL    githubv4.String
+						AddAssigneesToAssignable struct {
+							Assignable struct {
+								Issue struct {
+									ID     githubv4.ID
+									Number githubv4.Int
+									URL    githubv4.String
+								} `graphql:"... on Issue"`
 							}
-						} `graphql:"updateIssue(input: $input)"`
+						} `graphql:"addAssigneesToAssignable(input: $input)"`
 					}{},
-					UpdateIssueInput{
-						ID:          githubv4.ID("test-issue-id"),
-						AssigneeIDs: []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+					AddAssigneesToAssignableInput{
+						AssignableID: githubv4.ID("test-issue-id"),
+						AssigneeIDs:  []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
 						AgentAssignment: &AgentAssignmentInput{
 							BaseRef:            nil,
 							CustomAgent:        ptrGitHubv4String(""),
@@ -154,8 +150,8 @@ func TestAssignCopilotToIssue(t *testing.T) {
 					},
 					nil,
 					githubv4mock.DataResponse(map[string]any{
-						"updateIssue": map[string]any{
-							"issue": map[string]any{
+						"addAssigneesToAssignable": map[string]any{
+							"assignable": map[string]any{
 								"id":     githubv4.ID("test-issue-id"),
 								"number": githubv4.Int(123),
 								"url":    githubv4.String("https://github.com/owner/repo/issues/123"),
@@ -215,12 +211,7 @@ func TestAssignCopilotToIssue(t *testing.T) {
 						Repository struct {
 							ID    githubv4.ID
 							Issue struct {
-								ID        githubv4.ID
-								Assignees struct {
-									Nodes []struct {
-										ID githubv4.ID
-									}
-								} `graphql:"assignees(first: 100)"`
+								ID githubv4.ID
 							} `graphql:"issue(number: $number)"`
 						} `graphql:"repository(owner: $owner, name: $name)"`
 					}{},
@@ -234,26 +225,25 @@ func TestAssignCopilotToIssue(t *testing.T) {
 							"id": githubv4.ID("test-repo-id"),
 							"issue": map[string]any{
 								"id": githubv4.ID("test-issue-id"),
-								"assignees": map[string]any{
-									"nodes": []any{},
-								},
 							},
 						},
 					}),
 				),
 				githubv4mock.NewMutationMatcher(
 					struct {
-						UpdateIssue struct {
-							Issue struct {
-								ID     githubv4.ID
-								Number githubv4.Int
-								URL    githubv4.String
+						AddAssigneesToAssignable struct {
+							Assignable struct {
+								Issue struct {
+									ID     githubv4.ID
+									Number githubv4.Int
+									URL    githubv4.String
+								} `graphql:"... on Issue"`
 							}
-						} `graphql:"updateIssue(input: $input)"`
+						} `graphql:"addAssigneesToAssignable(input: $input)"`
 					}{},
-					UpdateIssueInput{
-						ID:          githubv4.ID("test-issue-id"),
-						AssigneeIDs: []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+					AddAssigneesToAssignableInput{
+						AssignableID: githubv4.ID("test-issue-id"),
+						AssigneeIDs:  []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
 						AgentAssignment: &AgentAssignmentInput{
 							BaseRef:            nil,
 							CustomAgent:        ptrGitHubv4String(""),
@@ -263,8 +253,8 @@ func TestAssignCopilotToIssue(t *testing.T) {
 					},
 					nil,
 					githubv4mock.DataResponse(map[string]any{
-						"updateIssue": map[string]any{
-							"issue": map[string]any{
+						"addAssigneesToAssignable": map[string]any{
+							"assignable": map[string]any{
 								"id":     githubv4.ID("test-issue-id"),
 								"number": githubv4.Int(123),
 								"url":    githubv4.String("https://github.com/owner/repo/issues/123"),
@@ -275,13 +265,14 @@ func TestAssignCopilotToIssue(t *testing.T) {
 			),
 		},
 		{
-			name: "successful assignment when there are existing assignees",
+			name: "copilot bot not on first page of suggested actors",
 			requestArgs: map[string]any{
 				"owner":        "owner",
 				"repo":         "repo",
 				"issue_number": float64(123),
 			},
 			mockedClient: githubv4mock.NewMockedHTTPClient(
+				// First page of suggested actors
 				githubv4mock.NewQueryMatcher(
 					struct {
 						Repository struct {
@@ -305,6 +296,42 @@ func TestAssignCopilotToIssue(t *testing.T) {
 						"name":      githubv4.String("repo"),
 						"endCursor": (*githubv4.String)(nil),
 					},
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"suggestedActors": map[string]any{
+								"nodes": pageOfFakeBots(100),
+								"pageInfo": map[string]any{
+									"hasNextPage": true,
+									"endCursor":   githubv4.String("next-page-cursor"),
+								},
+							},
+						},
+					}),
+				),
+				// Second page of suggested actors
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							SuggestedActors struct {
+								Nodes []struct {
+									Bot struct {
+										ID       githubv4.ID
+										Login    githubv4.String
+										TypeName string `graphql:"__typename"`
+									} `graphql:"... on Bot"`
+								}
+								PageInfo struct {
+									HasNextPage bool
+									EndCursor   string
+								}
+							} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
+						} `graphql:"repository(owner: $owner, name: $name)"`
+					}{},
+					map[string]any{
+						"owner":     githubv4.String("owner"),
+						"name":      githubv4.String("repo"),
+						"endCursor": githubv4.String("next-page-cursor"),
+					},
 					githubv4mock.DataResponse(map[string]any{
 						"repository": map[string]any{
 							"suggestedActors": map[string]any{
@@ -324,12 +351,7 @@ func TestAssignCopilotToIssue(t *testing.T) {
 						Repository struct {
 							ID    githubv4.ID
 							Issue struct {
-								ID        githubv4.ID
-								Assignees struct {
-									Nodes []struct {
-										ID githubv4.ID
-									}
-								} `graphql:"assignees(first: 100)"`
+								ID githubv4.ID
 							} `graphql:"issue(number: $number)"`
 						} `graphql:"repository(owner: $owner, name: $name)"`
 					}{},
@@ -343,37 +365,25 @@ func TestAssignCopilotToIssue(t *testing.T) {
 							"id": githubv4.ID("test-repo-id"),
 							"issue": map[string]any{
 								"id": githubv4.ID("test-issue-id"),
-								"assignees": map[string]any{
-									"nodes": []any{
-										map[string]any{
-											"id": githubv4.ID("existing-assignee-id"),
-										},
-										map[string]any{
-											"id": githubv4.ID("existing-assignee-id-2"),
-										},
-									},
-								},
 							},
 						},
 					}),
 				),
 				githubv4mock.NewMutationMatcher(
 					struct {
-						UpdateIssue struct {
-							Issue struct {
-								ID     githubv4.ID
-								Number githubv4.Int
-								URL    githubv4.String
+						AddAssigneesToAssignable struct {
+							Assignable struct {
+								Issue struct {
+									ID     githubv4.ID
+									Number githubv4.Int
+									URL    githubv4.String
+								} `graphql:"... on Issue"`
 							}
-						} `graphql:"updateIssue(input: $input)"`
+						} `graphql:"addAssigneesToAssignable(input: $input)"`
 					}{},
-					UpdateIssueInput{
-						ID: githubv4.ID("test-issue-id"),
-						AssigneeIDs: []githubv4.ID{
-							githubv4.ID("existing-assignee-id"),
-							githubv4.ID("existing-assignee-id-2"),
-							githubv4.ID("copilot-swe-agent-id"),
-						},
+					AddAssigneesToAssignableInput{
+						AssignableID: githubv4.ID("test-issue-id"),
+						AssigneeIDs:  []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
 						AgentAssignment: &AgentAssignmentInput{
 							BaseRef:            nil,
 							CustomAgent:        ptrGitHubv4String(""),
@@ -383,8 +393,8 @@ func TestAssignCopilotToIssue(t *testing.T) {
 					},
 					nil,
 					githubv4mock.DataResponse(map[string]any{
-						"updateIssue": map[string]any{
-							"issue": map[string]any{
+						"addAssigneesToAssignable": map[string]any{
+							"assignable": map[string]any{
 								"id":     githubv4.ID("test-issue-id"),
 								"number": githubv4.Int(123),
 								"url":    githubv4.String("https://github.com/owner/repo/issues/123"),
@@ -395,14 +405,13 @@ func TestAssignCopilotToIssue(t *testing.T) {
 			),
 		},
 		{
-			name: "copilot bot not on first page of suggested actors",
+			name: "copilot not a suggested actor",
 			requestArgs: map[string]any{
 				"owner":        "owner",
 				"repo":         "repo",
 				"issue_number": float64(123),
 			},
 			mockedClient: githubv4mock.NewMockedHTTPClient(
-				// First page of suggested actors
 				githubv4mock.NewQueryMatcher(
 					struct {
 						Repository struct {
@@ -429,16 +438,23 @@ func TestAssignCopilotToIssue(t *testing.T) {
 					githubv4mock.DataResponse(map[string]any{
 						"repository": map[string]any{
 							"suggestedActors": map[string]any{
-								"nodes": pageOfFakeBots(100),
-								"pageInfo": map[string]any{
-									"hasNextPage": true,
-									"endCursor":   githubv4.String("next-page-cursor"),
-								},
+								"nodes": []any{},
 							},
 						},
 					}),
 				),
-				// Second page of suggested actors
+			),
+			expectToolError:    true,
+			expectedToolErrMsg: "copilot isn't available as an assignee for this issue. Please inform the user to visit https://docs.github.com/en/copilot/using-github-copilot/using-copilot-coding-agent-to-work-on-tasks/about-assigning-tasks-to-copilot for more information.",
+		},
+		{
+			name: "issue not found",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+			},
+			mockedClient: githubv4mock.NewMockedHTTPClient(
 				githubv4mock.NewQueryMatcher(
 					struct {
 						Repository struct {
@@ -460,7 +476,7 @@ func TestAssignCopilotToIssue(t *testing.T) {
 					map[string]any{
 						"owner":     githubv4.String("owner"),
 						"name":      githubv4.String("repo"),
-						"endCursor": githubv4.String("next-page-cursor"),
+						"endCursor": (*githubv4.String)(nil),
 					},
 					githubv4mock.DataResponse(map[string]any{
 						"repository": map[string]any{
@@ -481,107 +497,20 @@ func TestAssignCopilotToIssue(t *testing.T) {
 						Repository struct {
 							ID    githubv4.ID
 							Issue struct {
-								ID        githubv4.ID
-								Assignees struct {
-									Nodes []struct {
-										ID githubv4.ID
-									}
-								} `graphql:"assignees(first: 100)"`
+								ID githubv4.ID
 							} `graphql:"issue(number: $number)"`
 						} `graphql:"repository(owner: $owner, name: $name)"`
 					}{},
 					map[string]any{
 						"owner":  githubv4.String("owner"),
 						"name":   githubv4.String("repo"),
-						"number": githubv4.Int(123),
+						"number": githubv4.Int(999),
 					},
-					githubv4mock.DataResponse(map[string]any{
-						"repository": map[string]any{
-							"id": githubv4.ID("test-repo-id"),
-							"issue": map[string]any{
-								"id": githubv4.ID("test-issue-id"),
-								"assignees": map[string]any{
-									"nodes": []any{},
-								},
-							},
-						},
-					}),
-				),
-				githubv4mock.NewMutationMatcher(
-					struct {
-						UpdateIssue struct {
-							Issue struct {
-								ID     githubv4.ID
-								Number githubv4.Int
-								URL    githubv4.String
-							}
-						} `graphql:"updateIssue(input: $input)"`
-					}{},
-					UpdateIssueInput{
-						ID:          githubv4.ID("test-issue-id"),
-						AssigneeIDs: []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
-						AgentAssignment: &AgentAssignmentInput{
-							BaseRef:            nil,
-							CustomAgent:        ptrGitHubv4String(""),
-							CustomInstructions: ptrGitHubv4String(""),
-							TargetRepositoryID: githubv4.ID("test-repo-id"),
-						},
-					},
-					nil,
-					githubv4mock.DataResponse(map[string]any{
-						"updateIssue": map[string]any{
-							"issue": map[string]any{
-								"id":     githubv4.ID("test-issue-id"),
-								"number": githubv4.Int(123),
-								"url":    githubv4.String("https://github.com/owner/repo/issues/123"),
-							},
-						},
-					}),
-				),
-			),
-		},
-		{
-			name: "copilot not a suggested actor",
-			requestArgs: map[string]any{
-				"owner":        "owner",
-				"repo":         "repo",
-				"issue_number": float64(123),
-			},
-			mockedClient: githubv4mock.NewMockedHTTPClient(
-				githubv4mock.NewQueryMatcher(
-					struct {
-						Repository struct {
-							SuggestedActors struct {
-								Nodes []struct {
-									Bot struct {
-										ID       githubv4.ID
-										Login    githubv4.String
-										TypeName string `graphql:"__typename"`
-									} `graphql:"... on Bot"`
-								}
-								PageInfo struct {
-									HasNextPage bool
-									EndCursor   string
-								}
-							} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
-						} `graphql:"repository(owner: $owner, name: $name)"`
-					}{},
-					map[string]any{
-						"owner":     githubv4.String("owner"),
-						"name":      githubv4.String("repo"),
-						"endCursor": (*githubv4.String)(nil),
-					},
-					githubv4mock.DataResponse(map[string]any{
-						"repository": map[string]any{
-							"suggestedActors": map[string]any{
-								"nodes": []any{},
-							},
-						},
-					}),
+					githubv4mock.ErrorResponse("Could not resolve to an Issue with the number of 999."),
 				),
 			),
 			expectToolError:    true,
-			expectedToolErrMsg: "copilot isn't available as an assignee for this issue. Please inform the user to visit https://docs.github.com/en/copilot/using-github-copilot/using-copilot-coding-agent-to-work-on-tasks/about-assigning-tasks-to-copilot for more information.",
+			expectedToolErrMsg: "issue #999 not found",
 		},
 		{
 			name: "successful assignment with base_ref specified",
@@ -634,12 +563,7 @@ func TestAssignCopilotToIssue(t *testing.T) {
 						Repository struct {
 							ID    githubv4.ID
 							Issue struct {
-								ID        githubv4.ID
-								Assignees struct {
-									Nodes []struct {
-										ID githubv4.ID
-									}
-								} `graphql:"assignees(first: 100)"`
+								ID githubv4.ID
 							} `graphql:"issue(number: $number)"`
 						} `graphql:"repository(owner: $owner, name: $name)"`
 					}{},
@@ -653,26 +577,25 @@ func TestAssignCopilotToIssue(t *testing.T) {
 							"id": githubv4.ID("test-repo-id"),
 							"issue": map[string]any{
 								"id": githubv4.ID("test-issue-id"),
-								"assignees": map[string]any{
-									"nodes": []any{},
-								},
 							},
 						},
 					}),
 				),
 				githubv4mock.NewMutationMatcher(
 					struct {
-						UpdateIssue struct {
-							Issue struct {
-								ID     githubv4.ID
-								Number githubv4.Int
-								URL    githubv4.String
+						AddAssigneesToAssignable struct {
+							Assignable struct {
+								Issue struct {
+									ID     githubv4.ID
+									Number githubv4.Int
+									URL    githubv4.String
+								} `graphql:"... on Issue"`
 							}
-						} `graphql:"updateIssue(input: $input)"`
+						} `graphql:"addAssigneesToAssignable(input: $input)"`
 					}{},
-					UpdateIssueInput{
-						ID:          githubv4.ID("test-issue-id"),
-						AssigneeIDs: []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+					AddAssigneesToAssignableInput{
+						AssignableID: githubv4.ID("test-issue-id"),
+						AssigneeIDs:  []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
 						AgentAssignment: &AgentAssignmentInput{
 							BaseRef:            ptrGitHubv4String("feature-branch"),
 							CustomAgent:        ptrGitHubv4String(""),
@@ -682,8 +605,8 @@ func TestAssignCopilotToIssue(t *testing.T) {
 					},
 					nil,
 					githubv4mock.DataResponse(map[string]any{
-						"updateIssue": map[string]any{
-							"issue": map[string]any{
+						"addAssigneesToAssignable": map[string]any{
+							"assignable": map[string]any{
 								"id":     githubv4.ID("test-issue-id"),
 								"number": githubv4.Int(123),
 								"url":    githubv4.String("https://github.com/owner/repo/issues/123"),
@@ -744,12 +667,7 @@ func TestAssignCopilotToIssue(t *testing.T) {
 						Repository struct {
 							ID    githubv4.ID
 							Issue struct {
-								ID        githubv4.ID
-								Assignees struct {
-									Nodes []struct {
-										ID githubv4.ID
-									}
-								} `graphql:"assignees(first: 100)"`
+								ID githubv4.ID
 							} `graphql:"issue(number: $number)"`
 						} `graphql:"repository(owner: $owner, name: $name)"`
 					}{},
@@ -763,26 +681,25 @@ func TestAssignCopilotToIssue(t *testing.T) {
 							"id": githubv4.ID("test-repo-id"),
 							"issue": map[string]any{
 								"id": githubv4.ID("test-issue-id"),
-								"assignees": map[string]any{
-									"nodes": []any{},
-								},
 							},
 						},
 					}),
 				),
 				githubv4mock.NewMutationMatcher(
 					struct {
-						UpdateIssue struct {
-							Issue struct {
-								ID     githubv4.ID
-								Number githubv4.Int
-								URL    githubv4.String
+						AddAssigneesToAssignable struct {
+							Assignable struct {
+								Issue struct {
+									ID     githubv4.ID
+									Number githubv4.Int
+									URL    githubv4.String
+								} `graphql:"... on Issue"`
 							}
-						} `graphql:"updateIssue(input: $input)"`
+						} `graphql:"addAssigneesToAssignable(input: $input)"`
 					}{},
-					UpdateIssueInput{
-						ID:          githubv4.ID("test-issue-id"),
-						AssigneeIDs: []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+					AddAssigneesToAssignableInput{
+						AssignableID: githubv4.ID("test-issue-id"),
+						AssigneeIDs:  []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
 						AgentAssignment: &AgentAssignmentInput{
 							BaseRef:            nil,
 							CustomAgent:        ptrGitHubv4String(""),
@@ -792,8 +709,8 @@ func TestAssignCopilotToIssue(t *testing.T) {
 					},
 					nil,
 					githubv4mock.DataResponse(map[string]any{
-						"updateIssue": map[string]any{
-							"issue": map[string]any{
+						"addAssigneesToAssignable": map[string]any{
+							"assignable": map[string]any{
 								"id":     githubv4.ID("test-issue-id"),
 								"number": githubv4.Int(123),
 								"url":    githubv4.String("https://github.com/owner/repo/issues/123"),
@@ -850,6 +767,433 @@ func TestAssignCopilotToIssue(t *testing.T) {
 	}
 }
 
+func TestAssignCopilotToIssue_ConcurrentAssigneeChange(t *testing.T) {
+	t.Parallel()
+
+	ptrGitHubv4String := func(s string) *githubv4.String {
+		v := githubv4.String(s)
+		return &v
+	}
+
+	// The issue is read while alice is its only assignee, then bob is
+	// assigned by someone else before Copilot is. The mutation must only add
+	// Copilot, so neither alice nor bob is dropped by the write.
+	mockedClient := githubv4mock.NewMockedHTTPClient(
+		githubv4mock.NewQueryMatcher(
+			struct {
+				Repository struct {
+					SuggestedActors struct {
+						Nodes []struct {
+							Bot struct {
+								ID       githubv4.ID
+								Login    githubv4.String
+								TypeName string `graphql:"__typename"`
+							} `graphql:"... on Bot"`
+						}
+						PageInfo struct {
+							HasNextPage bool
+							EndCursor   string
+						}
+					} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
+				} `graphql:"repository(owner: $owner, name: $name)"`
+			}{},
+			map[string]any{
+				"owner":     githubv4.String("owner"),
+				"name":      githubv4.String("repo"),
+				"endCursor": (*githubv4.String)(nil),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"suggestedActors": map[string]any{
+						"nodes": []any{
+							map[string]any{"id": "copilot-swe-agent-id", "login": "copilot-swe-agent", "__typename": "Bot"},
+						},
+					},
+				},
+			}),
+		),
+		githubv4mock.NewQueryMatcher(
+			struct {
+				Repository struct {
+					ID    githubv4.ID
+					Issue struct {
+						ID githubv4.ID
+					} `graphql:"issue(number: $number)"`
+				} `graphql:"repository(owner: $owner, name: $name)"`
+			}{},
+			map[string]any{
+				"owner":  githubv4.String("owner"),
+				"name":   githubv4.String("repo"),
+				"number": githubv4.Int(123),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"id":    "test-repo-id",
+					"issue": map[string]any{"id": "test-issue-id"},
+				},
+			}),
+		),
+		githubv4mock.NewMutationMatcher(
+			struct {
+				AddAssigneesToAssignable struct {
+					Assignable struct {
+						Issue struct {
+							ID     githubv4.ID
+							Number githubv4.Int
+							URL    githubv4.String
+						} `graphql:"... on Issue"`
+					}
+				} `graphql:"addAssigneesToAssignable(input: $input)"`
+			}{},
+			AddAssigneesToAssignableInput{
+				AssignableID: githubv4.ID("test-issue-id"),
+				AssigneeIDs:  []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+				AgentAssignment: &AgentAssignmentInput{
+					CustomAgent:        ptrGitHubv4String(""),
+					CustomInstructions: ptrGitHubv4String(""),
+					TargetRepositoryID: githubv4.ID("test-repo-id"),
+				},
+			},
+			nil,
+			githubv4mock.DataResponse(map[string]any{
+				"addAssigneesToAssignable": map[string]any{
+					"assignable": map[string]any{"id": "test-issue-id", "number": 123, "url": "https://github.com/owner/repo/issues/123"},
+				},
+			}),
+		),
+	)
+
+	serverTool := AssignCopilotToIssue(translations.NullTranslationHelper)
+	deps := BaseDeps{GQLClient: githubv4.NewClient(mockedClient)}
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(123),
+	})
+
+	ctx := ContextWithPollConfig(context.Background(), PollConfig{MaxAttempts: 0})
+	result, err := handler(ContextWithDeps(ctx, deps), &request)
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+}
+
+func TestAssignCopilotToIssue_PartialData(t *testing.T) {
+	t.Parallel()
+
+	ptrGitHubv4String := func(s string) *githubv4.String {
+		v := githubv4.String(s)
+		return &v
+	}
+	suggestedActorsMatcher := githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				SuggestedActors struct {
+					Nodes []struct {
+						Bot struct {
+							ID       githubv4.ID
+							Login    githubv4.String
+							TypeName string `graphql:"__typename"`
+						} `graphql:"... on Bot"`
+					}
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
+			} `graphql:"repository(owner: $owner, name: $name)"`
+		}{},
+		map[string]any{
+			"owner":     githubv4.String("owner"),
+			"name":      githubv4.String("repo"),
+			"endCursor": (*githubv4.String)(nil),
+		},
+		// One actor failed to resolve, but Copilot is among the others.
+		githubv4mock.PartialResponse(map[string]any{
+			"repository": map[string]any{
+				"suggestedActors": map[string]any{
+					"nodes": []any{
+						nil,
+						map[string]any{"id": "copilot-swe-agent-id", "login": "copilot-swe-agent", "__typename": "Bot"},
+					},
+				},
+			},
+		}, "Something went wrong while executing your query."),
+	)
+	issueMatcher := func(response githubv4mock.GQLResponse) githubv4mock.Matcher {
+		return githubv4mock.NewQueryMatcher(
+			struct {
+				Repository struct {
+					ID    githubv4.ID
+					Issue struct {
+						ID githubv4.ID
+					} `graphql:"issue(number: $number)"`
+				} `graphql:"repository(owner: $owner, name: $name)"`
+			}{},
+			map[string]any{
+				"owner":  githubv4.String("owner"),
+				"name":   githubv4.String("repo"),
+				"number": githubv4.Int(123),
+			},
+			response,
+		)
+	}
+
+	serverTool := AssignCopilotToIssue(translations.NullTranslationHelper)
+	request := createMCPRequest(map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(123),
+	})
+	ctx := ContextWithPollConfig(context.Background(), PollConfig{MaxAttempts: 0})
+
+	t.Run("assigns with partial suggested actors and reports the errors", func(t *testing.T) {
+		t.Parallel()
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			suggestedActorsMatcher,
+			issueMatcher(githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"id": "test-repo-id",
+					"issue": map[string]any{
+						"id": "test-issue-id",
+					},
+				},
+			})),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					AddAssigneesToAssignable struct {
+						Assignable struct {
+							Issue struct {
+								ID     githubv4.ID
+								Number githubv4.Int
+								URL    githubv4.String
+							} `graphql:"... on Issue"`
+						}
+					} `graphql:"addAssigneesToAssignable(input: $input)"`
+				}{},
+				AddAssigneesToAssignableInput{
+					AssignableID: githubv4.ID("test-issue-id"),
+					AssigneeIDs:  []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+					AgentAssignment: &AgentAssignmentInput{
+						CustomAgent:        ptrGitHubv4String(""),
+						CustomInstructions: ptrGitHubv4String(""),
+						TargetRepositoryID: githubv4.ID("test-repo-id"),
+					},
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"addAssigneesToAssignable": map[string]any{
+						"assignable": map[string]any{"id": "test-issue-id", "number": 123, "url": "https://github.com/owner/repo/issues/123"},
+					},
+				}),
+			),
+		)
+		deps := BaseDeps{GQLClient: githubv4.NewClient(mockedClient)}
+		result, err := serverTool.Handler(deps)(ContextWithDeps(ctx, deps), &request)
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+		require.False(t, result.IsError, textContent.Text)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+		assert.Equal(t, true, response["partial"])
+		assert.Equal(t, []any{"failed to get suggested actors: Something went wrong while executing your query."}, response["partial_errors"])
+	})
+
+	t.Run("does not assign when the issue failed to resolve", func(t *testing.T) {
+		t.Parallel()
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			suggestedActorsMatcher,
+			issueMatcher(githubv4mock.PartialResponse(map[string]any{
+				"repository": map[string]any{
+					"id":    "test-repo-id",
+					"issue": nil,
+				},
+			}, "Something went wrong while executing your query.")),
+		)
+		deps := BaseDeps{GQLClient: githubv4.NewClient(mockedClient)}
+		result, err := serverTool.Handler(deps)(ContextWithDeps(ctx, deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to get issue ID")
+	})
+}
+
+func TestAssignCopilotToIssue_Instructions(t *testing.T) {
+	t.Parallel()
+
+	ptrGitHubv4String := func(s string) *githubv4.String {
+		v := githubv4.String(s)
+		return &v
+	}
+	mockedGQLClient := func() *http.Client {
+		return githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				struct {
+					Repository struct {
+						SuggestedActors struct {
+							Nodes []struct {
+								Bot struct {
+									ID       githubv4.ID
+									Login    githubv4.String
+									TypeName string `graphql:"__typename"`
+								} `graphql:"... on Bot"`
+							}
+							PageInfo struct {
+								HasNextPage bool
+								EndCursor   string
+							}
+						} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
+					} `graphql:"repository(owner: $owner, name: $name)"`
+				}{},
+				map[string]any{
+					"owner":     githubv4.String("owner"),
+					"name":      githubv4.String("repo"),
+					"endCursor": (*githubv4.String)(nil),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"repository": map[string]any{
+						"suggestedActors": map[string]any{
+							"nodes": []any{
+								map[string]any{"id": "copilot-swe-agent-id", "login": "copilot-swe-agent", "__typename": "Bot"},
+							},
+						},
+					},
+				}),
+			),
+			githubv4mock.NewQueryMatcher(
+				struct {
+					Repository struct {
+						ID    githubv4.ID
+						Issue struct {
+							ID githubv4.ID
+						} `graphql:"issue(number: $number)"`
+					} `graphql:"repository(owner: $owner, name: $name)"`
+				}{},
+				map[string]any{
+					"owner":  githubv4.String("owner"),
+					"name":   githubv4.String("repo"),
+					"number": githubv4.Int(123),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"repository": map[string]any{
+						"id": "test-repo-id",
+						"issue": map[string]any{
+							"id": "test-issue-id",
+						},
+					},
+				}),
+			),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					AddAssigneesToAssignable struct {
+						Assignable struct {
+							Issue struct {
+								ID     githubv4.ID
+								Number githubv4.Int
+								URL    githubv4.String
+							} `graphql:"... on Issue"`
+						}
+					} `graphql:"addAssigneesToAssignable(input: $input)"`
+				}{},
+				AddAssigneesToAssignableInput{
+					AssignableID: githubv4.ID("test-issue-id"),
+					AssigneeIDs:  []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+					AgentAssignment: &AgentAssignmentInput{
+						CustomAgent:        ptrGitHubv4String(""),
+						CustomInstructions: ptrGitHubv4String(""),
+						TargetRepositoryID: githubv4.ID("test-repo-id"),
+					},
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"addAssigneesToAssignable": map[string]any{
+						"assignable": map[string]any{"id": "test-issue-id", "number": 123, "url": "https://github.com/owner/repo/issues/123"},
+					},
+				}),
+			),
+		)
+	}
+
+	serverTool := AssignCopilotToIssue(translations.NullTranslationHelper)
+	request := createMCPRequest(map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(123),
+		"instructions": "Keep the public API unchanged and add a regression test.",
+	})
+	ctx := ContextWithPollConfig(context.Background(), PollConfig{MaxAttempts: 0})
+
+	tests := []struct {
+		name             string
+		commentHandler   http.HandlerFunc
+		expectedComment  map[string]any
+		expectedErrorMsg string
+	}{
+		{
+			name: "posts the instructions after assigning",
+			commentHandler: expectPath(t, "/repos/owner/repo/issues/123/comments").andThen(
+				expectRequestBody(t, map[string]any{"body": "Keep the public API unchanged and add a regression test."}).andThen(
+					mockResponse(t, http.StatusCreated, &github.IssueComment{
+						ID:      github.Ptr(int64(42)),
+						HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123#issuecomment-42"),
+					}),
+				),
+			),
+			expectedComment: map[string]any{"id": "42", "url": "https://github.com/owner/repo/issues/123#issuecomment-42"},
+		},
+		{
+			name:             "reports a failed comment alongside the assignment",
+			commentHandler:   mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+			expectedErrorMsg: "copilot was assigned but failed to post the instructions comment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					PostReposIssuesCommentsByOwnerByRepoByIssueNumber: tc.commentHandler,
+				})),
+				GQLClient: githubv4.NewClient(mockedGQLClient()),
+			}
+			result, err := serverTool.Handler(deps)(ContextWithDeps(ctx, deps), &request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
+
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Contains(t, response["message"], "successfully assigned copilot to issue")
+			if tc.expectedComment != nil {
+				assert.Equal(t, tc.expectedComment, response["comment"])
+				assert.NotContains(t, response, "comment_error")
+				return
+			}
+			assert.NotContains(t, response, "comment")
+			assert.Contains(t, response["comment_error"], tc.expectedErrorMsg)
+		})
+	}
+
+	t.Run("refuses over-long instructions before assigning", func(t *testing.T) {
+		t.Parallel()
+		deps := BaseDeps{
+			Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(nil)),
+			GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient()),
+		}
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(123),
+			"instructions": strings.Repeat("x", MaxBodyLength+1),
+		})
+		result, err := serverTool.Handler(deps)(ContextWithDeps(ctx, deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "instructions is 65,537 characters")
+	})
+}
+
 func Test_RequestCopilotReview(t *testing.T) {
 	t.Parallel()
 
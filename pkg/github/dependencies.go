@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -95,6 +96,18 @@ type ToolDependencies interface {
 	// GetContentWindowSize returns the content window size for log truncation
 	GetContentWindowSize() int
 
+	// GetDefaultPageSize returns the configured default page size for list tools,
+	// or zero when each tool should use its own default
+	GetDefaultPageSize() int
+
+	// GetMaxResponseBytes returns the configured size limit of guarded tool
+	// results, or zero to use DefaultMaxResponseBytes
+	GetMaxResponseBytes() int
+
+	// GetRepoPolicy returns the repository allow/deny policy, or nil when
+	// every repository the token can reach is permitted
+	GetRepoPolicy() *RepoPolicy
+
 	// IsFeatureEnabled checks if a feature flag is enabled.
 	IsFeatureEnabled(ctx context.Context, flagName string) bool
 
@@ -121,6 +134,9 @@ type BaseDeps struct {
 	T                 translations.TranslationHelperFunc
 	Flags             FeatureFlags
 	ContentWindowSize int
+	DefaultPageSize   int
+	MaxResponseBytes  int
+	RepoPolicy        *RepoPolicy
 
 	// Feature flag checker for runtime checks
 	featureChecker inventory.FeatureFlagChecker
@@ -141,6 +157,9 @@ func NewBaseDeps(
 	t translations.TranslationHelperFunc,
 	flags FeatureFlags,
 	contentWindowSize int,
+	defaultPageSize int,
+	maxResponseBytes int,
+	repoPolicy *RepoPolicy,
 	featureChecker inventory.FeatureFlagChecker,
 	obsv observability.Exporters,
 ) *BaseDeps {
@@ -152,6 +171,9 @@ func NewBaseDeps(
 		T:                 t,
 		Flags:             flags,
 		ContentWindowSize: contentWindowSize,
+		DefaultPageSize:   defaultPageSize,
+		MaxResponseBytes:  maxResponseBytes,
+		RepoPolicy:        repoPolicy,
 		featureChecker:    featureChecker,
 		Obsv:              obsv,
 	}
@@ -186,6 +208,15 @@ func (d BaseDeps) GetFlags(_ context.Context) FeatureFlags { return d.Flags }
 // GetContentWindowSize implements ToolDependencies.
 func (d BaseDeps) GetContentWindowSize() int { return d.ContentWindowSize }
 
+// GetDefaultPageSize implements ToolDependencies.
+func (d BaseDeps) GetDefaultPageSize() int { return d.DefaultPageSize }
+
+// GetMaxResponseBytes implements ToolDependencies.
+func (d BaseDeps) GetMaxResponseBytes() int { return d.MaxResponseBytes }
+
+// GetRepoPolicy implements ToolDependencies.
+func (d BaseDeps) GetRepoPolicy() *RepoPolicy { return d.RepoPolicy }
+
 // Logger implements ToolDependencies.
 func (d BaseDeps) Logger(_ context.Context) *slog.Logger {
 	return d.Obsv.Logger()
@@ -220,6 +251,8 @@ func (d BaseDeps) IsFeatureEnabled(ctx context.Context, flagName string) bool {
 //
 // The handler function receives deps extracted from context via MustDepsFromContext.
 // Ensure ContextWithDeps is called to inject deps before any tool handlers are invoked.
+// Calls whose owner, repo, or search query fall outside the deps' RepoPolicy are
+// rejected before the handler runs.
 //
 // requiredScopes specifies the minimum OAuth scopes needed for this tool.
 // AcceptedScopes are automatically derived using the scope hierarchy (e.g., if
@@ -232,6 +265,10 @@ func NewTool[In, Out any](
 ) inventory.ServerTool {
 	st := inventory.NewServerToolWithContextHandler(tool, toolset, func(ctx context.Context, req *mcp.CallToolRequest, args In) (*mcp.CallToolResult, Out, error) {
 		deps := MustDepsFromContext(ctx)
+		if err := deps.GetRepoPolicy().checkArgs(args); err != nil {
+			var zero Out
+			return utils.NewToolResultError(err.Error()), zero, nil
+		}
 		return handler(ctx, deps, req, args)
 	})
 	st.RequiredScopes = scopes.ToStringSlice(requiredScopes...)
@@ -244,6 +281,8 @@ func NewTool[In, Out any](
 //
 // The handler function receives deps extracted from context via MustDepsFromContext.
 // Ensure ContextWithDeps is called to inject deps before any tool handlers are invoked.
+// Calls whose owner, repo, or search query fall outside the deps' RepoPolicy are
+// rejected before the handler runs.
 //
 // requiredScopes specifies the minimum OAuth scopes needed for this tool.
 // AcceptedScopes are automatically derived using the scope hierarchy.
@@ -255,6 +294,14 @@ func NewToolFromHandler(
 ) inventory.ServerTool {
 	st := inventory.NewServerTool(tool, toolset, func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		deps := MustDepsFromContext(ctx)
+		if req != nil && req.Params != nil && len(req.Params.Arguments) > 0 {
+			var args map[string]any
+			if err := json.Unmarshal(req.Params.Arguments, &args); err == nil {
+				if err := deps.GetRepoPolicy().checkArgs(args); err != nil {
+					return utils.NewToolResultError(err.Error()), nil
+				}
+			}
+		}
 		return handler(ctx, deps, req)
 	})
 	st.RequiredScopes = scopes.ToStringSlice(requiredScopes...)
@@ -270,6 +317,9 @@ type RequestDeps struct {
 	RepoAccessOpts    []lockdown.RepoAccessOption
 	T                 translations.TranslationHelperFunc
 	ContentWindowSize int
+	DefaultPageSize   int
+	MaxResponseBytes  int
+	RepoPolicy        *RepoPolicy
 
 	// Feature flag checker for runtime checks
 	featureChecker inventory.FeatureFlagChecker
@@ -286,6 +336,9 @@ func NewRequestDeps(
 	repoAccessOpts []lockdown.RepoAccessOption,
 	t translations.TranslationHelperFunc,
 	contentWindowSize int,
+	defaultPageSize int,
+	maxResponseBytes int,
+	repoPolicy *RepoPolicy,
 	featureChecker inventory.FeatureFlagChecker,
 	obsv observability.Exporters,
 ) *RequestDeps {
@@ -296,6 +349,9 @@ func NewRequestDeps(
 		RepoAccessOpts:    repoAccessOpts,
 		T:                 t,
 		ContentWindowSize: contentWindowSize,
+		DefaultPageSize:   defaultPageSize,
+		MaxResponseBytes:  maxResponseBytes,
+		RepoPolicy:        repoPolicy,
 		featureChecker:    featureChecker,
 		obsv:              obsv,
 	}
@@ -347,7 +403,9 @@ func (d *RequestDeps) GetGQLClient(ctx context.Context) (*githubv4.Client, error
 	gqlHTTPClient := &http.Client{
 		Transport: &transport.BearerAuthTransport{
 			Transport: &transport.GraphQLFeaturesTransport{
-				Transport: http.DefaultTransport,
+				Transport: &transport.GraphQLRateLimitTransport{
+					Transport: http.DefaultTransport,
+				},
 			},
 			Token: token,
 		},
@@ -416,6 +474,15 @@ func (d *RequestDeps) GetFlags(ctx context.Context) FeatureFlags {
 // GetContentWindowSize implements ToolDependencies.
 func (d *RequestDeps) GetContentWindowSize() int { return d.ContentWindowSize }
 
+// GetDefaultPageSize implements ToolDependencies.
+func (d *RequestDeps) GetDefaultPageSize() int { return d.DefaultPageSize }
+
+// GetMaxResponseBytes implements ToolDependencies.
+func (d *RequestDeps) GetMaxResponseBytes() int { return d.MaxResponseBytes }
+
+// GetRepoPolicy implements ToolDependencies.
+func (d *RequestDeps) GetRepoPolicy() *RepoPolicy { return d.RepoPolicy }
+
 // Logger implements ToolDependencies.
 func (d *RequestDeps) Logger(_ context.Context) *slog.Logger {
 	return d.obsv.Logger()
@@ -35,6 +35,9 @@ func TestIsFeatureEnabled_WithEnabledFlag(t *testing.T) {
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,       // contentWindowSize
+		0,       // defaultPageSize
+		0,       // maxResponseBytes
+		nil,     // repoPolicy
 		checker, // featureChecker
 		testExporters(),
 	)
@@ -60,6 +63,9 @@ func TestIsFeatureEnabled_WithoutChecker(t *testing.T) {
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,   // contentWindowSize
+		0,   // defaultPageSize
+		0,   // maxResponseBytes
+		nil, // repoPolicy
 		nil, // featureChecker (nil)
 		testExporters(),
 	)
@@ -85,6 +91,9 @@ func TestIsFeatureEnabled_EmptyFlagName(t *testing.T) {
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,       // contentWindowSize
+		0,       // defaultPageSize
+		0,       // maxResponseBytes
+		nil,     // repoPolicy
 		checker, // featureChecker
 		testExporters(),
 	)
@@ -110,6 +119,9 @@ func TestIsFeatureEnabled_CheckerError(t *testing.T) {
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,       // contentWindowSize
+		0,       // defaultPageSize
+		0,       // maxResponseBytes
+		nil,     // repoPolicy
 		checker, // featureChecker
 		testExporters(),
 	)
@@ -125,6 +125,39 @@ func getQueryType(useOrdering bool, categoryID *githubv4.ID) any {
 	return &BasicNoOrder{}
 }
 
+// resolveDiscussionCategoryID maps a category name to its node ID so callers
+// can filter by the name shown in the UI. Values that already look like a
+// category node ID are returned as-is.
+func resolveDiscussionCategoryID(ctx context.Context, client *githubv4.Client, owner, repo, category string) (githubv4.ID, error) {
+	if strings.HasPrefix(category, "DIC_") {
+		return githubv4.ID(category), nil
+	}
+
+	var q struct {
+		Repository struct {
+			DiscussionCategories struct {
+				Nodes []struct {
+					ID   githubv4.ID
+					Name githubv4.String
+				}
+			} `graphql:"discussionCategories(first: 100)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	vars := map[string]any{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+	}
+	if err := client.Query(ctx, &q, vars); err != nil {
+		return nil, fmt.Errorf("failed to resolve discussion category: %w", err)
+	}
+	for _, c := range q.Repository.DiscussionCategories.Nodes {
+		if strings.EqualFold(string(c.Name), category) {
+			return c.ID, nil
+		}
+	}
+	return nil, fmt.Errorf("discussion category %q not found in %s/%s; use list_discussion_categories to see the available categories", category, owner, repo)
+}
+
 func ListDiscussions(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
 		ToolsetMetadataDiscussions,
@@ -148,7 +181,7 @@ func ListDiscussions(t translations.TranslationHelperFunc) inventory.ServerTool
 					},
 					"category": {
 						Type:        "string",
-						Description: "Optional filter by discussion category ID. If provided, only discussions with this category are listed.",
+						Description: "Optional filter by discussion category, given as a category name (e.g. \"Ideas\") or ID. If provided, only discussions with this category are listed.",
 					},
 					"orderBy": {
 						Type:        "string",
@@ -212,7 +245,10 @@ func ListDiscussions(t translations.TranslationHelperFunc) inventory.ServerTool
 
 			var categoryID *githubv4.ID
 			if category != "" {
-				id := githubv4.ID(category)
+				id, err := resolveDiscussionCategoryID(ctx, client, owner, repo, category)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
 				categoryID = &id
 			}
 
@@ -341,6 +377,9 @@ func GetDiscussion(t translations.TranslationHelperFunc) inventory.ServerTool {
 						Category       struct {
 							Name githubv4.String
 						} `graphql:"category"`
+						Comments struct {
+							TotalCount githubv4.Int
+						}
 					} `graphql:"discussion(number: $discussionNumber)"`
 				} `graphql:"repository(owner: $owner, name: $repo)"`
 			}
@@ -359,13 +398,14 @@ func GetDiscussion(t translations.TranslationHelperFunc) inventory.ServerTool {
 			// so we use map[string]interface{} for the response (consistent with other functions
 			// like ListDiscussions and GetDiscussionComments).
 			response := map[string]any{
-				"number":     int(d.Number),
-				"title":      string(d.Title),
-				"body":       string(d.Body),
-				"url":        string(d.URL),
-				"closed":     bool(d.Closed),
-				"isAnswered": bool(d.IsAnswered),
-				"createdAt":  d.CreatedAt.Time,
+				"number":        int(d.Number),
+				"title":         string(d.Title),
+				"body":          string(d.Body),
+				"url":           string(d.URL),
+				"closed":        bool(d.Closed),
+				"isAnswered":    bool(d.IsAnswered),
+				"createdAt":     d.CreatedAt.Time,
+				"commentsCount": int(d.Comments.TotalCount),
 				"category": map[string]any{
 					"name": string(d.Category.Name),
 				},
@@ -455,9 +495,9 @@ func GetDiscussionComments(t translations.TranslationHelperFunc) inventory.Serve
 				return nil, nil, err
 			}
 
-			// Use default of 30 if pagination was not explicitly provided
+			// Use the configured default page size if pagination was not explicitly provided
 			if !paginationExplicit {
-				defaultFirst := int32(DefaultGraphQLPageSize)
+				defaultFirst := int32(defaultPageSize(deps, DefaultGraphQLPageSize, 100)) // #nosec G115 - bounded by 100
 				paginationParams.First = &defaultFirst
 			}
 
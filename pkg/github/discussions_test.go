@@ -312,6 +312,26 @@ func Test_ListDiscussions(t *testing.T) {
 			expectError:   false,
 			expectedCount: 2, // Only General discussions (matching the category ID)
 		},
+		{
+			name: "filter by category name",
+			reqParams: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"category": "general",
+			},
+			expectError:   false,
+			expectedCount: 2,
+		},
+		{
+			name: "filter by unknown category name",
+			reqParams: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"category": "Nope",
+			},
+			expectError: true,
+			errContains: "use list_discussion_categories",
+		},
 		{
 			name: "order by created at ascending",
 			reqParams: map[string]any{
@@ -410,6 +430,14 @@ func Test_ListDiscussions(t *testing.T) {
 	qBasicNoOrder := "query($after:String$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 	qWithCategoryNoOrder := "query($after:String$categoryId:ID!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, categoryId: $categoryId){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 	qBasicWithOrder := "query($after:String$first:Int!$orderByDirection:OrderDirection!$orderByField:DiscussionOrderField!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, orderBy: { field: $orderByField, direction: $orderByDirection }){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qCategoryLookup := "query($owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussionCategories(first: 100){nodes{id,name}}}}"
+	varsCategoryLookup := map[string]any{"owner": "owner", "repo": "repo"}
+	mockResponseCategories := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{"discussionCategories": map[string]any{"nodes": []map[string]any{
+			{"id": "DIC_kwDOABC123", "name": "General"},
+			{"id": "DIC_kwDOABC456", "name": "Q&A"},
+		}}},
+	})
 	qWithCategoryAndOrder := "query($after:String$categoryId:ID!$first:Int!$orderByDirection:OrderDirection!$orderByField:DiscussionOrderField!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, categoryId: $categoryId, orderBy: { field: $orderByField, direction: $orderByDirection }){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 
 	for _, tc := range tests {
@@ -423,6 +451,15 @@ func Test_ListDiscussions(t *testing.T) {
 			case "filter by category ID":
 				matcher := githubv4mock.NewQueryMatcher(qWithCategoryNoOrder, varsDiscussionsFiltered, mockResponseListGeneral)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "filter by category name":
+				httpClient = githubv4mock.NewMockedHTTPClient(
+					githubv4mock.NewQueryMatcher(qCategoryLookup, varsCategoryLookup, mockResponseCategories),
+					githubv4mock.NewQueryMatcher(qWithCategoryNoOrder, varsDiscussionsFiltered, mockResponseListGeneral),
+				)
+			case "filter by unknown category name":
+				httpClient = githubv4mock.NewMockedHTTPClient(
+					githubv4mock.NewQueryMatcher(qCategoryLookup, varsCategoryLookup, mockResponseCategories),
+				)
 			case "order by created at ascending":
 				matcher := githubv4mock.NewQueryMatcher(qBasicWithOrder, varsOrderByCreatedAsc, mockResponseOrderedCreatedAsc)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
@@ -509,7 +546,7 @@ func Test_GetDiscussion(t *testing.T) {
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "discussionNumber"})
 
 	// Use exact string query that matches implementation output
-	qGetDiscussion := "query($discussionNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){number,title,body,createdAt,closed,isAnswered,answerChosenAt,url,category{name}}}}"
+	qGetDiscussion := "query($discussionNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){number,title,body,createdAt,closed,isAnswered,answerChosenAt,url,category{name},comments{totalCount}}}}"
 
 	vars := map[string]any{
 		"owner":            "owner",
@@ -535,16 +572,18 @@ func Test_GetDiscussion(t *testing.T) {
 					"closed":     false,
 					"isAnswered": false,
 					"category":   map[string]any{"name": "General"},
+					"comments":   map[string]any{"totalCount": 4},
 				}},
 			}),
 			expectError: false,
 			expected: map[string]any{
-				"number":     float64(1),
-				"title":      "Test Discussion Title",
-				"body":       "This is a test discussion",
-				"url":        "https://github.com/owner/repo/discussions/1",
-				"closed":     false,
-				"isAnswered": false,
+				"number":        float64(1),
+				"title":         "Test Discussion Title",
+				"body":          "This is a test discussion",
+				"url":           "https://github.com/owner/repo/discussions/1",
+				"closed":        false,
+				"isAnswered":    false,
+				"commentsCount": float64(4),
 			},
 		},
 		{
@@ -582,6 +621,7 @@ func Test_GetDiscussion(t *testing.T) {
 			assert.Equal(t, tc.expected["url"], out["url"])
 			assert.Equal(t, tc.expected["closed"], out["closed"])
 			assert.Equal(t, tc.expected["isAnswered"], out["isAnswered"])
+			assert.Equal(t, tc.expected["commentsCount"], out["commentsCount"])
 			// Check category is present
 			category, ok := out["category"].(map[string]any)
 			require.True(t, ok)
@@ -594,7 +634,7 @@ func Test_GetDiscussionWithStringNumber(t *testing.T) {
 	// Test that WeakDecode handles string discussionNumber from MCP clients
 	toolDef := GetDiscussion(translations.NullTranslationHelper)
 
-	qGetDiscussion := "query($discussionNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){number,title,body,createdAt,closed,isAnswered,answerChosenAt,url,category{name}}}}"
+	qGetDiscussion := "query($discussionNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){number,title,body,createdAt,closed,isAnswered,answerChosenAt,url,category{name},comments{totalCount}}}}"
 
 	vars := map[string]any{
 		"owner":            "owner",
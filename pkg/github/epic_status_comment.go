@@ -0,0 +1,236 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// epicStatusMarker opens every comment posted by post_epic_status_comment so
+// later runs can find and update it.
+const epicStatusMarker = "<!-- mcp-epic-status -->"
+
+// MaxEpicStatusCommentScan caps how many comments post_epic_status_comment
+// reads while looking for its previous comment.
+const MaxEpicStatusCommentScan = 1000
+
+const epicStatusProgressBarWidth = 20
+
+// EpicStatusCommentResult is the output of post_epic_status_comment. Action is
+// "created", "updated", or "unchanged" when the previous comment already
+// showed the same status.
+type EpicStatusCommentResult struct {
+	Action          string  `json:"action"`
+	CommentID       int64   `json:"comment_id"`
+	HTMLURL         string  `json:"html_url"`
+	Total           int     `json:"total"`
+	Closed          int     `json:"closed"`
+	PercentComplete float64 `json:"percent_complete"`
+	Truncated       bool    `json:"truncated,omitempty"`
+}
+
+// PostEpicStatusComment creates a tool that posts, or refreshes, a comment on
+// a parent issue summarizing the progress of its sub-issues.
+func PostEpicStatusComment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "post_epic_status_comment",
+			Description: t("TOOL_POST_EPIC_STATUS_COMMENT_DESCRIPTION", "Post a comment on a parent issue summarizing the progress of its direct sub-issues: a progress bar and a checklist of children with their states and assignees. "+
+				"Running it again updates the comment it posted before instead of adding another one."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:          t("TOOL_POST_EPIC_STATUS_COMMENT_USER_TITLE", "Post epic status comment"),
+				ReadOnlyHint:   false,
+				IdempotentHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the parent issue",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			subIssues, truncated, result, err := listAllSubIssues(ctx, client, owner, repo, issueNumber)
+			if result != nil || err != nil {
+				return result, nil, err
+			}
+			progress := summarizeEpicProgress(issueNumber, subIssues)
+			progress.Truncated = truncated
+			children, result, err := epicChildren(ctx, deps, owner, repo, subIssues)
+			if result != nil || err != nil {
+				return result, nil, err
+			}
+			body := renderEpicStatus(progress, children)
+
+			// The previous comment is the one this user posted, so a user
+			// quoting the marker in their own comment is not mistaken for it.
+			var session *mcp.ServerSession
+			if req != nil {
+				session = req.Session
+			}
+			login, resp, err := authenticatedLogin(ctx, client, session)
+			if err != nil && !errors.Is(err, errAppTokenHasNoUser) {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get authenticated user", resp, err), nil, nil
+			}
+			previous, result := findEpicStatusComment(ctx, client, owner, repo, issueNumber, login)
+			if result != nil {
+				return result, nil, nil
+			}
+
+			output := EpicStatusCommentResult{
+				Total:           progress.Total,
+				Closed:          progress.Closed,
+				PercentComplete: progress.PercentComplete,
+				Truncated:       progress.Truncated,
+			}
+			switch {
+			case previous == nil:
+				comment, resp, err := client.Issues.CreateComment(ctx, owner, repo, issueNumber, &github.IssueComment{Body: github.Ptr(body)})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create comment", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+				output.Action = "created"
+				output.CommentID = comment.GetID()
+				output.HTMLURL = comment.GetHTMLURL()
+			case previous.GetBody() == body:
+				output.Action = "unchanged"
+				output.CommentID = previous.GetID()
+				output.HTMLURL = previous.GetHTMLURL()
+			default:
+				comment, resp, err := client.Issues.EditComment(ctx, owner, repo, previous.GetID(), &github.IssueComment{Body: github.Ptr(body)})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update comment", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+				output.Action = "updated"
+				output.CommentID = comment.GetID()
+				output.HTMLURL = comment.GetHTMLURL()
+			}
+			return MarshalledTextResult(output), nil, nil
+		})
+}
+
+// findEpicStatusComment pages through an issue's comments, up to
+// MaxEpicStatusCommentScan, for the latest one that starts with the marker
+// and was written by login. With an empty login (a GitHub App token) it
+// accepts any bot author instead. A non-nil result is an error to return.
+func findEpicStatusComment(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, login string) (*github.IssueComment, *mcp.CallToolResult) {
+	var found *github.IssueComment
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for scanned := 0; scanned < MaxEpicStatusCommentScan; {
+		comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+		if err != nil {
+			return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list comments", resp, err)
+		}
+		_ = resp.Body.Close()
+
+		for _, comment := range comments {
+			if !strings.HasPrefix(comment.GetBody(), epicStatusMarker) {
+				continue
+			}
+			author := comment.GetUser()
+			if login != "" && !strings.EqualFold(author.GetLogin(), login) {
+				continue
+			}
+			if login == "" && author.GetType() != "Bot" {
+				continue
+			}
+			found = comment
+		}
+		scanned += len(comments)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return found, nil
+}
+
+// renderEpicStatus renders the Markdown body of an epic status comment.
+func renderEpicStatus(progress EpicProgress, children []MinimalEpicChild) string {
+	var b strings.Builder
+	b.WriteString(epicStatusMarker + "\n")
+	b.WriteString("### Epic progress\n\n")
+
+	filled := 0
+	if progress.Total > 0 {
+		filled = progress.Closed * epicStatusProgressBarWidth / progress.Total
+	}
+	fmt.Fprintf(&b, "`%s%s` %s%% (%d of %d sub-issues closed)\n\n",
+		strings.Repeat("█", filled), strings.Repeat("░", epicStatusProgressBarWidth-filled),
+		strconv.FormatFloat(progress.PercentComplete, 'f', -1, 64), progress.Closed, progress.Total)
+
+	if progress.Total == 0 {
+		b.WriteString("This issue has no sub-issues yet.\n")
+		return b.String()
+	}
+
+	for _, child := range children {
+		check := " "
+		if child.State == "closed" {
+			check = "x"
+		}
+		fmt.Fprintf(&b, "- [%s] #%d %s (%s", check, child.Number, child.Title, child.State)
+		// Logins are written as code spans so the comment does not mention,
+		// and notify, the assignees every time it is updated.
+		for i, assignee := range child.Assignees {
+			sep := ", "
+			if i == 0 {
+				sep = "; "
+			}
+			fmt.Fprintf(&b, "%s`%s`", sep, assignee)
+		}
+		b.WriteString(")\n")
+	}
+	if hidden := progress.Total - len(children); hidden > 0 {
+		fmt.Fprintf(&b, "\n%d sub-issue(s) are counted but not listed.\n", hidden)
+	}
+	if progress.Truncated {
+		fmt.Fprintf(&b, "\nOnly the first %d sub-issues are included.\n", MaxEpicProgressChildren)
+	}
+	return b.String()
+}
@@ -0,0 +1,135 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PostEpicStatusComment(t *testing.T) {
+	serverTool := PostEpicStatusComment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "post_epic_status_comment", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	subIssues := []*github.SubIssue{
+		{Number: github.Ptr(1), Title: github.Ptr("Schema"), State: github.Ptr("closed"), User: &github.User{Login: github.Ptr("author")},
+			Assignees: []*github.User{{Login: github.Ptr("alice")}}},
+		{Number: github.Ptr(2), Title: github.Ptr("API"), State: github.Ptr("open"), User: &github.User{Login: github.Ptr("author")},
+			Assignees: []*github.User{{Login: github.Ptr("alice")}, {Login: github.Ptr("bob")}}},
+		{Number: github.Ptr(3), Title: github.Ptr("Docs"), State: github.Ptr("open"), User: &github.User{Login: github.Ptr("author")}},
+		{Number: github.Ptr(4), Title: github.Ptr("Rollout"), State: github.Ptr("closed"), User: &github.User{Login: github.Ptr("author")}},
+	}
+	expectedBody := epicStatusMarker + "\n" +
+		"### Epic progress\n\n" +
+		"`██████████░░░░░░░░░░` 50% (2 of 4 sub-issues closed)\n\n" +
+		"- [x] #1 Schema (closed; `alice`)\n" +
+		"- [ ] #2 API (open; `alice`, `bob`)\n" +
+		"- [ ] #3 Docs (open)\n" +
+		"- [x] #4 Rollout (closed)\n"
+
+	type call struct {
+		method string
+		path   string
+		body   string
+	}
+	newDeps := func(comments []*github.IssueComment, calls *[]call) BaseDeps {
+		record := func(w http.ResponseWriter, r *http.Request) {
+			var comment github.IssueComment
+			_ = json.NewDecoder(r.Body).Decode(&comment)
+			*calls = append(*calls, call{method: r.Method, path: r.URL.Path, body: comment.GetBody()})
+			mockResponse(t, http.StatusOK, &github.IssueComment{
+				ID:      github.Ptr(int64(500)),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/10#issuecomment-500"),
+			})(w, r)
+		}
+		return BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, subIssues),
+			GetUser: mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("maintainer"), Type: github.Ptr("User")}),
+			GetReposIssuesCommentsByOwnerByRepoByIssueNumber:  mockResponse(t, http.StatusOK, comments),
+			PostReposIssuesCommentsByOwnerByRepoByIssueNumber: record,
+			PatchReposIssuesCommentByOwnerByRepoByCommentID:   record,
+		}))}
+	}
+	run := func(t *testing.T, deps BaseDeps) EpicStatusCommentResult {
+		t.Helper()
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(10)})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response EpicStatusCommentResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		return response
+	}
+
+	t.Run("creates a comment when none was posted", func(t *testing.T) {
+		var calls []call
+		response := run(t, newDeps([]*github.IssueComment{
+			{ID: github.Ptr(int64(1)), Body: github.Ptr("Looks good"), User: &github.User{Login: github.Ptr("maintainer")}},
+		}, &calls))
+
+		require.Len(t, calls, 1)
+		assert.Equal(t, http.MethodPost, calls[0].method)
+		assert.Equal(t, "/repos/owner/repo/issues/10/comments", calls[0].path)
+		assert.Equal(t, expectedBody, calls[0].body)
+		assert.Equal(t, EpicStatusCommentResult{
+			Action:          "created",
+			CommentID:       500,
+			HTMLURL:         "https://github.com/owner/repo/issues/10#issuecomment-500",
+			Total:           4,
+			Closed:          2,
+			PercentComplete: 50,
+		}, response)
+	})
+
+	t.Run("updates the previously posted comment", func(t *testing.T) {
+		var calls []call
+		response := run(t, newDeps([]*github.IssueComment{
+			{ID: github.Ptr(int64(7)), Body: github.Ptr(epicStatusMarker + "\nold status"), User: &github.User{Login: github.Ptr("maintainer")}},
+			{ID: github.Ptr(int64(8)), Body: github.Ptr("Any news?"), User: &github.User{Login: github.Ptr("someone")}},
+		}, &calls))
+
+		require.Len(t, calls, 1)
+		assert.Equal(t, http.MethodPatch, calls[0].method)
+		assert.Equal(t, "/repos/owner/repo/issues/comments/7", calls[0].path)
+		assert.Equal(t, expectedBody, calls[0].body)
+		assert.Equal(t, "updated", response.Action)
+	})
+
+	t.Run("leaves an up-to-date comment alone", func(t *testing.T) {
+		var calls []call
+		response := run(t, newDeps([]*github.IssueComment{
+			{ID: github.Ptr(int64(7)), Body: github.Ptr(expectedBody), User: &github.User{Login: github.Ptr("maintainer")},
+				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/10#issuecomment-7")},
+		}, &calls))
+
+		assert.Empty(t, calls)
+		assert.Equal(t, "unchanged", response.Action)
+		assert.Equal(t, int64(7), response.CommentID)
+	})
+
+	t.Run("ignores the marker in other users' comments", func(t *testing.T) {
+		var calls []call
+		response := run(t, newDeps([]*github.IssueComment{
+			{ID: github.Ptr(int64(7)), Body: github.Ptr(epicStatusMarker + "\nquoted status"), User: &github.User{Login: github.Ptr("someone")}},
+			{ID: github.Ptr(int64(8)), Body: github.Ptr("See the status below " + epicStatusMarker), User: &github.User{Login: github.Ptr("maintainer")}},
+		}, &calls))
+
+		require.Len(t, calls, 1)
+		assert.Equal(t, http.MethodPost, calls[0].method)
+		assert.Equal(t, "created", response.Action)
+	})
+}
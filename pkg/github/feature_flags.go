@@ -23,6 +23,11 @@ const FeatureFlagFileBlame = "file_blame"
 // unless explicitly opted in.
 const FeatureFlagIssueDependencies = "issue_dependencies"
 
+// FeatureFlagGraphQLQuery is the feature flag name for the run_graphql_query
+// tool, which runs caller-supplied read-only GraphQL queries. It is gated so
+// the open-ended tool is only advertised when an operator opts in.
+const FeatureFlagGraphQLQuery = "graphql_query"
+
 // AllowedFeatureFlags is the allowlist of feature flags that can be enabled
 // by users via --features CLI flag or X-MCP-Features HTTP header.
 // Only flags in this list are accepted; unknown flags are silently ignored.
@@ -35,6 +40,7 @@ var AllowedFeatureFlags = []string{
 	FeatureFlagPullRequestsGranular,
 	FeatureFlagFileBlame,
 	FeatureFlagIssueDependencies,
+	FeatureFlagGraphQLQuery,
 }
 
 // InsidersFeatureFlags is the list of feature flags that insiders mode enables.
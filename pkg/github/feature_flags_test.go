@@ -101,6 +101,9 @@ func TestHelloWorld_ConditionalBehavior_Featureflag(t *testing.T) {
 				translations.NullTranslationHelper,
 				FeatureFlags{},
 				0,
+				0,
+				0,
+				nil,
 				featureCheckerFor(enabledFlags...),
 				stubExporters(),
 			)
@@ -38,7 +38,7 @@ func ListGists(t translations.TranslationHelperFunc) inventory.ServerTool {
 					},
 					"since": {
 						Type:        "string",
-						Description: "Only gists updated after this time (ISO 8601 timestamp)",
+						Description: "Only gists updated after this time (ISO 8601 timestamp, or relative such as '7 days ago', 'yesterday' or 'today')",
 					},
 				},
 			}),
@@ -69,7 +69,7 @@ func ListGists(t translations.TranslationHelperFunc) inventory.ServerTool {
 
 			// Parse since timestamp if provided
 			if since != "" {
-				sinceTime, err := parseISOTimestamp(since)
+				sinceTime, err := parseFlexibleTimestamp(since)
 				if err != nil {
 					return utils.NewToolResultError(fmt.Sprintf("invalid since timestamp: %v", err)), nil, nil
 				}
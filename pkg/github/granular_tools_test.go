@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
@@ -43,6 +44,7 @@ func TestGranularToolSnaps(t *testing.T) {
 		GranularAddSubIssue,
 		GranularRemoveSubIssue,
 		GranularReprioritizeSubIssue,
+		GranularMoveSubIssue,
 		GranularSetIssueFields,
 		GranularAddIssueReaction,
 		GranularAddIssueCommentReaction,
@@ -89,6 +91,7 @@ func TestIssuesGranularToolset(t *testing.T) {
 			"add_sub_issue",
 			"remove_sub_issue",
 			"reprioritize_sub_issue",
+			"move_sub_issue",
 			"set_issue_fields",
 			"add_issue_reaction",
 			"add_issue_comment_reaction",
@@ -172,6 +175,43 @@ func TestGranularCreateIssue(t *testing.T) {
 				"body":  "Test body",
 			},
 		},
+		{
+			name: "creation with dedupe key appends marker",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUser:                     mockResponse(t, http.StatusOK, &gogithub.User{Login: gogithub.Ptr("octocat")}),
+				GetReposIssuesByOwnerByRepo: mockResponse(t, http.StatusOK, []*gogithub.Issue{}),
+				PostReposIssuesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"title": "Test Issue",
+					"body":  "Test body\n\n<!-- mcp-dedupe:abc -->",
+				}).andThen(mockResponse(t, http.StatusCreated, mockIssue)),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"title":      "Test Issue",
+				"body":       "Test body",
+				"dedupe_key": "abc",
+			},
+		},
+		{
+			name: "dedupe key matching existing issue",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUser: mockResponse(t, http.StatusOK, &gogithub.User{Login: gogithub.Ptr("octocat")}),
+				GetReposIssuesByOwnerByRepo: mockResponse(t, http.StatusOK, []*gogithub.Issue{{
+					ID:        gogithub.Ptr(int64(5)),
+					Body:      gogithub.Ptr("Test body\n\n<!-- mcp-dedupe:abc -->"),
+					HTMLURL:   gogithub.Ptr("https://github.com/owner/repo/issues/5"),
+					CreatedAt: &gogithub.Timestamp{Time: time.Now()},
+				}}),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"title":      "Test Issue",
+				"dedupe_key": "abc",
+			},
+			expectedErrMsg: `"deduplicated":true`,
+		},
 		{
 			name:         "missing required parameter",
 			mockedClient: MockHTTPClientWithHandlers(nil),
@@ -204,6 +244,87 @@ func TestGranularCreateIssue(t *testing.T) {
 	}
 }
 
+func TestGranularCreateIssue_AddToProject(t *testing.T) {
+	mockIssue := &gogithub.Issue{
+		ID:      gogithub.Ptr(int64(9001)),
+		Number:  gogithub.Ptr(7),
+		HTMLURL: gogithub.Ptr("https://github.com/owner/repo/issues/7"),
+	}
+	serverTool := GranularCreateIssue(translations.NullTranslationHelper)
+	projectArgs := map[string]any{
+		"owner":              "owner",
+		"repo":               "repo",
+		"title":              "Test Issue",
+		"project_owner":      "octo-org",
+		"project_owner_type": "org",
+		"project_number":     float64(3),
+	}
+
+	t.Run("adds the new issue to the project", func(t *testing.T) {
+		client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PostReposIssuesByOwnerByRepo: mockResponse(t, http.StatusCreated, mockIssue),
+			PostOrgsProjectsV2ItemsByProject: expectRequestBody(t, map[string]any{
+				"type": "Issue",
+				"id":   float64(9001),
+			}).andThen(mockResponse(t, http.StatusCreated, &gogithub.ProjectV2Item{ID: gogithub.Ptr(int64(555))})),
+		}))
+		deps := BaseDeps{Client: client}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(projectArgs)
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response MinimalResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, MinimalResponse{
+			ID:            "9001",
+			URL:           "https://github.com/owner/repo/issues/7",
+			ProjectItemID: 555,
+		}, response)
+	})
+
+	t.Run("project failure keeps the issue and warns", func(t *testing.T) {
+		client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PostReposIssuesByOwnerByRepo:     mockResponse(t, http.StatusCreated, mockIssue),
+			PostOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+		}))
+		deps := BaseDeps{Client: client}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(projectArgs)
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response MinimalResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "9001", response.ID)
+		assert.Equal(t, "https://github.com/owner/repo/issues/7", response.URL)
+		assert.Zero(t, response.ProjectItemID)
+		assert.Contains(t, response.Warning, "issue was created but could not be added to project octo-org/3")
+		assert.Contains(t, response.Warning, "Resource not accessible by integration")
+	})
+
+	t.Run("project_owner without project_number", func(t *testing.T) {
+		client := mustNewGHClient(t, MockHTTPClientWithHandlers(nil))
+		deps := BaseDeps{Client: client}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"owner":         "owner",
+			"repo":          "repo",
+			"title":         "Test Issue",
+			"project_owner": "octo-org",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "project_owner and a positive project_number must be provided together")
+	})
+}
+
 func TestGranularUpdateIssueTitle(t *testing.T) {
 	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
 		PatchReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, &gogithub.Issue{
@@ -1106,6 +1227,95 @@ func TestGranularUpdateIssueState(t *testing.T) {
 	}
 }
 
+func TestGranularUpdateIssueStateComment(t *testing.T) {
+	args := map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(1),
+		"state":        "closed",
+		"state_reason": "not_planned",
+		"comment":      "Closing: out of scope for this release.",
+	}
+	patchIssue := expectRequestBody(t, map[string]any{
+		"state":        "closed",
+		"state_reason": "not_planned",
+	}).andThen(mockResponse(t, http.StatusOK, &gogithub.Issue{
+		ID:      gogithub.Ptr(int64(100)),
+		Number:  gogithub.Ptr(1),
+		HTMLURL: gogithub.Ptr("https://github.com/owner/repo/issues/1"),
+	}))
+
+	tests := []struct {
+		name          string
+		postComment   http.HandlerFunc
+		expectComment bool
+		expectedError string
+	}{
+		{
+			name: "posts the comment after closing",
+			postComment: expectRequestBody(t, map[string]any{
+				"body": "Closing: out of scope for this release.",
+			}).andThen(mockResponse(t, http.StatusCreated, &gogithub.IssueComment{
+				ID:      gogithub.Ptr(int64(7)),
+				HTMLURL: gogithub.Ptr("https://github.com/owner/repo/issues/1#issuecomment-7"),
+			})),
+			expectComment: true,
+		},
+		{
+			name:          "reports a failed comment alongside the state change",
+			postComment:   mockResponse(t, http.StatusForbidden, `{"message": "Issue is locked"}`),
+			expectedError: "issue closed but failed to post comment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposIssuesByOwnerByRepoByIssueNumber:        patchIssue,
+				PostReposIssuesCommentsByOwnerByRepoByIssueNumber: tc.postComment,
+			}))
+			deps := BaseDeps{Client: client}
+			serverTool := GranularUpdateIssueState(translations.NullTranslationHelper)
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response updateIssueResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "https://github.com/owner/repo/issues/1", response.URL)
+			if tc.expectComment {
+				require.NotNil(t, response.Comment)
+				assert.Equal(t, "7", response.Comment.ID)
+				assert.Empty(t, response.CommentError)
+			} else {
+				assert.Nil(t, response.Comment)
+				assert.Contains(t, response.CommentError, tc.expectedError)
+			}
+		})
+	}
+
+	t.Run("rejects a comment on a suggestion", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(nil))}
+		serverTool := GranularUpdateIssueState(translations.NullTranslationHelper)
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"owner":         "owner",
+			"repo":          "repo",
+			"issue_number":  float64(1),
+			"state":         "closed",
+			"is_suggestion": true,
+			"comment":       "Looks stale",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "comment cannot be combined with is_suggestion")
+	})
+}
+
 func TestGranularUpdateIssueStateSuggest(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1282,7 +1492,7 @@ func TestGranularUpdateIssueStateInvalidRationale(t *testing.T) {
 				"is_suggestion": true,
 				"duplicate_of":  float64(42),
 			},
-			expectedErrText: "duplicate_of can only be used when state_reason is 'duplicate'",
+			expectedErrText: "duplicate_of requires state_reason 'duplicate', not 'not_planned'",
 		},
 		{
 			name: "suggestion duplicate without duplicate_of",
@@ -1305,7 +1515,7 @@ func TestGranularUpdateIssueStateInvalidRationale(t *testing.T) {
 				"state":        "open",
 				"state_reason": "completed",
 			},
-			expectedErrText: "state_reason can only be used when state is 'closed'",
+			expectedErrText: "state_reason 'completed' is only valid with state 'closed', not 'open'",
 		},
 	}
 
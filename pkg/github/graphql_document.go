@@ -0,0 +1,550 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This file holds a small GraphQL document parser used to vet the queries
+// run_graphql_query sends. It follows the executable-document grammar of the
+// GraphQL specification closely enough to find every operation, field and
+// fragment in a document; values and types are consumed but not kept. The
+// GitHub API validates the document fully once it is sent.
+
+const (
+	// MaxGraphQLQueryLength caps the size in bytes of a run_graphql_query document.
+	MaxGraphQLQueryLength = 10000
+	// MaxGraphQLQueryDepth caps how deeply the fields of a run_graphql_query
+	// document nest, counting through fragments.
+	MaxGraphQLQueryDepth = 10
+)
+
+// forbiddenGraphQLFields are fields run_graphql_query refuses to select
+// anywhere in a document, with the reason given to the caller. They expose
+// identity provider, SSO and network security configuration, or, for
+// __schema, return the whole schema at once.
+var forbiddenGraphQLFields = map[string]string{
+	"__schema":             "it returns the whole schema; use __type to inspect one type",
+	"samlIdentityProvider": "it exposes SAML single sign-on configuration",
+	"externalIdentities":   "it exposes identities linked through single sign-on",
+	"ipAllowListEntries":   "it exposes IP allow list configuration",
+	"ownerInfo":            "it exposes enterprise administration settings",
+}
+
+type gqlTokenKind int
+
+const (
+	gqlTokenEOF gqlTokenKind = iota
+	gqlTokenPunct
+	gqlTokenName
+	gqlTokenNumber
+	gqlTokenString
+)
+
+type gqlToken struct {
+	kind  gqlTokenKind
+	value string
+	pos   int
+}
+
+// gqlSelection is a field, fragment spread or inline fragment. field is the
+// field's name, not its alias; spread names the fragment a spread refers to.
+type gqlSelection struct {
+	field      string
+	spread     string
+	selections []gqlSelection
+}
+
+type gqlOperation struct {
+	kind       string
+	selections []gqlSelection
+}
+
+type gqlDocument struct {
+	operations []gqlOperation
+	fragments  map[string][]gqlSelection
+}
+
+// tokenizeGraphQL splits a GraphQL document into tokens, dropping the
+// ignored tokens: whitespace, line terminators, commas and comments.
+func tokenizeGraphQL(src string) ([]gqlToken, error) {
+	var tokens []gqlToken
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case strings.HasPrefix(src[i:], "\uFEFF"):
+			i += len("\uFEFF")
+		case c == '#':
+			for i < len(src) && src[i] != '\n' && src[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "..."):
+			tokens = append(tokens, gqlToken{kind: gqlTokenPunct, value: "...", pos: i})
+			i += 3
+		case strings.ContainsRune("!$&()=:@[]{}|", rune(c)):
+			tokens = append(tokens, gqlToken{kind: gqlTokenPunct, value: string(c), pos: i})
+			i++
+		case c == '_' || isASCIILetter(c):
+			start := i
+			for i < len(src) && (src[i] == '_' || isASCIILetter(src[i]) || isASCIIDigit(src[i])) {
+				i++
+			}
+			tokens = append(tokens, gqlToken{kind: gqlTokenName, value: src[start:i], pos: start})
+		case c == '-' || isASCIIDigit(c):
+			start := i
+			i++
+			for i < len(src) && (isASCIIDigit(src[i]) || strings.IndexByte(".eE+-", src[i]) >= 0) {
+				i++
+			}
+			if i < len(src) && (src[i] == '_' || isASCIILetter(src[i])) {
+				return nil, fmt.Errorf("invalid number at offset %d", start)
+			}
+			tokens = append(tokens, gqlToken{kind: gqlTokenNumber, value: src[start:i], pos: start})
+		case strings.HasPrefix(src[i:], `"""`):
+			start := i
+			end := -1
+			for j := i + 3; j+3 <= len(src); j++ {
+				if strings.HasPrefix(src[j:], `\"""`) {
+					j += 3
+					continue
+				}
+				if strings.HasPrefix(src[j:], `"""`) {
+					end = j + 3
+					break
+				}
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated block string at offset %d", start)
+			}
+			tokens = append(tokens, gqlToken{kind: gqlTokenString, value: src[start:end], pos: start})
+			i = end
+		case c == '"':
+			start := i
+			i++
+			for {
+				if i >= len(src) || src[i] == '\n' || src[i] == '\r' {
+					return nil, fmt.Errorf("unterminated string at offset %d", start)
+				}
+				if src[i] == '\\' {
+					i += 2
+					continue
+				}
+				if src[i] == '"' {
+					i++
+					break
+				}
+				i++
+			}
+			tokens = append(tokens, gqlToken{kind: gqlTokenString, value: src[start:i], pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at offset %d", src[i], i)
+		}
+	}
+	return append(tokens, gqlToken{kind: gqlTokenEOF, pos: len(src)}), nil
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+type gqlParser struct {
+	tokens []gqlToken
+	pos    int
+}
+
+func (p *gqlParser) peek() gqlToken {
+	return p.tokens[p.pos]
+}
+
+func (p *gqlParser) next() gqlToken {
+	t := p.tokens[p.pos]
+	if t.kind != gqlTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *gqlParser) peekPunct(value string) bool {
+	t := p.peek()
+	return t.kind == gqlTokenPunct && t.value == value
+}
+
+func (p *gqlParser) expectPunct(value string) error {
+	t := p.next()
+	if t.kind != gqlTokenPunct || t.value != value {
+		return unexpectedGraphQLToken(t, fmt.Sprintf("%q", value))
+	}
+	return nil
+}
+
+func (p *gqlParser) expectName() (string, error) {
+	t := p.next()
+	if t.kind != gqlTokenName {
+		return "", unexpectedGraphQLToken(t, "a name")
+	}
+	return t.value, nil
+}
+
+func unexpectedGraphQLToken(t gqlToken, expected string) error {
+	if t.kind == gqlTokenEOF {
+		return fmt.Errorf("unexpected end of document, expected %s", expected)
+	}
+	return fmt.Errorf("unexpected %q at offset %d, expected %s", t.value, t.pos, expected)
+}
+
+// parseGraphQLDocument parses an executable GraphQL document into its
+// operations and fragments.
+func parseGraphQLDocument(src string) (*gqlDocument, error) {
+	tokens, err := tokenizeGraphQL(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &gqlParser{tokens: tokens}
+	doc := &gqlDocument{fragments: make(map[string][]gqlSelection)}
+
+	for p.peek().kind != gqlTokenEOF {
+		if p.peekPunct("{") {
+			selections, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, gqlOperation{kind: "query", selections: selections})
+			continue
+		}
+
+		t := p.next()
+		if t.kind != gqlTokenName {
+			return nil, unexpectedGraphQLToken(t, "an operation or fragment")
+		}
+		switch t.value {
+		case "query", "mutation", "subscription":
+			if p.peek().kind == gqlTokenName {
+				p.next()
+			}
+			if p.peekPunct("(") {
+				if err := p.parseVariableDefinitions(); err != nil {
+					return nil, err
+				}
+			}
+			if err := p.parseDirectives(); err != nil {
+				return nil, err
+			}
+			selections, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, gqlOperation{kind: t.value, selections: selections})
+		case "fragment":
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			if name == "on" {
+				return nil, fmt.Errorf("a fragment cannot be named \"on\"")
+			}
+			if on, err := p.expectName(); err != nil || on != "on" {
+				return nil, fmt.Errorf("fragment %s must name its type with \"on\"", name)
+			}
+			if _, err := p.expectName(); err != nil {
+				return nil, err
+			}
+			if err := p.parseDirectives(); err != nil {
+				return nil, err
+			}
+			selections, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := doc.fragments[name]; ok {
+				return nil, fmt.Errorf("fragment %s is defined more than once", name)
+			}
+			doc.fragments[name] = selections
+		default:
+			return nil, fmt.Errorf("unexpected %q at offset %d; only operations and fragments are allowed", t.value, t.pos)
+		}
+	}
+	return doc, nil
+}
+
+func (p *gqlParser) parseSelectionSet() ([]gqlSelection, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	var selections []gqlSelection
+	for !p.peekPunct("}") {
+		selection, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, selection)
+	}
+	p.next()
+	if len(selections) == 0 {
+		return nil, fmt.Errorf("empty selection set")
+	}
+	return selections, nil
+}
+
+func (p *gqlParser) parseSelection() (gqlSelection, error) {
+	if p.peekPunct("...") {
+		p.next()
+		if t := p.peek(); t.kind == gqlTokenName && t.value != "on" {
+			p.next()
+			return gqlSelection{spread: t.value}, p.parseDirectives()
+		}
+		if t := p.peek(); t.kind == gqlTokenName && t.value == "on" {
+			p.next()
+			if _, err := p.expectName(); err != nil {
+				return gqlSelection{}, err
+			}
+		}
+		if err := p.parseDirectives(); err != nil {
+			return gqlSelection{}, err
+		}
+		selections, err := p.parseSelectionSet()
+		return gqlSelection{selections: selections}, err
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return gqlSelection{}, err
+	}
+	if p.peekPunct(":") {
+		p.next()
+		if name, err = p.expectName(); err != nil {
+			return gqlSelection{}, err
+		}
+	}
+	if p.peekPunct("(") {
+		if err := p.parseArguments(); err != nil {
+			return gqlSelection{}, err
+		}
+	}
+	if err := p.parseDirectives(); err != nil {
+		return gqlSelection{}, err
+	}
+	selection := gqlSelection{field: name}
+	if p.peekPunct("{") {
+		if selection.selections, err = p.parseSelectionSet(); err != nil {
+			return gqlSelection{}, err
+		}
+	}
+	return selection, nil
+}
+
+func (p *gqlParser) parseArguments() error {
+	if err := p.expectPunct("("); err != nil {
+		return err
+	}
+	for !p.peekPunct(")") {
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return err
+		}
+		if err := p.parseValue(); err != nil {
+			return err
+		}
+	}
+	p.next()
+	return nil
+}
+
+func (p *gqlParser) parseDirectives() error {
+	for p.peekPunct("@") {
+		p.next()
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+		if p.peekPunct("(") {
+			if err := p.parseArguments(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *gqlParser) parseVariableDefinitions() error {
+	if err := p.expectPunct("("); err != nil {
+		return err
+	}
+	for !p.peekPunct(")") {
+		if err := p.expectPunct("$"); err != nil {
+			return err
+		}
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return err
+		}
+		if err := p.parseType(); err != nil {
+			return err
+		}
+		if p.peekPunct("=") {
+			p.next()
+			if err := p.parseValue(); err != nil {
+				return err
+			}
+		}
+		if err := p.parseDirectives(); err != nil {
+			return err
+		}
+	}
+	p.next()
+	return nil
+}
+
+func (p *gqlParser) parseType() error {
+	if p.peekPunct("[") {
+		p.next()
+		if err := p.parseType(); err != nil {
+			return err
+		}
+		if err := p.expectPunct("]"); err != nil {
+			return err
+		}
+	} else if _, err := p.expectName(); err != nil {
+		return err
+	}
+	if p.peekPunct("!") {
+		p.next()
+	}
+	return nil
+}
+
+func (p *gqlParser) parseValue() error {
+	t := p.next()
+	switch {
+	case t.kind == gqlTokenName || t.kind == gqlTokenNumber || t.kind == gqlTokenString:
+		return nil
+	case t.kind == gqlTokenPunct && t.value == "$":
+		_, err := p.expectName()
+		return err
+	case t.kind == gqlTokenPunct && t.value == "[":
+		for !p.peekPunct("]") {
+			if err := p.parseValue(); err != nil {
+				return err
+			}
+		}
+		p.next()
+		return nil
+	case t.kind == gqlTokenPunct && t.value == "{":
+		for !p.peekPunct("}") {
+			if _, err := p.expectName(); err != nil {
+				return err
+			}
+			if err := p.expectPunct(":"); err != nil {
+				return err
+			}
+			if err := p.parseValue(); err != nil {
+				return err
+			}
+		}
+		p.next()
+		return nil
+	default:
+		return unexpectedGraphQLToken(t, "a value")
+	}
+}
+
+// validateGraphQLQuery checks that query is a single read-only GraphQL query
+// within the length and depth limits that selects no forbidden field.
+func validateGraphQLQuery(query string) error {
+	if strings.TrimSpace(query) == "" {
+		return fmt.Errorf("query must not be empty")
+	}
+	if len(query) > MaxGraphQLQueryLength {
+		return fmt.Errorf("query is %d bytes, more than the limit of %d", len(query), MaxGraphQLQueryLength)
+	}
+
+	doc, err := parseGraphQLDocument(query)
+	if err != nil {
+		return fmt.Errorf("invalid GraphQL document: %w", err)
+	}
+	if len(doc.operations) != 1 {
+		return fmt.Errorf("the document must contain exactly one operation, found %d", len(doc.operations))
+	}
+	if kind := doc.operations[0].kind; kind != "query" {
+		return fmt.Errorf("only queries can be run; %s operations are not allowed", kind)
+	}
+
+	v := &gqlDepthValidator{doc: doc, depths: make(map[string]int), visiting: make(map[string]bool)}
+	for name := range doc.fragments {
+		if _, err := v.fragmentDepth(name); err != nil {
+			return err
+		}
+	}
+	depth, err := v.depth(doc.operations[0].selections)
+	if err != nil {
+		return err
+	}
+	if depth > MaxGraphQLQueryDepth {
+		return fmt.Errorf("the query nests fields %d levels deep, more than the limit of %d", depth, MaxGraphQLQueryDepth)
+	}
+	return nil
+}
+
+// gqlDepthValidator measures how deeply the fields of a selection set nest,
+// following fragment spreads, and rejects forbidden fields, undefined
+// fragments and fragment cycles along the way.
+type gqlDepthValidator struct {
+	doc      *gqlDocument
+	depths   map[string]int
+	visiting map[string]bool
+}
+
+func (v *gqlDepthValidator) depth(selections []gqlSelection) (int, error) {
+	deepest := 0
+	for _, s := range selections {
+		var d int
+		var err error
+		switch {
+		case s.spread != "":
+			d, err = v.fragmentDepth(s.spread)
+		case s.field != "":
+			if reason, ok := forbiddenGraphQLFields[s.field]; ok {
+				return 0, fmt.Errorf("the field %s is not allowed: %s", s.field, reason)
+			}
+			d, err = v.depth(s.selections)
+			d++
+		default:
+			d, err = v.depth(s.selections)
+		}
+		if err != nil {
+			return 0, err
+		}
+		deepest = max(deepest, d)
+	}
+	return deepest, nil
+}
+
+func (v *gqlDepthValidator) fragmentDepth(name string) (int, error) {
+	if d, ok := v.depths[name]; ok {
+		return d, nil
+	}
+	selections, ok := v.doc.fragments[name]
+	if !ok {
+		return 0, fmt.Errorf("fragment %s is not defined", name)
+	}
+	if v.visiting[name] {
+		return 0, fmt.Errorf("fragment %s spreads itself", name)
+	}
+	v.visiting[name] = true
+	d, err := v.depth(selections)
+	if err != nil {
+		return 0, err
+	}
+	v.visiting[name] = false
+	v.depths[name] = d
+	return d, nil
+}
@@ -0,0 +1,173 @@
+package github
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateGraphQLQuery(t *testing.T) {
+	nested := func(levels int) string {
+		return strings.Repeat("a{", levels-1) + "b" + strings.Repeat("}", levels-1)
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedErr string
+	}{
+		{
+			name:  "named query with variables, directives and fragments",
+			query: `query Issues($owner: String!, $first: Int = 10, $labels: [String!]) @cached { repository(owner: $owner, name: "repo") { issues(first: $first, labels: $labels, orderBy: {field: CREATED_AT, direction: DESC}) { nodes { ...IssueFields ... on Issue @include(if: true) { title } } } } } fragment IssueFields on Issue { number author { login } }`,
+		},
+		{
+			name:  "shorthand query",
+			query: `{ viewer { login } }`,
+		},
+		{
+			name:  "mutation keywords inside strings and comments",
+			query: "# mutation { deleteRepository }\n" + `{ search(query: "mutation { x }", type: ISSUE, first: 1) { issueCount } a: search(query: """block "mutation" } {""", type: ISSUE, first: 1) { issueCount } }`,
+		},
+		{
+			name:  "escaped quotes and block string escapes",
+			query: `{ search(query: "say \"hi\" }", type: ISSUE, first: 1) { issueCount } b: search(query: """ends with \""" still inside""", type: ISSUE, first: 1) { issueCount } }`,
+		},
+		{
+			name:  "introspection of one type",
+			query: `{ __type(name: "Issue") { fields { name } } }`,
+		},
+		{
+			name:  "depth at the limit",
+			query: "{" + nested(MaxGraphQLQueryDepth) + "}",
+		},
+		{
+			name:        "mutation",
+			query:       `mutation { addStar(input: {starrableId: "x"}) { clientMutationId } }`,
+			expectedErr: "only queries can be run; mutation operations are not allowed",
+		},
+		{
+			name:        "subscription",
+			query:       `subscription { x }`,
+			expectedErr: "subscription operations are not allowed",
+		},
+		{
+			name:        "mutation after a comment that looks like a query",
+			query:       "#query {\nmutation M { addStar(input: {starrableId: \"x\"}) { clientMutationId } }",
+			expectedErr: "mutation operations are not allowed",
+		},
+		{
+			name:        "mutation next to a query",
+			query:       `query A { viewer { login } } mutation B { addStar(input: {starrableId: "x"}) { clientMutationId } }`,
+			expectedErr: "exactly one operation, found 2",
+		},
+		{
+			name:        "no operation",
+			query:       `fragment F on User { login }`,
+			expectedErr: "exactly one operation, found 0",
+		},
+		{
+			name:        "type system definition",
+			query:       `type Query { secret: String }`,
+			expectedErr: "only operations and fragments are allowed",
+		},
+		{
+			name:        "capitalized mutation keyword",
+			query:       `Mutation { x }`,
+			expectedErr: "only operations and fragments are allowed",
+		},
+		{
+			name:        "forbidden field behind an alias",
+			query:       `{ organization(login: "acme") { idp: samlIdentityProvider { ssoUrl } } }`,
+			expectedErr: "the field samlIdentityProvider is not allowed",
+		},
+		{
+			name:        "forbidden field in an inline fragment",
+			query:       `{ node(id: "x") { ... on Organization { ipAllowListEntries(first: 1) { totalCount } } } }`,
+			expectedErr: "the field ipAllowListEntries is not allowed",
+		},
+		{
+			name:        "forbidden field in an unused fragment",
+			query:       `{ viewer { login } } fragment F on Enterprise { ownerInfo { admins(first: 1) { totalCount } } }`,
+			expectedErr: "the field ownerInfo is not allowed",
+		},
+		{
+			name:        "schema introspection",
+			query:       `{ __schema { types { name } } }`,
+			expectedErr: "the field __schema is not allowed",
+		},
+		{
+			name:        "too deep",
+			query:       "{" + nested(MaxGraphQLQueryDepth+1) + "}",
+			expectedErr: "nests fields 11 levels deep, more than the limit of 10",
+		},
+		{
+			name:        "too deep through fragments",
+			query:       `{ a { ...F } } fragment F on T { b { c { d { e { f { g { h { ...G } } } } } } } } fragment G on T { i { j { k } } }`,
+			expectedErr: "nests fields 11 levels deep",
+		},
+		{
+			name:        "fragment cycle",
+			query:       `{ viewer { ...A } } fragment A on User { ...B } fragment B on User { ...A }`,
+			expectedErr: "spreads itself",
+		},
+		{
+			name:        "undefined fragment",
+			query:       `{ viewer { ...Missing } }`,
+			expectedErr: "fragment Missing is not defined",
+		},
+		{
+			name:        "duplicate fragment",
+			query:       `{ viewer { ...A } } fragment A on User { login } fragment A on User { name }`,
+			expectedErr: "fragment A is defined more than once",
+		},
+		{
+			name:        "unterminated string",
+			query:       `{ search(query: "open) { issueCount } }`,
+			expectedErr: "unterminated string",
+		},
+		{
+			name:        "unterminated block string",
+			query:       `{ search(query: """open) { issueCount } }`,
+			expectedErr: "unterminated block string",
+		},
+		{
+			name:        "unbalanced braces",
+			query:       `{ viewer { login }`,
+			expectedErr: "unexpected end of document",
+		},
+		{
+			name:        "trailing tokens",
+			query:       `{ viewer { login } } }`,
+			expectedErr: "expected an operation or fragment",
+		},
+		{
+			name:        "empty selection set",
+			query:       `{ viewer { } }`,
+			expectedErr: "empty selection set",
+		},
+		{
+			name:        "empty document",
+			query:       "  \n",
+			expectedErr: "query must not be empty",
+		},
+		{
+			name:        "too long",
+			query:       "{ viewer { login } }" + strings.Repeat(" ", MaxGraphQLQueryLength),
+			expectedErr: "more than the limit of 10000",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateGraphQLQuery(tc.query)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MaxGraphQLVariablesLength caps the size in bytes of the serialized
+// variables of a run_graphql_query call.
+const MaxGraphQLVariablesLength = 10000
+
+// GraphQLQueryError is an entry of the errors a GraphQL response carries.
+type GraphQLQueryError struct {
+	Message string `json:"message"`
+	Type    string `json:"type,omitempty"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+// GraphQLQueryResult is the output of run_graphql_query: the response data
+// and any errors, which can come with partial data.
+type GraphQLQueryResult struct {
+	Data   json.RawMessage     `json:"data"`
+	Errors []GraphQLQueryError `json:"errors,omitempty"`
+}
+
+// graphQLEndpoint returns the GraphQL endpoint of the API the REST client
+// talks to: /graphql on GitHub.com and GHE.com, and /api/graphql next to
+// /api/v3 on GitHub Enterprise Server.
+func graphQLEndpoint(client *github.Client) (string, error) {
+	u, err := url.Parse(client.BaseURL())
+	if err != nil {
+		return "", err
+	}
+	if strings.HasSuffix(u.Path, "/api/v3/") {
+		u.Path = strings.TrimSuffix(u.Path, "v3/") + "graphql"
+	} else {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/graphql"
+	}
+	return u.String(), nil
+}
+
+// RunGraphQLQuery creates a tool that runs a caller-supplied, read-only
+// GraphQL query. It is gated behind FeatureFlagGraphQLQuery.
+func RunGraphQLQuery(t translations.TranslationHelperFunc) inventory.ServerTool {
+	st := NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: "run_graphql_query",
+			Description: t("TOOL_RUN_GRAPHQL_QUERY_DESCRIPTION", fmt.Sprintf("Run a read-only query against the GitHub GraphQL API, for data the other tools do not expose. "+
+				"The document must hold exactly one query operation, plus any fragments it uses; mutations and subscriptions are refused. "+
+				"Documents are limited to %d bytes and %d levels of nested fields, and fields exposing single sign-on or security configuration, and __schema, are not allowed. "+
+				"Returns the response data and any GraphQL errors.", MaxGraphQLQueryLength, MaxGraphQLQueryDepth)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_RUN_GRAPHQL_QUERY_USER_TITLE", "Run GraphQL query"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"query": {
+						Type:        "string",
+						Description: "The GraphQL query document",
+					},
+					"variables": {
+						Type:        "object",
+						Description: "Values for the variables the query declares",
+					},
+				},
+				Required: []string{"query"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			// A document can read any repository by name or through nested
+			// connections, so it cannot be checked against the policy.
+			if deps.GetRepoPolicy() != nil {
+				return utils.NewToolResultError("run_graphql_query is not available when the server restricts repositories with --allowed-repos or --denied-repos"), nil, nil
+			}
+			query, err := RequiredParam[string](args, "query")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			variables, err := OptionalParam[map[string]any](args, "variables")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if err := validateGraphQLQuery(query); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if encoded, err := json.Marshal(variables); err != nil {
+				return utils.NewToolResultErrorFromErr("invalid variables", err), nil, nil
+			} else if len(encoded) > MaxGraphQLVariablesLength {
+				return utils.NewToolResultError(fmt.Sprintf("variables are %d bytes, more than the limit of %d", len(encoded), MaxGraphQLVariablesLength)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			// The GraphQL client only runs queries built from Go types, so the
+			// document is posted through the REST client's authenticated transport.
+			body := map[string]any{"query": query}
+			if len(variables) > 0 {
+				body["variables"] = variables
+			}
+			endpoint, err := graphQLEndpoint(client)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to determine the GraphQL endpoint: %w", err)
+			}
+			httpReq, err := client.NewRequest(ctx, http.MethodPost, endpoint, body)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to build GraphQL request: %w", err)
+			}
+			var result GraphQLQueryResult
+			resp, err := client.Do(httpReq, &result)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to run GraphQL query", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			if len(result.Data) == 0 || string(result.Data) == "null" {
+				if len(result.Errors) == 0 {
+					return utils.NewToolResultError("failed to run GraphQL query: the response has no data"), nil, nil
+				}
+				callResult := MarshalledTextResult(result)
+				callResult.IsError = true
+				return callResult, nil, nil
+			}
+			return limitResponseSize(deps, MarshalledTextResult(result), "Select fewer fields, or request fewer nodes with first or last and page with after or before."), nil, nil
+		})
+	st.FeatureFlagEnable = FeatureFlagGraphQLQuery
+	return st
+}
@@ -0,0 +1,140 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RunGraphQLQuery(t *testing.T) {
+	serverTool := RunGraphQLQuery(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "run_graphql_query", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, FeatureFlagGraphQLQuery, serverTool.FeatureFlagEnable)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"query"})
+
+	tests := []struct {
+		name             string
+		args             map[string]any
+		response         string
+		expectedRequest  map[string]any
+		expectedResult   string
+		expectedErrMsg   string
+		expectedErrorRes bool
+	}{
+		{
+			name:     "returns the data",
+			args:     map[string]any{"query": "query($login: String!) { user(login: $login) { name } }", "variables": map[string]any{"login": "octocat"}},
+			response: `{"data": {"user": {"name": "The Octocat"}}}`,
+			expectedRequest: map[string]any{
+				"query":     "query($login: String!) { user(login: $login) { name } }",
+				"variables": map[string]any{"login": "octocat"},
+			},
+			expectedResult: `{"data": {"user": {"name": "The Octocat"}}}`,
+		},
+		{
+			name:            "returns partial data with errors",
+			args:            map[string]any{"query": "{ a: user(login: \"octocat\") { name } b: user(login: \"ghost-404\") { name } }"},
+			response:        `{"data": {"a": {"name": "The Octocat"}, "b": null}, "errors": [{"type": "NOT_FOUND", "path": ["b"], "message": "Could not resolve to a User with the login of 'ghost-404'."}]}`,
+			expectedRequest: map[string]any{"query": "{ a: user(login: \"octocat\") { name } b: user(login: \"ghost-404\") { name } }"},
+			expectedResult:  `{"data": {"a": {"name": "The Octocat"}, "b": null}, "errors": [{"type": "NOT_FOUND", "path": ["b"], "message": "Could not resolve to a User with the login of 'ghost-404'."}]}`,
+		},
+		{
+			name:             "errors without data",
+			args:             map[string]any{"query": "{ viewer { nope } }"},
+			response:         `{"errors": [{"message": "Field 'nope' doesn't exist on type 'User'"}]}`,
+			expectedRequest:  map[string]any{"query": "{ viewer { nope } }"},
+			expectedResult:   `{"data": null, "errors": [{"message": "Field 'nope' doesn't exist on type 'User'"}]}`,
+			expectedErrorRes: true,
+		},
+		{
+			name:           "mutation is refused before any request",
+			args:           map[string]any{"query": `mutation { addStar(input: {starrableId: "x"}) { clientMutationId } }`},
+			expectedErrMsg: "mutation operations are not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests []map[string]any
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"POST /graphql": func(w http.ResponseWriter, r *http.Request) {
+					body, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					var request map[string]any
+					require.NoError(t, json.Unmarshal(body, &request))
+					requests = append(requests, request)
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(tc.response))
+				},
+			}))}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				assert.Empty(t, requests)
+				return
+			}
+
+			assert.Equal(t, tc.expectedErrorRes, result.IsError)
+			assert.Equal(t, []map[string]any{tc.expectedRequest}, requests)
+			assert.JSONEq(t, tc.expectedResult, getTextResult(t, result).Text)
+		})
+	}
+
+	t.Run("refused under a repository policy before any request", func(t *testing.T) {
+		policy, err := NewRepoPolicy(nil, []string{"octo-org/secret-*"})
+		require.NoError(t, err)
+		requested := false
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"POST /graphql": func(w http.ResponseWriter, _ *http.Request) {
+					requested = true
+					w.WriteHeader(http.StatusOK)
+				},
+			})),
+			RepoPolicy: policy,
+		}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"query": `{ repository(owner: "octo-org", name: "secret-plans") { description } }`})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "not available when the server restricts repositories")
+		assert.False(t, requested)
+	})
+}
+
+func Test_GraphQLEndpoint(t *testing.T) {
+	tests := []struct {
+		baseURL  string
+		expected string
+	}{
+		{baseURL: "https://api.github.com/", expected: "https://api.github.com/graphql"},
+		{baseURL: "https://api.acme.ghe.com/", expected: "https://api.acme.ghe.com/graphql"},
+		{baseURL: "https://ghes.example.com/api/v3/", expected: "https://ghes.example.com/api/graphql"},
+	}
+	for _, tc := range tests {
+		client, err := github.NewClient(github.WithEnterpriseURLs(tc.baseURL, tc.baseURL))
+		require.NoError(t, err)
+		endpoint, err := graphQLEndpoint(client)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, endpoint)
+	}
+}
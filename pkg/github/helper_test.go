@@ -9,9 +9,13 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/github/github-mcp-server/internal/fixtures"
 	gogithub "github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	testifymock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -57,17 +61,24 @@ const (
 	GetReposCommitsCheckRunsByOwnerByRepoByRef = "GET /repos/{owner}/{repo}/commits/{ref}/check-runs"
 
 	// Issues endpoints
+	GetIssues                                                   = "GET /issues"
 	GetReposIssuesByOwnerByRepoByIssueNumber                    = "GET /repos/{owner}/{repo}/issues/{issue_number}"
 	GetReposIssuesCommentByOwnerByRepoByCommentID               = "GET /repos/{owner}/{repo}/issues/comments/{comment_id}"
+	PatchReposIssuesCommentByOwnerByRepoByCommentID             = "PATCH /repos/{owner}/{repo}/issues/comments/{comment_id}"
 	GetReposIssuesCommentsByOwnerByRepoByIssueNumber            = "GET /repos/{owner}/{repo}/issues/{issue_number}/comments"
+	GetReposIssuesByOwnerByRepo                                 = "GET /repos/{owner}/{repo}/issues"
 	PostReposIssuesByOwnerByRepo                                = "POST /repos/{owner}/{repo}/issues"
 	PostReposIssuesCommentsByOwnerByRepoByIssueNumber           = "POST /repos/{owner}/{repo}/issues/{issue_number}/comments"
 	PostReposIssuesReactionsByOwnerByRepoByIssueNumber          = "POST /repos/{owner}/{repo}/issues/{issue_number}/reactions"
+	PostReposIssuesLabelsByOwnerByRepoByIssueNumber             = "POST /repos/{owner}/{repo}/issues/{issue_number}/labels"
 	PatchReposIssuesByOwnerByRepoByIssueNumber                  = "PATCH /repos/{owner}/{repo}/issues/{issue_number}"
 	GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber           = "GET /repos/{owner}/{repo}/issues/{issue_number}/sub_issues"
 	PostReposIssuesSubIssuesByOwnerByRepoByIssueNumber          = "POST /repos/{owner}/{repo}/issues/{issue_number}/sub_issues"
 	DeleteReposIssuesSubIssueByOwnerByRepoByIssueNumber         = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/sub_issue"
 	PatchReposIssuesSubIssuesPriorityByOwnerByRepoByIssueNumber = "PATCH /repos/{owner}/{repo}/issues/{issue_number}/sub_issues/priority"
+	PutReposIssuesLockByOwnerByRepoByIssueNumber                = "PUT /repos/{owner}/{repo}/issues/{issue_number}/lock"
+	DeleteReposIssuesLockByOwnerByRepoByIssueNumber             = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/lock"
+	GetReposIssuesCommentsReactionsByOwnerByRepoByCommentID     = "GET /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions"
 	PostReposIssuesCommentsReactionsByOwnerByRepoByCommentID    = "POST /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions"
 	DeleteReposIssuesIssueFieldValueByOwnerByRepoByIssueNumber  = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/issue-field-values/{issue_field_id}"
 
@@ -207,6 +218,21 @@ func mustNewGHClient(t *testing.T, httpClient *http.Client) *gogithub.Client {
 	return client
 }
 
+// replayDeps returns dependencies whose REST and GraphQL clients replay the
+// named fixture from __fixtures__. Run the test with RECORD_FIXTURES=true and
+// GITHUB_PERSONAL_ACCESS_TOKEN set to record it against the real API instead;
+// see internal/fixtures.
+func replayDeps(t *testing.T, name string) BaseDeps {
+	t.Helper()
+	httpClient := &http.Client{Transport: fixtures.NewTransport(t, name)}
+	return BaseDeps{
+		Client:          mustNewGHClient(t, httpClient),
+		GQLClient:       githubv4.NewClient(httpClient),
+		RepoAccessCache: stubRepoAccessCache(nil, 15*time.Minute),
+		Flags:           stubFeatureFlags(nil),
+	}
+}
+
 // expect is a helper function to create a partial mock that expects various
 // request behaviors, such as path, query parameters, and request body.
 func expect(t *testing.T, e expectations) *partialMock {
@@ -395,6 +421,28 @@ func getTextResult(t *testing.T, result *mcp.CallToolResult) *mcp.TextContent {
 	return textContent
 }
 
+// requireStructuredContentMatchesSchema asserts that a tool result carries
+// structured content which validates against the tool's declared output schema
+// and mirrors the JSON text content.
+func requireStructuredContentMatchesSchema(t *testing.T, tool mcp.Tool, result *mcp.CallToolResult) {
+	t.Helper()
+	schema, ok := tool.OutputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "tool %s should declare an output schema", tool.Name)
+	resolved, err := schema.Resolve(nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, result.StructuredContent, "expected structured content")
+	data, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	var structured any
+	require.NoError(t, json.Unmarshal(data, &structured))
+	require.NoError(t, resolved.Validate(structured))
+
+	var text any
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &text))
+	assert.Equal(t, text, structured)
+}
+
 func getErrorResult(t *testing.T, result *mcp.CallToolResult) *mcp.TextContent {
 	res := getTextResult(t, result)
 	require.True(t, result.IsError, "expected tool call result to be an error")
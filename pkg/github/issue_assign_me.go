@@ -0,0 +1,143 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// viewerLogins holds the authenticated login of each session.
+var viewerLogins = newSessionCache[string]("viewer-login-cache", 20*time.Minute)
+
+// errAppTokenHasNoUser is returned when the token belongs to a GitHub App
+// installation, which has no user identity to assign.
+var errAppTokenHasNoUser = errors.New("the server is authenticated with a GitHub App installation token, which has no user identity and cannot be assigned to issues; assign a specific user with issue_write instead")
+
+// AssignIssueToMeResult is the output of assign_issue_to_me.
+type AssignIssueToMeResult struct {
+	Assignee  string   `json:"assignee"`
+	Assignees []string `json:"assignees"`
+	URL       string   `json:"url"`
+}
+
+// authenticatedLogin returns the login of the authenticated user, reusing the
+// value cached for session when there is one.
+func authenticatedLogin(ctx context.Context, client *github.Client, session *mcp.ServerSession) (string, *github.Response, error) {
+	if tokenInfo, ok := ghcontext.GetTokenInfo(ctx); ok && tokenInfo.TokenType == utils.TokenTypeServerToServerGitHubAppToken {
+		return "", nil, errAppTokenHasNoUser
+	}
+	if login, ok := viewerLogins.get(session, ""); ok {
+		return login, nil, nil
+	}
+
+	user, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		var errResp *github.ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusForbidden &&
+			strings.Contains(errResp.Message, "Resource not accessible by integration") {
+			return "", resp, errAppTokenHasNoUser
+		}
+		return "", resp, err
+	}
+	login := user.GetLogin()
+	if user.GetType() == "Bot" || strings.HasSuffix(login, "[bot]") {
+		return "", resp, errAppTokenHasNoUser
+	}
+
+	viewerLogins.set(session, "", login)
+	return login, resp, nil
+}
+
+// AssignIssueToMe creates a tool that assigns an issue to the authenticated user.
+func AssignIssueToMe(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "assign_issue_to_me",
+			Description: t("TOOL_ASSIGN_ISSUE_TO_ME_DESCRIPTION", "Assign an issue or pull request to the authenticated user, without needing to know their login. Existing assignees are kept. Returns the updated assignee list."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_ASSIGN_ISSUE_TO_ME_USER_TITLE", "Assign issue to me"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "Issue or pull request number to assign",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			var session *mcp.ServerSession
+			if req != nil {
+				session = req.Session
+			}
+			login, resp, err := authenticatedLogin(ctx, client, session)
+			if errors.Is(err, errAppTokenHasNoUser) {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get authenticated user", resp, err), nil, nil
+			}
+
+			issue, resp, err := client.Issues.AddAssignees(ctx, owner, repo, issueNumber, []string{login})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to assign %s to issue", login), resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := AssignIssueToMeResult{
+				Assignee:  login,
+				Assignees: []string{},
+				URL:       issue.GetHTMLURL(),
+			}
+			for _, assignee := range issue.Assignees {
+				result.Assignees = append(result.Assignees, assignee.GetLogin())
+			}
+			return MarshalledTextResult(result), nil, nil
+		},
+	)
+}
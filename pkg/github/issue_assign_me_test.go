@@ -0,0 +1,96 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AssignIssueToMe(t *testing.T) {
+	serverTool := AssignIssueToMe(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "assign_issue_to_me", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	const assigneesPath = "POST /repos/owner/repo/issues/42/assignees"
+	args := map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42)}
+
+	t.Run("assigns the authenticated user and caches the login per session", func(t *testing.T) {
+		userLookups := 0
+		var requested []string
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUser: func(w http.ResponseWriter, _ *http.Request) {
+				userLookups++
+				_ = json.NewEncoder(w).Encode(&github.User{Login: github.Ptr("octocat"), Type: github.Ptr("User")})
+			},
+			assigneesPath: func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Assignees []string `json:"assignees"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				requested = body.Assignees
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(&github.Issue{
+					HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/42"),
+					Assignees: []*github.User{{Login: github.Ptr("hubot")}, {Login: github.Ptr("octocat")}},
+				})
+			},
+		}))}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequestWithCapabilities(t, &mcp.ClientCapabilities{})
+		request.Params = createMCPRequest(args).Params
+		for range 2 {
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response AssignIssueToMeResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, AssignIssueToMeResult{
+				Assignee:  "octocat",
+				Assignees: []string{"hubot", "octocat"},
+				URL:       "https://github.com/owner/repo/issues/42",
+			}, response)
+		}
+		assert.Equal(t, []string{"octocat"}, requested)
+		assert.Equal(t, 1, userLookups)
+	})
+
+	t.Run("installation token rejected by the user endpoint", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUser: mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(args)
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Equal(t, errAppTokenHasNoUser.Error(), getErrorResult(t, result).Text)
+	})
+
+	t.Run("installation token detected from its prefix", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(args)
+		ctx := ghcontext.WithTokenInfo(context.Background(), &ghcontext.TokenInfo{Token: "ghs_x", TokenType: utils.TokenTypeServerToServerGitHubAppToken})
+		result, err := handler(ContextWithDeps(ctx, deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Equal(t, errAppTokenHasNoUser.Error(), getErrorResult(t, result).Text)
+	})
+}
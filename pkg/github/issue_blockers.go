@@ -0,0 +1,262 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MaxIssueBlockers caps how many referenced blockers get_issue_blockers
+// resolves, and how many blocked issues it lists.
+const MaxIssueBlockers = 20
+
+// issueReferenceExpr matches an issue reference: a full issue URL,
+// "owner/repo#123" or "#123".
+const issueReferenceExpr = `(?:https?://[^\s/]+/[\w.-]+/[\w.-]+/issues/\d+|(?:[\w.-]+/[\w.-]+)?#\d+)\b`
+
+// blockerPhrasePattern matches a "blocked by" or "depends on" phrase and the
+// list of issue references following it, e.g. "Blocked by #1, #2 and o/r#3"
+// or "**Depends on:** #4".
+var blockerPhrasePattern = regexp.MustCompile(`(?i)\b(?:blocked\s+by|depends\s+on)\b[\s:*_]*(` +
+	issueReferenceExpr + `(?:\s*(?:,|&|\band\b)\s*` + issueReferenceExpr + `)*)`)
+
+// issueReferencePattern splits one reference into its parts: owner, repo and
+// number of a URL, or owner, repo and number of a "#" reference.
+var issueReferencePattern = regexp.MustCompile(`https?://[^\s/]+/([\w.-]+)/([\w.-]+)/issues/(\d+)|(?:([\w.-]+)/([\w.-]+))?#(\d+)`)
+
+// issueReference identifies an issue referenced from another issue's body.
+type issueReference struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+func (r issueReference) matches(owner, repo string, number int) bool {
+	return r.Number == number && strings.EqualFold(r.Owner, owner) && strings.EqualFold(r.Repo, repo)
+}
+
+// IssueBlocker is an issue in a get_issue_blockers response. Error is set,
+// and State is "unknown", when a referenced issue could not be read.
+type IssueBlocker struct {
+	Number     int    `json:"number"`
+	Repository string `json:"repository"`
+	Title      string `json:"title,omitempty"`
+	State      string `json:"state"`
+	URL        string `json:"url,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// IssueBlockersResult is the output of get_issue_blockers. Ready is true when
+// every blocker is known to be closed.
+type IssueBlockersResult struct {
+	Number    int            `json:"number"`
+	Blockers  []IssueBlocker `json:"blockers"`
+	Blocks    []IssueBlocker `json:"blocks"`
+	Ready     bool           `json:"ready"`
+	Truncated bool           `json:"truncated,omitempty"`
+}
+
+// parseIssueBlockers returns the issues body declares as blockers with
+// "blocked by" or "depends on", in order of first mention. References without
+// a repository resolve to owner/repo, and references to the issue itself and
+// inside fenced code blocks are ignored.
+func parseIssueBlockers(body, owner, repo string, self int) []issueReference {
+	var refs []issueReference
+	seen := map[string]bool{}
+	for _, phrase := range blockerPhrasePattern.FindAllStringSubmatch(stripFencedCode(body), -1) {
+		for _, m := range issueReferencePattern.FindAllStringSubmatch(phrase[1], -1) {
+			ref := issueReference{Owner: owner, Repo: repo}
+			number := m[6]
+			switch {
+			case m[3] != "":
+				ref.Owner, ref.Repo, number = m[1], m[2], m[3]
+			case m[4] != "":
+				ref.Owner, ref.Repo = m[4], m[5]
+			}
+			n, err := strconv.Atoi(number)
+			if err != nil || n <= 0 {
+				continue
+			}
+			ref.Number = n
+			if ref.matches(owner, repo, self) {
+				continue
+			}
+			key := strings.ToLower(fmt.Sprintf("%s/%s#%d", ref.Owner, ref.Repo, n))
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// stripFencedCode blanks out the lines of fenced code blocks, where phrases
+// like "depends on #1" are examples rather than declarations.
+func stripFencedCode(body string) string {
+	lines := strings.Split(body, "\n")
+	inFence := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			lines[i] = ""
+			continue
+		}
+		if inFence {
+			lines[i] = ""
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// resolveIssueBlocker reads a referenced issue. A failure is reported on the
+// blocker rather than failing the whole call.
+func resolveIssueBlocker(ctx context.Context, client *github.Client, ref issueReference) IssueBlocker {
+	blocker := IssueBlocker{Number: ref.Number, Repository: ref.Owner + "/" + ref.Repo}
+	issue, resp, err := client.Issues.Get(ctx, ref.Owner, ref.Repo, ref.Number)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get blocking issue", resp, err)
+		blocker.State = "unknown"
+		blocker.Error = err.Error()
+		return blocker
+	}
+	blocker.Title = sanitize.Sanitize(issue.GetTitle())
+	blocker.State = issue.GetState()
+	blocker.URL = issue.GetHTMLURL()
+	return blocker
+}
+
+// GetIssueBlockers creates a tool that infers which issues block an issue, and
+// which issues it blocks, from "blocked by" and "depends on" phrases in issue
+// bodies.
+func GetIssueBlockers(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "get_issue_blockers",
+			Description: t("TOOL_GET_ISSUE_BLOCKERS_DESCRIPTION", fmt.Sprintf("Infer an issue's blockers from 'Blocked by #123' or 'Depends on owner/repo#123' phrases in its body, with each blocker's current state, "+
+				"and find the issues in the same repository whose bodies say they are blocked by it. ready is true when every blocker is closed. "+
+				"Up to %d of each are returned. For dependencies recorded with GitHub's issue dependencies feature, use issue_dependency_read instead.", MaxIssueBlockers)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_ISSUE_BLOCKERS_USER_TITLE", "Get issue blockers"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The issue number",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			result := IssueBlockersResult{
+				Number:   issueNumber,
+				Blockers: []IssueBlocker{},
+				Blocks:   []IssueBlocker{},
+				Ready:    true,
+			}
+
+			refs := parseIssueBlockers(issue.GetBody(), owner, repo, issueNumber)
+			if len(refs) > MaxIssueBlockers {
+				refs = refs[:MaxIssueBlockers]
+				result.Truncated = true
+			}
+			for _, ref := range refs {
+				blocker := resolveIssueBlocker(ctx, client, ref)
+				if blocker.State != "closed" {
+					result.Ready = false
+				}
+				result.Blockers = append(result.Blockers, blocker)
+			}
+
+			// Search narrows the candidates; each hit's body is then parsed so
+			// that mentions that merely contain the phrase aren't reported.
+			query := fmt.Sprintf(`repo:%s/%s is:issue "blocked by #%d" OR "depends on #%d"`, owner, repo, issueNumber, issueNumber)
+			found, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to search for blocked issues", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+			for _, candidate := range found.Issues {
+				if candidate.GetNumber() == issueNumber {
+					continue
+				}
+				for _, ref := range parseIssueBlockers(candidate.GetBody(), owner, repo, candidate.GetNumber()) {
+					if !ref.matches(owner, repo, issueNumber) {
+						continue
+					}
+					if len(result.Blocks) == MaxIssueBlockers {
+						result.Truncated = true
+						break
+					}
+					result.Blocks = append(result.Blocks, IssueBlocker{
+						Number:     candidate.GetNumber(),
+						Repository: owner + "/" + repo,
+						Title:      sanitize.Sanitize(candidate.GetTitle()),
+						State:      candidate.GetState(),
+						URL:        candidate.GetHTMLURL(),
+					})
+					break
+				}
+			}
+
+			callResult := MarshalledTextResult(result)
+			callResult = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, callResult, ifc.LabelRepoUserContent)
+			return callResult, nil, nil
+		})
+}
@@ -0,0 +1,223 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseIssueBlockers(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []issueReference
+	}{
+		{
+			name:     "no phrase",
+			body:     "Fixes #1 and relates to #2",
+			expected: nil,
+		},
+		{
+			name: "blocked by list",
+			body: "Blocked by #1, #2 and #3",
+			expected: []issueReference{
+				{Owner: "acme", Repo: "app", Number: 1},
+				{Owner: "acme", Repo: "app", Number: 2},
+				{Owner: "acme", Repo: "app", Number: 3},
+			},
+		},
+		{
+			name: "depends on with colon and cross-repository references",
+			body: "**Depends on:** other/lib#7 & https://github.com/acme/api/issues/12",
+			expected: []issueReference{
+				{Owner: "other", Repo: "lib", Number: 7},
+				{Owner: "acme", Repo: "api", Number: 12},
+			},
+		},
+		{
+			name: "case-insensitive phrases on several lines",
+			body: "BLOCKED BY #4\n- [ ] depends on #5",
+			expected: []issueReference{
+				{Owner: "acme", Repo: "app", Number: 4},
+				{Owner: "acme", Repo: "app", Number: 5},
+			},
+		},
+		{
+			name: "duplicates and self-references are dropped",
+			body: "Blocked by #4, #10 and acme/app#4. Depends on ACME/APP#4",
+			expected: []issueReference{
+				{Owner: "acme", Repo: "app", Number: 4},
+			},
+		},
+		{
+			name:     "fenced code is ignored",
+			body:     "Example:\n```\nblocked by #6\n```\nNothing else.",
+			expected: nil,
+		},
+		{
+			name: "list ends at the first non-reference",
+			body: "Blocked by #8 until #9 ships",
+			expected: []issueReference{
+				{Owner: "acme", Repo: "app", Number: 8},
+			},
+		},
+		{
+			name:     "reference must follow the phrase",
+			body:     "Depends on the release in #11",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parseIssueBlockers(tc.body, "acme", "app", 10))
+		})
+	}
+}
+
+func Test_GetIssueBlockers(t *testing.T) {
+	serverTool := GetIssueBlockers(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_issue_blockers", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	issues := map[string]*github.Issue{
+		"/repos/acme/app/issues/10": {Number: github.Ptr(10), State: github.Ptr("open"), Body: github.Ptr("Blocked by #1 and other/lib#2")},
+		"/repos/acme/app/issues/1":  {Number: github.Ptr(1), Title: github.Ptr("Schema migration"), State: github.Ptr("closed"), HTMLURL: github.Ptr("https://github.com/acme/app/issues/1")},
+		"/repos/other/lib/issues/2": {Number: github.Ptr(2), Title: github.Ptr("Client release"), State: github.Ptr("open"), HTMLURL: github.Ptr("https://github.com/other/lib/issues/2")},
+		"/repos/acme/app/issues/20": {Number: github.Ptr(20), State: github.Ptr("open"), Body: github.Ptr("Depends on #1")},
+	}
+	getIssue := func(w http.ResponseWriter, r *http.Request) {
+		issue, ok := issues[r.URL.Path]
+		if !ok {
+			mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+			return
+		}
+		mockResponse(t, http.StatusOK, issue)(w, r)
+	}
+	var queries []string
+	searchBlocked := func(results ...*github.Issue) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.Query().Get("q"))
+			mockResponse(t, http.StatusOK, &github.IssuesSearchResult{Total: github.Ptr(len(results)), Issues: results})(w, r)
+		}
+	}
+
+	t.Run("resolves blockers and verifies blocked issues", func(t *testing.T) {
+		queries = nil
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposIssuesByOwnerByRepoByIssueNumber: getIssue,
+			GetSearchIssues: searchBlocked(
+				&github.Issue{Number: github.Ptr(11), Title: github.Ptr("Rollout"), State: github.Ptr("open"), HTMLURL: github.Ptr("https://github.com/acme/app/issues/11"), Body: github.Ptr("Blocked by #3 and #10")},
+				// Mentions the phrase, but blocks on #100 rather than #10.
+				&github.Issue{Number: github.Ptr(12), Title: github.Ptr("Docs"), State: github.Ptr("open"), Body: github.Ptr("Blocked by #100. Not blocked by anything else.")},
+			),
+		}))}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"owner": "acme", "repo": "app", "issue_number": float64(10)})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response IssueBlockersResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, 10, response.Number)
+		assert.False(t, response.Ready)
+		assert.Equal(t, []IssueBlocker{
+			{Number: 1, Repository: "acme/app", Title: "Schema migration", State: "closed", URL: "https://github.com/acme/app/issues/1"},
+			{Number: 2, Repository: "other/lib", Title: "Client release", State: "open", URL: "https://github.com/other/lib/issues/2"},
+		}, response.Blockers)
+		assert.Equal(t, []IssueBlocker{
+			{Number: 11, Repository: "acme/app", Title: "Rollout", State: "open", URL: "https://github.com/acme/app/issues/11"},
+		}, response.Blocks)
+
+		require.Len(t, queries, 1)
+		assert.Equal(t, `repo:acme/app is:issue "blocked by #10" OR "depends on #10"`, queries[0])
+	})
+
+	t.Run("ready when every blocker is closed", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposIssuesByOwnerByRepoByIssueNumber: getIssue,
+			GetSearchIssues:                          searchBlocked(),
+		}))}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"owner": "acme", "repo": "app", "issue_number": float64(20)})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+
+		var response IssueBlockersResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.True(t, response.Ready)
+		require.Len(t, response.Blockers, 1)
+		assert.Empty(t, response.Blocks)
+	})
+
+	t.Run("unreadable blocker is reported and not ready", func(t *testing.T) {
+		issues["/repos/acme/app/issues/30"] = &github.Issue{Number: github.Ptr(30), State: github.Ptr("open"), Body: github.Ptr("Depends on #404")}
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposIssuesByOwnerByRepoByIssueNumber: getIssue,
+			GetSearchIssues:                          searchBlocked(),
+		}))}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"owner": "acme", "repo": "app", "issue_number": float64(30)})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+
+		var response IssueBlockersResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.False(t, response.Ready)
+		require.Len(t, response.Blockers, 1)
+		assert.Equal(t, "unknown", response.Blockers[0].State)
+		assert.Contains(t, response.Blockers[0].Error, "404")
+	})
+
+	t.Run("blockers beyond the cap are truncated", func(t *testing.T) {
+		refs := make([]string, 0, MaxIssueBlockers+5)
+		for i := 1; i <= MaxIssueBlockers+5; i++ {
+			refs = append(refs, fmt.Sprintf("#%d", i))
+		}
+		issues["/repos/acme/app/issues/99"] = &github.Issue{Number: github.Ptr(99), State: github.Ptr("open"), Body: github.Ptr("Blocked by " + strings.Join(refs, ", "))}
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposIssuesByOwnerByRepoByIssueNumber: getIssue,
+			GetSearchIssues:                          searchBlocked(),
+		}))}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"owner": "acme", "repo": "app", "issue_number": float64(99)})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+
+		var response IssueBlockersResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.True(t, response.Truncated)
+		assert.Len(t, response.Blockers, MaxIssueBlockers)
+	})
+
+	t.Run("missing issue fails", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposIssuesByOwnerByRepoByIssueNumber: getIssue,
+		}))}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"owner": "acme", "repo": "app", "issue_number": float64(404)})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to get issue")
+	})
+}
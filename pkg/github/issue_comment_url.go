@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// issueLink is a parsed issue or pull request permalink. CommentID is zero
+// for plain links without an issuecomment anchor.
+type issueLink struct {
+	Owner       string
+	Repo        string
+	IssueNumber int
+	CommentID   int64
+}
+
+// MinimalCommentByURL is the output type for get_comment_by_url when the link
+// targets a specific comment.
+type MinimalCommentByURL struct {
+	IssueNumber int                 `json:"issue_number"`
+	IssueTitle  string              `json:"issue_title"`
+	Comment     MinimalIssueComment `json:"comment"`
+}
+
+// webHostForAPI returns the web host that serves permalinks for the given
+// REST API base URL: api.github.com -> github.com, api.<tenant>.ghe.com ->
+// <tenant>.ghe.com, and a GHES host (https://ghes.example.com/api/v3/) as-is.
+func webHostForAPI(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" {
+		return "github.com"
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Host), "api.")
+}
+
+// parseIssueLink parses an issue or pull request permalink on webHost, with
+// an optional #issuecomment-<id> anchor.
+func parseIssueLink(rawURL, webHost string) (issueLink, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return issueLink{}, fmt.Errorf("invalid URL %q: expected a link like https://%s/owner/repo/issues/42#issuecomment-123", rawURL, webHost)
+	}
+	if !strings.EqualFold(u.Host, webHost) {
+		return issueLink{}, fmt.Errorf("URL host %q does not match the configured GitHub host %q", u.Host, webHost)
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) >= 3 && parts[2] == "discussions" {
+		return issueLink{}, fmt.Errorf("discussion links are not supported; use get_discussion or get_discussion_comments instead")
+	}
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || (parts[2] != "issues" && parts[2] != "pull") {
+		return issueLink{}, fmt.Errorf("URL %q is not an issue or pull request link: expected /owner/repo/issues/<number>", rawURL)
+	}
+	number, err := strconv.Atoi(parts[3])
+	if err != nil || number < 1 {
+		return issueLink{}, fmt.Errorf("URL %q has an invalid issue number %q", rawURL, parts[3])
+	}
+	link := issueLink{Owner: parts[0], Repo: parts[1], IssueNumber: number}
+
+	switch {
+	case strings.HasPrefix(u.Fragment, "issuecomment-"):
+		id, err := strconv.ParseInt(strings.TrimPrefix(u.Fragment, "issuecomment-"), 10, 64)
+		if err != nil || id < 1 {
+			return issueLink{}, fmt.Errorf("URL %q has an invalid comment anchor %q", rawURL, u.Fragment)
+		}
+		link.CommentID = id
+	case strings.HasPrefix(u.Fragment, "discussioncomment-"):
+		return issueLink{}, fmt.Errorf("discussion comment anchors are not supported; use get_discussion_comments instead")
+	}
+	return link, nil
+}
+
+// GetCommentByURL creates a tool that resolves an issue comment permalink (or
+// a plain issue link) to its content.
+func GetCommentByURL(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "get_comment_by_url",
+			Description: t("TOOL_GET_COMMENT_BY_URL_DESCRIPTION", "Resolve a GitHub issue or pull request comment permalink (e.g. https://github.com/owner/repo/issues/42#issuecomment-123) to the comment body, author, timestamps and parent issue. Plain issue links return the issue itself."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_COMMENT_BY_URL_USER_TITLE", "Get comment by URL"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"url": {
+						Type:        "string",
+						Description: "Issue comment permalink or plain issue/pull request URL",
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			rawURL, err := RequiredParam[string](args, "url")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			link, err := parseIssueLink(rawURL, webHostForAPI(client.BaseURL()))
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if err := deps.GetRepoPolicy().checkRepo(link.Owner, link.Repo); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			if link.CommentID == 0 {
+				result, err := GetIssue(ctx, client, deps, link.Owner, link.Repo, link.IssueNumber, 0)
+				if result != nil {
+					result = attachRepoVisibilityIFCLabel(ctx, deps, client, link.Owner, link.Repo, result, ifc.LabelRepoUserContent)
+				}
+				return result, nil, err
+			}
+
+			result, err := getCommentByLink(ctx, client, deps, link)
+			if result != nil {
+				result = attachRepoVisibilityIFCLabel(ctx, deps, client, link.Owner, link.Repo, result, ifc.LabelRepoUserContent)
+			}
+			return result, nil, err
+		})
+}
+
+func getCommentByLink(ctx context.Context, client *github.Client, deps ToolDependencies, link issueLink) (*mcp.CallToolResult, error) {
+	comment, resp, err := client.Issues.GetComment(ctx, link.Owner, link.Repo, link.CommentID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get comment", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get comment", resp, body), nil
+	}
+
+	if !strings.HasSuffix(comment.GetIssueURL(), fmt.Sprintf("/issues/%d", link.IssueNumber)) {
+		return utils.NewToolResultError(fmt.Sprintf("comment %d does not belong to issue #%d", link.CommentID, link.IssueNumber)), nil
+	}
+
+	if deps.GetFlags(ctx).LockdownMode {
+		cache, err := deps.GetRepoAccessCache(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get repo access cache: %w", err)
+		}
+		if cache == nil {
+			return nil, fmt.Errorf("lockdown cache is not configured")
+		}
+		if login := comment.GetUser().GetLogin(); login != "" {
+			isSafeContent, err := cache.IsSafeContent(ctx, login, link.Owner, link.Repo)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("failed to check lockdown mode: %v", err)), nil
+			}
+			if !isSafeContent {
+				return utils.NewToolResultError("access to comment is restricted by lockdown mode"), nil
+			}
+		}
+	}
+
+	issue, issueResp, err := client.Issues.Get(ctx, link.Owner, link.Repo, link.IssueNumber)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get parent issue", issueResp, err), nil
+	}
+	_ = issueResp.Body.Close()
+
+	return MarshalledTextResult(MinimalCommentByURL{
+		IssueNumber: link.IssueNumber,
+		IssueTitle:  sanitize.Sanitize(issue.GetTitle()),
+		Comment:     convertToMinimalIssueComment(comment),
+	}), nil
+}
@@ -0,0 +1,235 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_webHostForAPI(t *testing.T) {
+	tests := []struct {
+		baseURL  string
+		expected string
+	}{
+		{baseURL: "https://api.github.com/", expected: "github.com"},
+		{baseURL: "https://api.octocorp.ghe.com/", expected: "octocorp.ghe.com"},
+		{baseURL: "https://ghes.example.com/api/v3/", expected: "ghes.example.com"},
+		{baseURL: "", expected: "github.com"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.baseURL, func(t *testing.T) {
+			assert.Equal(t, tc.expected, webHostForAPI(tc.baseURL))
+		})
+	}
+}
+
+func Test_parseIssueLink(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		webHost     string
+		expected    issueLink
+		expectedErr string
+	}{
+		{
+			name:     "issue comment permalink",
+			url:      "https://github.com/owner/repo/issues/42#issuecomment-123456",
+			webHost:  "github.com",
+			expected: issueLink{Owner: "owner", Repo: "repo", IssueNumber: 42, CommentID: 123456},
+		},
+		{
+			name:     "pull request comment permalink",
+			url:      "https://github.com/owner/repo/pull/7#issuecomment-99",
+			webHost:  "github.com",
+			expected: issueLink{Owner: "owner", Repo: "repo", IssueNumber: 7, CommentID: 99},
+		},
+		{
+			name:     "plain issue link",
+			url:      "https://github.com/owner/repo/issues/42",
+			webHost:  "github.com",
+			expected: issueLink{Owner: "owner", Repo: "repo", IssueNumber: 42},
+		},
+		{
+			name:     "enterprise server hostname",
+			url:      "https://ghes.example.com/owner/repo/issues/42#issuecomment-5",
+			webHost:  "ghes.example.com",
+			expected: issueLink{Owner: "owner", Repo: "repo", IssueNumber: 42, CommentID: 5},
+		},
+		{
+			name:     "enterprise cloud hostname is case-insensitive",
+			url:      "https://OctoCorp.ghe.com/owner/repo/issues/1#issuecomment-2",
+			webHost:  "octocorp.ghe.com",
+			expected: issueLink{Owner: "owner", Repo: "repo", IssueNumber: 1, CommentID: 2},
+		},
+		{
+			name:        "github.com link against enterprise host",
+			url:         "https://github.com/owner/repo/issues/42#issuecomment-5",
+			webHost:     "ghes.example.com",
+			expectedErr: "does not match the configured GitHub host",
+		},
+		{
+			name:        "non-GitHub URL",
+			url:         "https://example.com/owner/repo/issues/42",
+			webHost:     "github.com",
+			expectedErr: "does not match the configured GitHub host",
+		},
+		{
+			name:        "discussion comment anchor is rejected with guidance",
+			url:         "https://github.com/owner/repo/issues/42#discussioncomment-77",
+			webHost:     "github.com",
+			expectedErr: "use get_discussion_comments",
+		},
+		{
+			name:        "discussion link is rejected with guidance",
+			url:         "https://github.com/owner/repo/discussions/3#discussioncomment-77",
+			webHost:     "github.com",
+			expectedErr: "use get_discussion or get_discussion_comments",
+		},
+		{
+			name:        "malformed comment anchor",
+			url:         "https://github.com/owner/repo/issues/42#issuecomment-abc",
+			webHost:     "github.com",
+			expectedErr: "invalid comment anchor",
+		},
+		{
+			name:        "not a URL",
+			url:         "owner/repo#42",
+			webHost:     "github.com",
+			expectedErr: "invalid URL",
+		},
+		{
+			name:        "repository link",
+			url:         "https://github.com/owner/repo",
+			webHost:     "github.com",
+			expectedErr: "is not an issue or pull request link",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			link, err := parseIssueLink(tc.url, tc.webHost)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, link)
+		})
+	}
+}
+
+func Test_GetCommentByURL(t *testing.T) {
+	serverTool := GetCommentByURL(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_comment_by_url", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, []string{"url"}, tool.InputSchema.(*jsonschema.Schema).Required)
+
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockComment := &github.IssueComment{
+		ID:        github.Ptr(int64(123456)),
+		Body:      github.Ptr("Root cause is the cache TTL"),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/42#issuecomment-123456"),
+		IssueURL:  github.Ptr("https://api.github.com/repos/owner/repo/issues/42"),
+		User:      &github.User{Login: github.Ptr("octocat")},
+		CreatedAt: &github.Timestamp{Time: createdAt},
+		UpdatedAt: &github.Timestamp{Time: createdAt},
+	}
+	mockIssue := &github.Issue{
+		Number:  github.Ptr(42),
+		Title:   github.Ptr("Cache misses spike after deploy"),
+		State:   github.Ptr("open"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/42"),
+		User:    &github.User{Login: github.Ptr("reporter")},
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		handlers       map[string]http.HandlerFunc
+		expectError    string
+		expectComment  bool
+		expectedNumber int
+	}{
+		{
+			name: "comment permalink",
+			url:  "https://github.com/owner/repo/issues/42#issuecomment-123456",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesCommentByOwnerByRepoByCommentID: mockResponse(t, http.StatusOK, mockComment),
+				GetReposIssuesByOwnerByRepoByIssueNumber:      mockResponse(t, http.StatusOK, mockIssue),
+			},
+			expectComment:  true,
+			expectedNumber: 42,
+		},
+		{
+			name: "plain issue link falls back to the issue",
+			url:  "https://github.com/owner/repo/issues/42",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockIssue),
+			},
+			expectedNumber: 42,
+		},
+		{
+			name: "comment from another issue",
+			url:  "https://github.com/owner/repo/issues/7#issuecomment-123456",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesCommentByOwnerByRepoByCommentID: mockResponse(t, http.StatusOK, mockComment),
+			},
+			expectError: "does not belong to issue #7",
+		},
+		{
+			name:        "non-GitHub URL",
+			url:         "https://gitlab.com/owner/repo/issues/42#note_1",
+			handlers:    map[string]http.HandlerFunc{},
+			expectError: "does not match the configured GitHub host",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(map[string]any{"url": tc.url})
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectError)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			text := getTextResult(t, result).Text
+			if tc.expectComment {
+				var resp MinimalCommentByURL
+				require.NoError(t, json.Unmarshal([]byte(text), &resp))
+				assert.Equal(t, tc.expectedNumber, resp.IssueNumber)
+				assert.Equal(t, "Cache misses spike after deploy", resp.IssueTitle)
+				assert.Equal(t, int64(123456), resp.Comment.ID)
+				assert.Equal(t, "Root cause is the cache TTL", resp.Comment.Body)
+				assert.Equal(t, "octocat", resp.Comment.User.Login)
+				assert.Equal(t, createdAt.Format(time.RFC3339), resp.Comment.CreatedAt)
+				return
+			}
+
+			var issue MinimalIssue
+			require.NoError(t, json.Unmarshal([]byte(text), &issue))
+			assert.Equal(t, tc.expectedNumber, issue.Number)
+			assert.Equal(t, "Cache misses spike after deploy", issue.Title)
+		})
+	}
+}
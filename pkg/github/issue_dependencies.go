@@ -122,7 +122,7 @@ Options are:
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -311,7 +311,7 @@ Options are:
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
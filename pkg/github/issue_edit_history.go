@@ -0,0 +1,338 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// MaxIssueEditDiffLines caps the diff returned for a single edit.
+	MaxIssueEditDiffLines = 100
+	// issueEditDiffContext is the number of unchanged lines kept around each change.
+	issueEditDiffContext = 3
+	// maxIssueEditDiffCells bounds the line-diff table; larger bodies are
+	// reported as a full replacement instead of a minimal diff.
+	maxIssueEditDiffCells = 4_000_000
+)
+
+// IssueEdit is one entry in a get_issue_edit_history response.
+type IssueEdit struct {
+	Editor        string `json:"editor"`
+	EditedAt      string `json:"edited_at"`
+	Deleted       bool   `json:"deleted,omitempty"`
+	Diff          string `json:"diff,omitempty"`
+	DiffTruncated bool   `json:"diff_truncated,omitempty"`
+	Note          string `json:"note,omitempty"`
+}
+
+// IssueEditHistory is the output type for get_issue_edit_history. Edits are
+// newest first.
+type IssueEditHistory struct {
+	IssueNumber int         `json:"issue_number"`
+	Edits       []IssueEdit `json:"edits"`
+	TotalCount  int         `json:"total_count"`
+	PageInfo    struct {
+		HasNextPage bool   `json:"has_next_page"`
+		EndCursor   string `json:"end_cursor,omitempty"`
+	} `json:"page_info"`
+}
+
+type issueEditHistoryQuery struct {
+	Repository struct {
+		Issue struct {
+			Number githubv4.Int
+			Author *struct {
+				Login githubv4.String
+			}
+			UserContentEdits struct {
+				Nodes []struct {
+					Editor *struct {
+						Login githubv4.String
+					}
+					EditedAt  githubv4.DateTime
+					DeletedAt *githubv4.DateTime
+					Diff      *githubv4.String
+				}
+				PageInfo struct {
+					HasNextPage githubv4.Boolean
+					EndCursor   githubv4.String
+				}
+				TotalCount githubv4.Int
+			} `graphql:"userContentEdits(first: $first, after: $after)"`
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetIssueEditHistory creates a tool that lists the edits made to an issue's
+// body, each with a unified diff against the previous revision.
+func GetIssueEditHistory(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "get_issue_edit_history",
+			Description: t("TOOL_GET_ISSUE_EDIT_HISTORY_DESCRIPTION", fmt.Sprintf("Get the edit history of an issue's description, newest first. Each edit includes the editor, the time of the edit and a unified diff against the previous revision (capped at %d lines). An issue that was never edited returns no edits.", MaxIssueEditDiffLines)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_ISSUE_EDIT_HISTORY_USER_TITLE", "Get issue edit history"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithCursorPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the issue",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalCursorPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			paginationParams, err := pagination.ToGraphQLParams()
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub graphql client", err), nil, nil
+			}
+
+			vars := map[string]any{
+				"owner":       githubv4.String(owner),
+				"repo":        githubv4.String(repo),
+				"issueNumber": githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+				"first":       githubv4.Int(*paginationParams.First),
+				"after":       (*githubv4.String)(nil),
+			}
+			if paginationParams.After != nil {
+				vars["after"] = githubv4.String(*paginationParams.After)
+			}
+
+			var query issueEditHistoryQuery
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get issue edit history", err), nil, nil
+			}
+
+			// Every revision is the issue's content, so lockdown mode applies
+			// the same author check as issue_read.
+			if deps.GetFlags(ctx).LockdownMode {
+				cache, err := deps.GetRepoAccessCache(ctx)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to get repo access cache: %w", err)
+				}
+				if cache == nil {
+					return nil, nil, fmt.Errorf("lockdown cache is not configured")
+				}
+				if author := query.Repository.Issue.Author; author != nil && author.Login != "" {
+					isSafeContent, err := cache.IsSafeContent(ctx, string(author.Login), owner, repo)
+					if err != nil {
+						return utils.NewToolResultError(fmt.Sprintf("failed to check lockdown mode: %v", err)), nil, nil
+					}
+					if !isSafeContent {
+						return utils.NewToolResultError("access to issue details is restricted by lockdown mode"), nil, nil
+					}
+				}
+			}
+
+			result := MarshalledTextResult(buildIssueEditHistory(query))
+			result = attachRepoVisibilityIFCLabelLazy(ctx, deps, owner, repo, result, ifc.LabelRepoUserContent)
+			return result, nil, nil
+		})
+}
+
+// buildIssueEditHistory turns the userContentEdits page into diffs. GitHub
+// returns the full text of each revision in the edit's diff field, newest
+// first, so each edit is diffed against the next node on the page. The
+// oldest revision overall is the original body and is diffed against an
+// empty one.
+func buildIssueEditHistory(query issueEditHistoryQuery) IssueEditHistory {
+	edits := query.Repository.Issue.UserContentEdits
+	history := IssueEditHistory{
+		IssueNumber: int(query.Repository.Issue.Number),
+		Edits:       make([]IssueEdit, 0, len(edits.Nodes)),
+		TotalCount:  int(edits.TotalCount),
+	}
+	history.PageInfo.HasNextPage = bool(edits.PageInfo.HasNextPage)
+	history.PageInfo.EndCursor = string(edits.PageInfo.EndCursor)
+
+	for i, node := range edits.Nodes {
+		edit := IssueEdit{EditedAt: node.EditedAt.Format(time.RFC3339)}
+		if node.Editor != nil {
+			edit.Editor = string(node.Editor.Login)
+		}
+		switch {
+		case node.DeletedAt != nil || node.Diff == nil:
+			edit.Deleted = true
+			edit.Note = "revision was deleted"
+		case i+1 < len(edits.Nodes):
+			previous := edits.Nodes[i+1]
+			if previous.Diff == nil {
+				edit.Note = "previous revision was deleted"
+				break
+			}
+			edit.Diff, edit.DiffTruncated = unifiedLineDiff(sanitize.Sanitize(string(*previous.Diff)), sanitize.Sanitize(string(*node.Diff)), MaxIssueEditDiffLines)
+		case history.PageInfo.HasNextPage:
+			edit.Note = "previous revision is on the next page"
+		default:
+			edit.Diff, edit.DiffTruncated = unifiedLineDiff("", sanitize.Sanitize(string(*node.Diff)), MaxIssueEditDiffLines)
+		}
+		history.Edits = append(history.Edits, edit)
+	}
+	return history
+}
+
+// unifiedLineDiff returns a unified diff from before to after, keeping at
+// most maxLines output lines. The second result reports whether lines were
+// dropped.
+func unifiedLineDiff(before, after string, maxLines int) (string, bool) {
+	a := splitDiffLines(before)
+	b := splitDiffLines(after)
+
+	type op struct {
+		kind byte
+		line string
+	}
+	var ops []op
+	if len(a)*len(b) > maxIssueEditDiffCells {
+		for _, l := range a {
+			ops = append(ops, op{'-', l})
+		}
+		for _, l := range b {
+			ops = append(ops, op{'+', l})
+		}
+	} else {
+		// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+		lcs := make([][]int, len(a)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(b)+1)
+		}
+		for i := len(a) - 1; i >= 0; i-- {
+			for j := len(b) - 1; j >= 0; j-- {
+				if a[i] == b[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < len(a) || j < len(b) {
+			switch {
+			case i < len(a) && j < len(b) && a[i] == b[j]:
+				ops = append(ops, op{' ', a[i]})
+				i++
+				j++
+			case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+				ops = append(ops, op{'-', a[i]})
+				i++
+			default:
+				ops = append(ops, op{'+', b[j]})
+				j++
+			}
+		}
+	}
+
+	var out []string
+	oldLine, newLine := 1, 1
+	for start := 0; start < len(ops); {
+		if ops[start].kind == ' ' {
+			oldLine++
+			newLine++
+			start++
+			continue
+		}
+		// Grow the hunk until the gap to the next change exceeds twice the context.
+		end := start
+		for k := start; k < len(ops); k++ {
+			if ops[k].kind != ' ' {
+				end = k + 1
+			} else if k-end >= 2*issueEditDiffContext {
+				break
+			}
+		}
+		from := max(0, start-issueEditDiffContext)
+		to := min(len(ops), end+issueEditDiffContext)
+
+		hunkOld, hunkNew := oldLine-(start-from), newLine-(start-from)
+		var oldCount, newCount int
+		lines := make([]string, 0, to-from)
+		for _, o := range ops[from:to] {
+			if o.kind != '+' {
+				oldCount++
+			}
+			if o.kind != '-' {
+				newCount++
+			}
+			lines = append(lines, string(o.kind)+o.line)
+		}
+		// An empty side is anchored at the line before it, as in diff(1).
+		if oldCount == 0 {
+			hunkOld--
+		}
+		if newCount == 0 {
+			hunkNew--
+		}
+		out = append(out, fmt.Sprintf("@@ -%d,%d +%d,%d @@", hunkOld, oldCount, hunkNew, newCount))
+		out = append(out, lines...)
+
+		for _, o := range ops[start:to] {
+			if o.kind != '+' {
+				oldLine++
+			}
+			if o.kind != '-' {
+				newLine++
+			}
+		}
+		start = to
+	}
+
+	if len(out) > maxLines {
+		return strings.Join(out[:maxLines], "\n"), true
+	}
+	return strings.Join(out, "\n"), false
+}
+
+func splitDiffLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(s, "\r\n", "\n"), "\n"), "\n")
+}
@@ -0,0 +1,230 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetIssueEditHistory(t *testing.T) {
+	serverTool := GetIssueEditHistory(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_issue_edit_history", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	qEdits := "query($after:String$first:Int!$issueNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){issue(number: $issueNumber){number,author{login},userContentEdits(first: $first, after: $after){nodes{editor{login},editedAt,deletedAt,diff},pageInfo{hasNextPage,endCursor},totalCount}}}}"
+
+	vars := map[string]any{
+		"owner":       "owner",
+		"repo":        "repo",
+		"issueNumber": float64(42),
+		"first":       float64(30),
+		"after":       nil,
+	}
+
+	tests := []struct {
+		name     string
+		response githubv4mock.GQLResponse
+		expected []IssueEdit
+	}{
+		{
+			name: "multiple edits are diffed against the previous revision",
+			response: githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"number": 42,
+						"userContentEdits": map[string]any{
+							"nodes": []map[string]any{
+								{"editor": map[string]any{"login": "bob"}, "editedAt": "2024-01-03T00:00:00Z", "diff": "Steps:\n1. open\n2. click save\n3. crash"},
+								{"editor": map[string]any{"login": "alice"}, "editedAt": "2024-01-02T00:00:00Z", "diff": "Steps:\n1. open\n2. click\n3. crash"},
+								{"editor": map[string]any{"login": "alice"}, "editedAt": "2024-01-01T00:00:00Z", "diff": "Steps:\n1. open"},
+							},
+							"pageInfo":   map[string]any{"hasNextPage": false, "endCursor": "c3"},
+							"totalCount": 3,
+						},
+					},
+				},
+			}),
+			expected: []IssueEdit{
+				{Editor: "bob", EditedAt: "2024-01-03T00:00:00Z", Diff: "@@ -1,4 +1,4 @@\n Steps:\n 1. open\n-2. click\n+2. click save\n 3. crash"},
+				{Editor: "alice", EditedAt: "2024-01-02T00:00:00Z", Diff: "@@ -1,2 +1,4 @@\n Steps:\n 1. open\n+2. click\n+3. crash"},
+				{Editor: "alice", EditedAt: "2024-01-01T00:00:00Z", Diff: "@@ -0,0 +1,2 @@\n+Steps:\n+1. open"},
+			},
+		},
+		{
+			name: "previous revision on the next page",
+			response: githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"number": 42,
+						"userContentEdits": map[string]any{
+							"nodes": []map[string]any{
+								{"editor": map[string]any{"login": "bob"}, "editedAt": "2024-01-03T00:00:00Z", "diff": "b"},
+								{"editor": nil, "editedAt": "2024-01-02T00:00:00Z", "deletedAt": "2024-01-04T00:00:00Z", "diff": nil},
+							},
+							"pageInfo":   map[string]any{"hasNextPage": true, "endCursor": "c2"},
+							"totalCount": 3,
+						},
+					},
+				},
+			}),
+			expected: []IssueEdit{
+				{Editor: "bob", EditedAt: "2024-01-03T00:00:00Z", Note: "previous revision was deleted"},
+				{EditedAt: "2024-01-02T00:00:00Z", Deleted: true, Note: "revision was deleted"},
+			},
+		},
+		{
+			name: "issue that was never edited",
+			response: githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"number": 42,
+						"userContentEdits": map[string]any{
+							"nodes":      []map[string]any{},
+							"pageInfo":   map[string]any{"hasNextPage": false, "endCursor": ""},
+							"totalCount": 0,
+						},
+					},
+				},
+			}),
+			expected: []IssueEdit{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qEdits, vars, tc.response),
+			))}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			})
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var history IssueEditHistory
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &history))
+			assert.Equal(t, 42, history.IssueNumber)
+			assert.Equal(t, tc.expected, history.Edits)
+		})
+	}
+}
+
+func Test_UnifiedLineDiff(t *testing.T) {
+	t.Run("separate hunks for distant changes", func(t *testing.T) {
+		before := make([]string, 0, 20)
+		for i := 1; i <= 20; i++ {
+			before = append(before, fmt.Sprintf("line %d", i))
+		}
+		after := append([]string{}, before...)
+		after[1] = "line two"
+		after[18] = "line nineteen"
+
+		diff, truncated := unifiedLineDiff(strings.Join(before, "\n"), strings.Join(after, "\n"), MaxIssueEditDiffLines)
+		assert.False(t, truncated)
+		assert.Equal(t, "@@ -1,5 +1,5 @@\n line 1\n-line 2\n+line two\n line 3\n line 4\n line 5\n"+
+			"@@ -16,5 +16,5 @@\n line 16\n line 17\n line 18\n-line 19\n+line nineteen\n line 20", diff)
+	})
+
+	t.Run("caps the number of lines", func(t *testing.T) {
+		after := strings.Repeat("x\n", 2*MaxIssueEditDiffLines)
+		diff, truncated := unifiedLineDiff("", after, MaxIssueEditDiffLines)
+		assert.True(t, truncated)
+		assert.Len(t, strings.Split(diff, "\n"), MaxIssueEditDiffLines)
+	})
+}
+
+func Test_GetIssueEditHistory_Lockdown(t *testing.T) {
+	serverTool := GetIssueEditHistory(translations.NullTranslationHelper)
+
+	qEdits := "query($after:String$first:Int!$issueNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){issue(number: $issueNumber){number,author{login},userContentEdits(first: $first, after: $after){nodes{editor{login},editedAt,deletedAt,diff},pageInfo{hasNextPage,endCursor},totalCount}}}}"
+	vars := map[string]any{
+		"owner":       "owner",
+		"repo":        "repo",
+		"issueNumber": float64(42),
+		"first":       float64(30),
+		"after":       nil,
+	}
+	response := func(author string) githubv4mock.GQLResponse {
+		return githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"issue": map[string]any{
+					"number": 42,
+					"author": map[string]any{"login": author},
+					"userContentEdits": map[string]any{
+						"nodes": []map[string]any{
+							{"editor": map[string]any{"login": author}, "editedAt": "2024-01-01T00:00:00Z", "diff": "injected instructions"},
+						},
+						"pageInfo":   map[string]any{"hasNextPage": false, "endCursor": "c1"},
+						"totalCount": 1,
+					},
+				},
+			},
+		})
+	}
+
+	tests := []struct {
+		name           string
+		author         string
+		expectedErrMsg string
+	}{
+		{
+			name:           "author without push access is refused",
+			author:         "outsider",
+			expectedErrMsg: "access to issue details is restricted by lockdown mode",
+		},
+		{
+			name:   "author with push access is returned",
+			author: "maintainer",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			permClient := mockRESTPermissionServer(t, "read", map[string]string{"maintainer": "write"})
+			deps := BaseDeps{
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+					githubv4mock.NewQueryMatcher(qEdits, vars, response(tc.author)),
+				)),
+				RepoAccessCache: stubRepoAccessCache(permClient, 15*time.Minute),
+				Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": true}),
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			})
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Equal(t, tc.expectedErrMsg, errorContent.Text)
+				assert.NotContains(t, errorContent.Text, "injected instructions")
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Contains(t, getTextResult(t, result).Text, "injected instructions")
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// MaxIssueLinkedPullRequests caps the pull requests get_issue_linked_prs and
+// issue_read's get_linked_pull_requests method return.
+const MaxIssueLinkedPullRequests = 100
+
+type linkedPullRequestFragment struct {
+	Number      githubv4.Int
+	Title       githubv4.String
+	State       githubv4.String
+	IsDraft     githubv4.Boolean
+	URL         githubv4.String
+	HeadRefName githubv4.String
+	Author      struct {
+		Login githubv4.String
+	}
+	Repository struct {
+		NameWithOwner githubv4.String
+	}
+}
+
+type issueLinkedPullRequestsQuery struct {
+	Repository struct {
+		Issue struct {
+			ClosedByPullRequestsReferences struct {
+				Nodes []linkedPullRequestFragment
+			} `graphql:"closedByPullRequestsReferences(first: 100, includeClosedPrs: $includeClosed)"`
+			TimelineItems struct {
+				Nodes []struct {
+					ConnectedEvent struct {
+						Subject struct {
+							PullRequest linkedPullRequestFragment `graphql:"... on PullRequest"`
+						}
+					} `graphql:"... on ConnectedEvent"`
+				}
+			} `graphql:"timelineItems(itemTypes: [CONNECTED_EVENT], first: 100)"`
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetIssueLinkedPullRequests creates a tool that lists the pull requests
+// that close or are connected to an issue.
+func GetIssueLinkedPullRequests(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "get_issue_linked_prs",
+			Description: t("TOOL_GET_ISSUE_LINKED_PRS_DESCRIPTION", fmt.Sprintf("List pull requests linked to an issue: those that will close it when merged, plus pull requests manually connected from the issue's timeline. Use this to check whether a fix is already in flight. Returns at most %d pull requests.", MaxIssueLinkedPullRequests)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_ISSUE_LINKED_PRS_USER_TITLE", "Get pull requests linked to an issue"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the issue",
+					},
+					"include_closed": {
+						Type:        "boolean",
+						Description: "Include closed and merged pull requests (default false)",
+						Default:     json.RawMessage(`false`),
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includeClosed, err := OptionalParam[bool](args, "include_closed")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub graphql client", err), nil, nil
+			}
+
+			result, err := ListIssueLinkedPullRequests(ctx, client, deps, owner, repo, issueNumber, includeClosed)
+			if result != nil {
+				result = attachRepoVisibilityIFCLabelLazy(ctx, deps, owner, repo, result, ifc.LabelRepoUserContent)
+			}
+			return result, nil, err
+		})
+}
+
+// ListIssueLinkedPullRequests returns the pull requests that will close the
+// issue when merged, followed by the ones connected from its timeline. Closed
+// and merged pull requests are only included when includeClosed is set.
+func ListIssueLinkedPullRequests(ctx context.Context, client *githubv4.Client, deps ToolDependencies, owner, repo string, issueNumber int, includeClosed bool) (*mcp.CallToolResult, error) {
+	cache, err := deps.GetRepoAccessCache(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
+	}
+	lockdownMode := deps.GetFlags(ctx).LockdownMode
+	if lockdownMode && cache == nil {
+		return nil, fmt.Errorf("lockdown cache is not configured")
+	}
+
+	var query issueLinkedPullRequestsQuery
+	vars := map[string]any{
+		"owner":         githubv4.String(owner),
+		"repo":          githubv4.String(repo),
+		"issueNumber":   githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+		"includeClosed": githubv4.Boolean(includeClosed),
+	}
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get linked pull requests", err), nil
+	}
+
+	pullRequests := make([]MinimalLinkedPullRequest, 0)
+	seen := make(map[string]bool)
+	add := func(pr linkedPullRequestFragment, source string) {
+		url := string(pr.URL)
+		// Non-pull-request subjects of connected events decode as zero values.
+		if url == "" || seen[url] || len(pullRequests) >= MaxIssueLinkedPullRequests {
+			return
+		}
+		if !includeClosed && pr.State != "OPEN" {
+			return
+		}
+		repository := string(pr.Repository.NameWithOwner)
+		if lockdownMode && !isSafeLinkedContent(ctx, cache, string(pr.Author.Login), repository) {
+			return
+		}
+		seen[url] = true
+		pullRequests = append(pullRequests, MinimalLinkedPullRequest{
+			Number:     int(pr.Number),
+			Title:      sanitize.Sanitize(string(pr.Title)),
+			State:      string(pr.State),
+			Draft:      bool(pr.IsDraft),
+			URL:        url,
+			HeadRef:    string(pr.HeadRefName),
+			Repository: repository,
+			Source:     source,
+		})
+	}
+
+	issue := query.Repository.Issue
+	for _, pr := range issue.ClosedByPullRequestsReferences.Nodes {
+		add(pr, "closing")
+	}
+	for _, node := range issue.TimelineItems.Nodes {
+		add(node.ConnectedEvent.Subject.PullRequest, "connected")
+	}
+
+	return MarshalledTextResult(map[string]any{
+		"pull_requests": pullRequests,
+		"totalCount":    len(pullRequests),
+	}), nil
+}
@@ -0,0 +1,138 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetIssueLinkedPullRequests(t *testing.T) {
+	serverTool := GetIssueLinkedPullRequests(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_issue_linked_prs", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	qLinked := "query($includeClosed:Boolean!$issueNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){issue(number: $issueNumber){closedByPullRequestsReferences(first: 100, includeClosedPrs: $includeClosed){nodes{number,title,state,isDraft,url,headRefName,author{login},repository{nameWithOwner}}},timelineItems(itemTypes: [CONNECTED_EVENT], first: 100){nodes{... on ConnectedEvent{subject{... on PullRequest{number,title,state,isDraft,url,headRefName,author{login},repository{nameWithOwner}}}}}}}}}"
+
+	pr := func(number int, state string, headRef string) map[string]any {
+		return map[string]any{
+			"number":      number,
+			"title":       "Fix search",
+			"state":       state,
+			"isDraft":     false,
+			"url":         "https://github.com/owner/repo/pull/" + headRef,
+			"headRefName": headRef,
+			"author":      map[string]any{"login": "bob"},
+			"repository":  map[string]any{"nameWithOwner": "owner/repo"},
+		}
+	}
+
+	response := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"issue": map[string]any{
+				"closedByPullRequestsReferences": map[string]any{
+					"nodes": []any{pr(10, "OPEN", "fix-a"), pr(11, "MERGED", "fix-b")},
+				},
+				"timelineItems": map[string]any{
+					"nodes": []any{
+						// Already listed as a closing reference.
+						map[string]any{"subject": pr(10, "OPEN", "fix-a")},
+						map[string]any{"subject": pr(12, "OPEN", "fix-c")},
+						// A connected issue rather than a pull request.
+						map[string]any{"subject": map[string]any{}},
+					},
+				},
+			},
+		},
+	})
+
+	tests := []struct {
+		name          string
+		includeClosed bool
+		expected      []MinimalLinkedPullRequest
+	}{
+		{
+			name: "open pull requests by default",
+			expected: []MinimalLinkedPullRequest{
+				{Number: 10, Title: "Fix search", State: "OPEN", URL: "https://github.com/owner/repo/pull/fix-a", HeadRef: "fix-a", Repository: "owner/repo", Source: "closing"},
+				{Number: 12, Title: "Fix search", State: "OPEN", URL: "https://github.com/owner/repo/pull/fix-c", HeadRef: "fix-c", Repository: "owner/repo", Source: "connected"},
+			},
+		},
+		{
+			name:          "include closed",
+			includeClosed: true,
+			expected: []MinimalLinkedPullRequest{
+				{Number: 10, Title: "Fix search", State: "OPEN", URL: "https://github.com/owner/repo/pull/fix-a", HeadRef: "fix-a", Repository: "owner/repo", Source: "closing"},
+				{Number: 11, Title: "Fix search", State: "MERGED", URL: "https://github.com/owner/repo/pull/fix-b", HeadRef: "fix-b", Repository: "owner/repo", Source: "closing"},
+				{Number: 12, Title: "Fix search", State: "OPEN", URL: "https://github.com/owner/repo/pull/fix-c", HeadRef: "fix-c", Repository: "owner/repo", Source: "connected"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vars := map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issueNumber":   float64(42),
+				"includeClosed": tc.includeClosed,
+			}
+			deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qLinked, vars, response),
+			))}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"issue_number":   float64(42),
+				"include_closed": tc.includeClosed,
+			})
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var got struct {
+				PullRequests []MinimalLinkedPullRequest `json:"pull_requests"`
+				TotalCount   int                        `json:"totalCount"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, tc.expected, got.PullRequests)
+			assert.Equal(t, len(tc.expected), got.TotalCount)
+		})
+	}
+
+	t.Run("issue not found", func(t *testing.T) {
+		vars := map[string]any{
+			"owner":         "owner",
+			"repo":          "repo",
+			"issueNumber":   float64(404),
+			"includeClosed": false,
+		}
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(qLinked, vars, githubv4mock.ErrorResponse("Could not resolve to an Issue with the number of 404.")),
+		))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(404),
+		})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to get linked pull requests: Could not resolve to an Issue")
+	})
+}
@@ -0,0 +1,213 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// IssueLockResult is the output of lock_issue and unlock_issue.
+type IssueLockResult struct {
+	Owner      string `json:"owner"`
+	Repo       string `json:"repo"`
+	Number     int    `json:"number"`
+	Locked     bool   `json:"locked"`
+	LockReason string `json:"lock_reason,omitempty"`
+}
+
+// issueTargetSchema returns the input properties that identify an issue,
+// either by issue_url or by owner, repo and issue_number.
+func issueTargetSchema() map[string]*jsonschema.Schema {
+	return map[string]*jsonschema.Schema{
+		"issue_url": {
+			Type:        "string",
+			Description: "Issue or pull request URL, e.g. https://github.com/owner/repo/issues/42. Use instead of owner, repo and issue_number.",
+		},
+		"owner": {
+			Type:        "string",
+			Description: "Repository owner. Required unless issue_url is given.",
+		},
+		"repo": {
+			Type:        "string",
+			Description: "Repository name. Required unless issue_url is given.",
+		},
+		"issue_number": {
+			Type:        "number",
+			Description: "The issue number. Required unless issue_url is given.",
+			Minimum:     jsonschema.Ptr(1.0),
+		},
+	}
+}
+
+// issueTargetFromArgs resolves the issue a call targets from issue_url when
+// it is given, and from owner, repo and issue_number otherwise. Explicit
+// parameters passed alongside issue_url must agree with it.
+func issueTargetFromArgs(args map[string]any, webHost string) (issueLink, error) {
+	rawURL, err := OptionalParam[string](args, "issue_url")
+	if err != nil {
+		return issueLink{}, err
+	}
+	if strings.TrimSpace(rawURL) == "" {
+		owner, err := RequiredParam[string](args, "owner")
+		if err != nil {
+			return issueLink{}, fmt.Errorf("%w (or pass issue_url)", err)
+		}
+		repo, err := RequiredParam[string](args, "repo")
+		if err != nil {
+			return issueLink{}, fmt.Errorf("%w (or pass issue_url)", err)
+		}
+		issueNumber, err := RequiredPositiveInt(args, "issue_number")
+		if err != nil {
+			return issueLink{}, fmt.Errorf("%w (or pass issue_url)", err)
+		}
+		return issueLink{Owner: owner, Repo: repo, IssueNumber: issueNumber}, nil
+	}
+
+	link, err := parseIssueLink(rawURL, webHost)
+	if err != nil {
+		return issueLink{}, err
+	}
+	// A comment anchor still identifies its issue.
+	link.CommentID = 0
+
+	owner, err := OptionalParam[string](args, "owner")
+	if err != nil {
+		return issueLink{}, err
+	}
+	repo, err := OptionalParam[string](args, "repo")
+	if err != nil {
+		return issueLink{}, err
+	}
+	issueNumber, err := OptionalIntParam(args, "issue_number")
+	if err != nil {
+		return issueLink{}, err
+	}
+	if (owner != "" && !strings.EqualFold(owner, link.Owner)) ||
+		(repo != "" && !strings.EqualFold(repo, link.Repo)) ||
+		(issueNumber != 0 && issueNumber != link.IssueNumber) {
+		return issueLink{}, fmt.Errorf("issue_url points to %s/%s#%d, which conflicts with the owner, repo or issue_number given", link.Owner, link.Repo, link.IssueNumber)
+	}
+	return link, nil
+}
+
+// LockIssue creates a tool that locks the conversation on an issue or pull
+// request.
+func LockIssue(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type:       "object",
+		Properties: issueTargetSchema(),
+	}
+	schema.Properties["lock_reason"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Reason for locking, shown on the issue",
+		Enum:        []any{"off-topic", "too heated", "resolved", "spam"},
+	}
+
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "lock_issue",
+			Description: t("TOOL_LOCK_ISSUE_DESCRIPTION", "Lock the conversation on an issue or pull request so only collaborators can comment. Identify it by issue_url, or by owner, repo and issue_number."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:          t("TOOL_LOCK_ISSUE_USER_TITLE", "Lock issue conversation"),
+				ReadOnlyHint:   false,
+				IdempotentHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			lockReason, err := OptionalParam[string](args, "lock_reason")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			target, err := issueTargetFromArgs(args, webHostForAPI(client.BaseURL()))
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if err := deps.GetRepoPolicy().checkRepo(target.Owner, target.Repo); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			var opts *github.LockIssueOptions
+			if lockReason != "" {
+				opts = &github.LockIssueOptions{LockReason: lockReason}
+			}
+			resp, err := client.Issues.Lock(ctx, target.Owner, target.Repo, target.IssueNumber, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to lock issue", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(IssueLockResult{
+				Owner:      target.Owner,
+				Repo:       target.Repo,
+				Number:     target.IssueNumber,
+				Locked:     true,
+				LockReason: lockReason,
+			}), nil, nil
+		})
+}
+
+// UnlockIssue creates a tool that unlocks the conversation on an issue or
+// pull request.
+func UnlockIssue(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "unlock_issue",
+			Description: t("TOOL_UNLOCK_ISSUE_DESCRIPTION", "Unlock the conversation on an issue or pull request. Identify it by issue_url, or by owner, repo and issue_number."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:          t("TOOL_UNLOCK_ISSUE_USER_TITLE", "Unlock issue conversation"),
+				ReadOnlyHint:   false,
+				IdempotentHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type:       "object",
+				Properties: issueTargetSchema(),
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			target, err := issueTargetFromArgs(args, webHostForAPI(client.BaseURL()))
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if err := deps.GetRepoPolicy().checkRepo(target.Owner, target.Repo); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			resp, err := client.Issues.Unlock(ctx, target.Owner, target.Repo, target.IssueNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to unlock issue", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(IssueLockResult{
+				Owner:  target.Owner,
+				Repo:   target.Repo,
+				Number: target.IssueNumber,
+				Locked: false,
+			}), nil, nil
+		})
+}
@@ -0,0 +1,140 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LockIssue(t *testing.T) {
+	serverTool := LockIssue(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "lock_issue", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		args           map[string]any
+		expectedPath   string
+		expectedReason string
+		expectedErrMsg string
+	}{
+		{
+			name:         "explicit parameters",
+			args:         map[string]any{"owner": "acme", "repo": "app", "issue_number": float64(42)},
+			expectedPath: "/repos/acme/app/issues/42/lock",
+		},
+		{
+			name:           "issue URL with a lock reason",
+			args:           map[string]any{"issue_url": "https://github.com/acme/app/issues/42", "lock_reason": "too heated"},
+			expectedPath:   "/repos/acme/app/issues/42/lock",
+			expectedReason: "too heated",
+		},
+		{
+			name:         "pull request URL with a comment anchor",
+			args:         map[string]any{"issue_url": "https://github.com/acme/app/pull/7#issuecomment-99"},
+			expectedPath: "/repos/acme/app/issues/7/lock",
+		},
+		{
+			name:         "matching explicit parameters alongside the URL",
+			args:         map[string]any{"issue_url": "https://github.com/acme/app/issues/42", "owner": "ACME", "issue_number": float64(42)},
+			expectedPath: "/repos/acme/app/issues/42/lock",
+		},
+		{
+			name:           "conflicting explicit parameters",
+			args:           map[string]any{"issue_url": "https://github.com/acme/app/issues/42", "issue_number": float64(43)},
+			expectedErrMsg: "issue_url points to acme/app#42, which conflicts with the owner, repo or issue_number given",
+		},
+		{
+			name:           "URL on another host",
+			args:           map[string]any{"issue_url": "https://gitlab.com/acme/app/issues/42"},
+			expectedErrMsg: `URL host "gitlab.com" does not match the configured GitHub host "github.com"`,
+		},
+		{
+			name:           "URL that is not an issue",
+			args:           map[string]any{"issue_url": "https://github.com/acme/app/commits/main"},
+			expectedErrMsg: "is not an issue or pull request link",
+		},
+		{
+			name:           "neither URL nor parameters",
+			args:           map[string]any{"owner": "acme", "repo": "app"},
+			expectedErrMsg: "missing required parameter: issue_number (or pass issue_url)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotPath string
+			var gotBody map[string]any
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutReposIssuesLockByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+					gotPath = r.URL.Path
+					_ = json.NewDecoder(r.Body).Decode(&gotBody)
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}))}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				assert.Empty(t, gotPath)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Equal(t, tc.expectedPath, gotPath)
+			if tc.expectedReason != "" {
+				assert.Equal(t, tc.expectedReason, gotBody["lock_reason"])
+			}
+
+			var response IssueLockResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.True(t, response.Locked)
+			assert.Equal(t, tc.expectedReason, response.LockReason)
+		})
+	}
+}
+
+func Test_UnlockIssue(t *testing.T) {
+	serverTool := UnlockIssue(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "unlock_issue", tool.Name)
+
+	for name, args := range map[string]map[string]any{
+		"explicit parameters": {"owner": "acme", "repo": "app", "issue_number": float64(42)},
+		"issue URL":           {"issue_url": "https://github.com/acme/app/issues/42"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotPath string
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposIssuesLockByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+					gotPath = r.URL.Path
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}))}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			assert.Equal(t, "/repos/acme/app/issues/42/lock", gotPath)
+			assert.JSONEq(t, `{"owner":"acme","repo":"app","number":42,"locked":false}`, getTextResult(t, result).Text)
+		})
+	}
+}
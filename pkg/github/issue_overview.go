@@ -0,0 +1,310 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// DefaultIssueOverviewComments is the number of comments get_issue_overview
+	// returns when comments is not provided.
+	DefaultIssueOverviewComments = 10
+	// MaxIssueOverviewComments bounds the comments fetched by the single query.
+	MaxIssueOverviewComments = 50
+)
+
+// IssueOverviewComment is a trimmed comment in a get_issue_overview response.
+type IssueOverviewComment struct {
+	Author    string `json:"author"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+}
+
+// IssueOverview is the output type for get_issue_overview. Comments are the
+// most recent ones, newest first; Issue.Comments holds the total count.
+type IssueOverview struct {
+	Issue    MinimalIssue           `json:"issue"`
+	Comments []IssueOverviewComment `json:"comments"`
+}
+
+// issueOverviewQuery fetches everything get_issue_overview returns in one
+// round-trip.
+type issueOverviewQuery struct {
+	Repository struct {
+		Issue struct {
+			Number      githubv4.Int
+			Title       githubv4.String
+			Body        githubv4.String
+			State       githubv4.String
+			StateReason *githubv4.String
+			URL         githubv4.String
+			CreatedAt   githubv4.DateTime
+			UpdatedAt   githubv4.DateTime
+			ClosedAt    *githubv4.DateTime
+			Author      struct {
+				Login githubv4.String
+			}
+			Labels struct {
+				Nodes []struct {
+					Name  githubv4.String
+					Color githubv4.String
+				}
+			} `graphql:"labels(first: 100)"`
+			Assignees struct {
+				Nodes []struct {
+					Login githubv4.String
+				}
+			} `graphql:"assignees(first: 100)"`
+			Milestone *struct {
+				Title githubv4.String
+			}
+			Comments struct {
+				TotalCount githubv4.Int
+				Nodes      []struct {
+					Author struct {
+						Login githubv4.String
+					}
+					Body      githubv4.String
+					CreatedAt githubv4.DateTime
+				}
+			} `graphql:"comments(last: $commentCount)"`
+			Parent *struct {
+				Number githubv4.Int
+				Title  githubv4.String
+				State  githubv4.String
+				URL    githubv4.String
+				Author struct {
+					Login githubv4.String
+				}
+				Repository struct {
+					NameWithOwner githubv4.String
+				}
+			}
+			SubIssuesSummary struct {
+				Total            githubv4.Int
+				Completed        githubv4.Int
+				PercentCompleted githubv4.Int
+			}
+			ClosedByPullRequestsReferences struct {
+				Nodes []struct {
+					Number  githubv4.Int
+					Title   githubv4.String
+					State   githubv4.String
+					IsDraft githubv4.Boolean
+					URL     githubv4.String
+					Author  struct {
+						Login githubv4.String
+					}
+					Repository struct {
+						NameWithOwner githubv4.String
+					}
+				}
+			} `graphql:"closedByPullRequestsReferences(first: 25, includeClosedPrs: true)"`
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetIssueOverview creates a tool that returns an issue together with its
+// latest comments, parent, sub-issue progress and linked pull requests.
+func GetIssueOverview(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "get_issue_overview",
+			Description: t("TOOL_GET_ISSUE_OVERVIEW_DESCRIPTION", "Get an overview of an issue in a single call: core fields, labels, assignees, the most recent comments (newest first), parent issue, sub-issue progress and linked pull requests. Use this instead of separate issue_read calls when summarizing an issue."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_ISSUE_OVERVIEW_USER_TITLE", "Get issue overview"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the issue",
+					},
+					"comments": {
+						Type:        "number",
+						Description: fmt.Sprintf("Number of most recent comments to include (default %d, max %d)", DefaultIssueOverviewComments, MaxIssueOverviewComments),
+						Minimum:     jsonschema.Ptr(0.0),
+						Maximum:     jsonschema.Ptr(float64(MaxIssueOverviewComments)),
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commentCount, err := OptionalIntParamWithDefault(args, "comments", DefaultIssueOverviewComments)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if commentCount < 0 || commentCount > MaxIssueOverviewComments {
+				return utils.NewToolResultError(fmt.Sprintf("comments must be between 0 and %d", MaxIssueOverviewComments)), nil, nil
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub graphql client", err), nil, nil
+			}
+
+			result, err := getIssueOverview(ctx, client, deps, owner, repo, issueNumber, commentCount)
+			if result != nil {
+				result = attachRepoVisibilityIFCLabelLazy(ctx, deps, owner, repo, result, ifc.LabelRepoUserContent)
+			}
+			return result, nil, err
+		})
+}
+
+func getIssueOverview(ctx context.Context, client *githubv4.Client, deps ToolDependencies, owner, repo string, issueNumber, commentCount int) (*mcp.CallToolResult, error) {
+	cache, err := deps.GetRepoAccessCache(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
+	}
+	lockdownMode := deps.GetFlags(ctx).LockdownMode
+	if lockdownMode && cache == nil {
+		return nil, fmt.Errorf("lockdown cache is not configured")
+	}
+
+	var query issueOverviewQuery
+	vars := map[string]any{
+		"owner":        githubv4.String(owner),
+		"repo":         githubv4.String(repo),
+		"issueNumber":  githubv4.Int(issueNumber),  // #nosec G115 - issue numbers are always small positive integers
+		"commentCount": githubv4.Int(commentCount), // #nosec G115 - bounded by MaxIssueOverviewComments
+	}
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get issue overview", err), nil
+	}
+	issue := query.Repository.Issue
+	nameWithOwner := owner + "/" + repo
+
+	if lockdownMode {
+		if login := string(issue.Author.Login); login != "" {
+			isSafeContent, err := cache.IsSafeContent(ctx, login, owner, repo)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("failed to check lockdown mode: %v", err)), nil
+			}
+			if !isSafeContent {
+				return utils.NewToolResultError("access to issue details is restricted by lockdown mode"), nil
+			}
+		}
+	}
+
+	overview := IssueOverview{
+		Issue: MinimalIssue{
+			Number:    int(issue.Number),
+			Title:     sanitize.Sanitize(string(issue.Title)),
+			Body:      sanitize.Sanitize(string(issue.Body)),
+			State:     strings.ToLower(string(issue.State)),
+			HTMLURL:   string(issue.URL),
+			User:      &MinimalUser{Login: string(issue.Author.Login)},
+			Comments:  int(issue.Comments.TotalCount),
+			CreatedAt: issue.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: issue.UpdatedAt.Format(time.RFC3339),
+		},
+		Comments: make([]IssueOverviewComment, 0, len(issue.Comments.Nodes)),
+	}
+	minimalIssue := &overview.Issue
+	if issue.StateReason != nil {
+		minimalIssue.StateReason = strings.ToLower(string(*issue.StateReason))
+	}
+	if issue.ClosedAt != nil {
+		minimalIssue.ClosedAt = issue.ClosedAt.Format(time.RFC3339)
+	}
+	if issue.Milestone != nil {
+		minimalIssue.Milestone = string(issue.Milestone.Title)
+	}
+	for _, label := range issue.Labels.Nodes {
+		minimalIssue.Labels = append(minimalIssue.Labels, MinimalLabel{Name: string(label.Name), Color: string(label.Color)})
+	}
+	for _, assignee := range issue.Assignees.Nodes {
+		minimalIssue.Assignees = append(minimalIssue.Assignees, string(assignee.Login))
+	}
+
+	minimalIssue.HasParent = ToBoolPtr(issue.Parent != nil)
+	if p := issue.Parent; p != nil {
+		repository := string(p.Repository.NameWithOwner)
+		if !lockdownMode || isSafeLinkedContent(ctx, cache, string(p.Author.Login), repository) {
+			minimalIssue.Parent = &MinimalIssueRef{
+				Number:     int(p.Number),
+				Title:      sanitize.Sanitize(string(p.Title)),
+				State:      string(p.State),
+				URL:        string(p.URL),
+				Repository: repository,
+			}
+		}
+	}
+	minimalIssue.HasChildren = ToBoolPtr(issue.SubIssuesSummary.Total > 0)
+	if issue.SubIssuesSummary.Total > 0 {
+		minimalIssue.SubIssuesSummary = &MinimalSubIssuesSummary{
+			Total:            int(issue.SubIssuesSummary.Total),
+			Completed:        int(issue.SubIssuesSummary.Completed),
+			PercentCompleted: int(issue.SubIssuesSummary.PercentCompleted),
+		}
+	}
+
+	for _, pr := range issue.ClosedByPullRequestsReferences.Nodes {
+		repository := string(pr.Repository.NameWithOwner)
+		if lockdownMode && !isSafeLinkedContent(ctx, cache, string(pr.Author.Login), repository) {
+			continue
+		}
+		minimalIssue.LinkedPullRequests = append(minimalIssue.LinkedPullRequests, MinimalLinkedPullRequest{
+			Number:     int(pr.Number),
+			Title:      sanitize.Sanitize(string(pr.Title)),
+			State:      string(pr.State),
+			Draft:      bool(pr.IsDraft),
+			URL:        string(pr.URL),
+			Repository: repository,
+		})
+	}
+
+	// comments(last: N) returns oldest first; walk backwards for newest first.
+	for i := len(issue.Comments.Nodes) - 1; i >= 0; i-- {
+		comment := issue.Comments.Nodes[i]
+		login := string(comment.Author.Login)
+		if lockdownMode && !isSafeLinkedContent(ctx, cache, login, nameWithOwner) {
+			continue
+		}
+		overview.Comments = append(overview.Comments, IssueOverviewComment{
+			Author:    login,
+			Body:      sanitize.Sanitize(string(comment.Body)),
+			CreatedAt: comment.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return MarshalledTextResult(overview), nil
+}
@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetIssueOverview(t *testing.T) {
+	serverTool := GetIssueOverview(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_issue_overview", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	// Pin the exact query so changes to its shape are deliberate.
+	qOverview := "query($commentCount:Int!$issueNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){issue(number: $issueNumber){number,title,body,state,stateReason,url,createdAt,updatedAt,closedAt,author{login},labels(first: 100){nodes{name,color}},assignees(first: 100){nodes{login}},milestone{title},comments(last: $commentCount){totalCount,nodes{author{login},body,createdAt}},parent{number,title,state,url,author{login},repository{nameWithOwner}},subIssuesSummary{total,completed,percentCompleted},closedByPullRequestsReferences(first: 25, includeClosedPrs: true){nodes{number,title,state,isDraft,url,author{login},repository{nameWithOwner}}}}}}"
+
+	overviewResponse := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"issue": map[string]any{
+				"number":    42,
+				"title":     "Search is slow",
+				"body":      "Queries take 10s",
+				"state":     "OPEN",
+				"url":       "https://github.com/owner/repo/issues/42",
+				"createdAt": "2024-01-01T00:00:00Z",
+				"updatedAt": "2024-01-03T00:00:00Z",
+				"author":    map[string]any{"login": "reporter"},
+				"labels":    map[string]any{"nodes": []map[string]any{{"name": "perf", "color": "fbca04"}}},
+				"assignees": map[string]any{"nodes": []map[string]any{{"login": "alice"}}},
+				"milestone": map[string]any{"title": "v2"},
+				"comments": map[string]any{
+					"totalCount": 12,
+					"nodes": []map[string]any{
+						{"author": map[string]any{"login": "alice"}, "body": "Looking into it", "createdAt": "2024-01-02T00:00:00Z"},
+						{"author": map[string]any{"login": "bob"}, "body": "Found the index", "createdAt": "2024-01-03T00:00:00Z"},
+					},
+				},
+				"parent": map[string]any{
+					"number":     7,
+					"title":      "Performance epic",
+					"state":      "OPEN",
+					"url":        "https://github.com/owner/repo/issues/7",
+					"author":     map[string]any{"login": "lead"},
+					"repository": map[string]any{"nameWithOwner": "owner/repo"},
+				},
+				"subIssuesSummary": map[string]any{"total": 3, "completed": 1, "percentCompleted": 33},
+				"closedByPullRequestsReferences": map[string]any{
+					"nodes": []map[string]any{
+						{
+							"number":     99,
+							"title":      "Add search index",
+							"state":      "OPEN",
+							"isDraft":    true,
+							"url":        "https://github.com/owner/repo/pull/99",
+							"author":     map[string]any{"login": "bob"},
+							"repository": map[string]any{"nameWithOwner": "owner/repo"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	t.Run("returns the overview in one query", func(t *testing.T) {
+		vars := map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issueNumber":  float64(42),
+			"commentCount": float64(2),
+		}
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(qOverview, vars, overviewResponse),
+		))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+			"comments":     float64(2),
+		})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var overview IssueOverview
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &overview))
+		assert.Equal(t, 42, overview.Issue.Number)
+		assert.Equal(t, "open", overview.Issue.State)
+		assert.Equal(t, 12, overview.Issue.Comments)
+		assert.Equal(t, []MinimalLabel{{Name: "perf", Color: "fbca04"}}, overview.Issue.Labels)
+		assert.Equal(t, []string{"alice"}, overview.Issue.Assignees)
+		assert.Equal(t, "v2", overview.Issue.Milestone)
+		require.NotNil(t, overview.Issue.Parent)
+		assert.Equal(t, 7, overview.Issue.Parent.Number)
+		require.NotNil(t, overview.Issue.SubIssuesSummary)
+		assert.Equal(t, MinimalSubIssuesSummary{Total: 3, Completed: 1, PercentCompleted: 33}, *overview.Issue.SubIssuesSummary)
+		require.Len(t, overview.Issue.LinkedPullRequests, 1)
+		assert.Equal(t, 99, overview.Issue.LinkedPullRequests[0].Number)
+
+		// Newest comment first.
+		assert.Equal(t, []IssueOverviewComment{
+			{Author: "bob", Body: "Found the index", CreatedAt: "2024-01-03T00:00:00Z"},
+			{Author: "alice", Body: "Looking into it", CreatedAt: "2024-01-02T00:00:00Z"},
+		}, overview.Comments)
+	})
+
+	t.Run("defaults to ten comments", func(t *testing.T) {
+		vars := map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issueNumber":  float64(42),
+			"commentCount": float64(DefaultIssueOverviewComments),
+		}
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(qOverview, vars, overviewResponse),
+		))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+		})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+	})
+
+	t.Run("rejects more than the maximum comments", func(t *testing.T) {
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient())}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+			"comments":     float64(MaxIssueOverviewComments + 1),
+		})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "comments must be between 0 and 50")
+	})
+}
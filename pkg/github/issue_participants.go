@@ -0,0 +1,159 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// MaxIssueParticipants caps the participants list_issue_participants reads.
+const MaxIssueParticipants = 100
+
+// IssueParticipant is a user in a list_issue_participants response. Author
+// and Assignee mark the user who opened the issue and its assignees; other
+// participants commented on or otherwise took part in the issue.
+type IssueParticipant struct {
+	Login    string `json:"login"`
+	Author   bool   `json:"author,omitempty"`
+	Assignee bool   `json:"assignee,omitempty"`
+}
+
+// IssueParticipantsResult is the output of list_issue_participants.
+type IssueParticipantsResult struct {
+	Number       int                `json:"number"`
+	Participants []IssueParticipant `json:"participants"`
+	TotalCount   int                `json:"total_count"`
+	Truncated    bool               `json:"truncated,omitempty"`
+}
+
+type issueParticipantsQuery struct {
+	Repository struct {
+		Issue struct {
+			Author struct {
+				Login githubv4.String
+			}
+			Assignees struct {
+				Nodes []struct {
+					Login githubv4.String
+				}
+			} `graphql:"assignees(first: 100)"`
+			Participants struct {
+				TotalCount githubv4.Int
+				Nodes      []struct {
+					Login githubv4.String
+				}
+			} `graphql:"participants(first: 100)"`
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// ListIssueParticipants creates a tool that lists the users taking part in an
+// issue.
+func ListIssueParticipants(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "list_issue_participants",
+			Description: t("TOOL_LIST_ISSUE_PARTICIPANTS_DESCRIPTION", fmt.Sprintf("List the users taking part in an issue: the author, the assignees and everyone who commented or otherwise participated, with the author and assignees marked. "+
+				"Use this to find an issue's stakeholders. Returns at most %d participants.", MaxIssueParticipants)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ISSUE_PARTICIPANTS_USER_TITLE", "List issue participants"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the issue",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub graphql client", err), nil, nil
+			}
+
+			var query issueParticipantsQuery
+			vars := map[string]any{
+				"owner":       githubv4.String(owner),
+				"repo":        githubv4.String(repo),
+				"issueNumber": githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list issue participants", err), nil, nil
+			}
+
+			issue := query.Repository.Issue
+			result := IssueParticipantsResult{
+				Number:       issueNumber,
+				Participants: make([]IssueParticipant, 0, len(issue.Participants.Nodes)),
+				TotalCount:   int(issue.Participants.TotalCount),
+				Truncated:    int(issue.Participants.TotalCount) > len(issue.Participants.Nodes),
+			}
+			index := make(map[string]int)
+			participant := func(login string) *IssueParticipant {
+				key := strings.ToLower(login)
+				if i, ok := index[key]; ok {
+					return &result.Participants[i]
+				}
+				index[key] = len(result.Participants)
+				result.Participants = append(result.Participants, IssueParticipant{Login: login})
+				return &result.Participants[len(result.Participants)-1]
+			}
+
+			// The author and assignees come first. Deleted users have no login.
+			if login := string(issue.Author.Login); login != "" {
+				participant(login).Author = true
+			}
+			for _, node := range issue.Assignees.Nodes {
+				if login := string(node.Login); login != "" {
+					participant(login).Assignee = true
+				}
+			}
+			for _, node := range issue.Participants.Nodes {
+				if login := string(node.Login); login != "" {
+					participant(login)
+				}
+			}
+
+			callResult := MarshalledTextResult(result)
+			callResult = attachRepoVisibilityIFCLabelLazy(ctx, deps, owner, repo, callResult, ifc.LabelRepoMetadata)
+			return callResult, nil, nil
+		})
+}
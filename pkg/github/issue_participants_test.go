@@ -0,0 +1,109 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListIssueParticipants(t *testing.T) {
+	serverTool := ListIssueParticipants(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issue_participants", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	vars := map[string]any{
+		"owner":       githubv4.String("owner"),
+		"repo":        githubv4.String("repo"),
+		"issueNumber": githubv4.Int(42),
+	}
+	login := func(l string) map[string]any { return map[string]any{"login": l} }
+
+	t.Run("marks the author and assignees among the participants", func(t *testing.T) {
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(issueParticipantsQuery{}, vars, githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"author":    login("alice"),
+						"assignees": map[string]any{"nodes": []any{login("bob"), login("Alice")}},
+						"participants": map[string]any{
+							"totalCount": 4,
+							"nodes":      []any{login("alice"), login("carol"), login("bob"), login("dave")},
+						},
+					},
+				},
+			})),
+		))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42)})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response IssueParticipantsResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, IssueParticipantsResult{
+			Number: 42,
+			Participants: []IssueParticipant{
+				{Login: "alice", Author: true, Assignee: true},
+				{Login: "bob", Assignee: true},
+				{Login: "carol"},
+				{Login: "dave"},
+			},
+			TotalCount: 4,
+		}, response)
+	})
+
+	t.Run("reports truncation and skips deleted users", func(t *testing.T) {
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(issueParticipantsQuery{}, vars, githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"author":    nil,
+						"assignees": map[string]any{"nodes": []any{}},
+						"participants": map[string]any{
+							"totalCount": 150,
+							"nodes":      []any{login("carol")},
+						},
+					},
+				},
+			})),
+		))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42)})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response IssueParticipantsResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, []IssueParticipant{{Login: "carol"}}, response.Participants)
+		assert.Equal(t, 150, response.TotalCount)
+		assert.True(t, response.Truncated)
+	})
+
+	t.Run("missing issue", func(t *testing.T) {
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(issueParticipantsQuery{}, vars, githubv4mock.ErrorResponse("Could not resolve to an Issue with the number of 42.")),
+		))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42)})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to list issue participants")
+	})
+}
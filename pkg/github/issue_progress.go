@@ -0,0 +1,263 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MaxEpicProgressChildren caps how many direct sub-issues get_epic_progress
+// reads before reporting the roll-up as truncated.
+const MaxEpicProgressChildren = 200
+
+const unassignedGroup = "(unassigned)"
+
+// ProgressCount is the total and closed number of sub-issues in a bucket.
+type ProgressCount struct {
+	Total  int `json:"total"`
+	Closed int `json:"closed"`
+}
+
+// MinimalEpicChild is a per-child entry of get_epic_progress.
+type MinimalEpicChild struct {
+	Number    int      `json:"number"`
+	Title     string   `json:"title"`
+	State     string   `json:"state"`
+	HTMLURL   string   `json:"html_url"`
+	Assignees []string `json:"assignees,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+}
+
+// EpicProgress is the output type for get_epic_progress.
+type EpicProgress struct {
+	IssueNumber     int                      `json:"issue_number"`
+	Total           int                      `json:"total"`
+	Closed          int                      `json:"closed"`
+	PercentComplete float64                  `json:"percent_complete"`
+	ByAssignee      map[string]ProgressCount `json:"by_assignee"`
+	ByLabel         map[string]ProgressCount `json:"by_label"`
+	// Truncated is set when the parent has more than MaxEpicProgressChildren
+	// sub-issues; the aggregates then cover only the first ones.
+	Truncated bool               `json:"truncated,omitempty"`
+	Children  []MinimalEpicChild `json:"children,omitempty"`
+}
+
+// GetEpicProgress creates a tool that rolls up the completion of a parent
+// issue's direct sub-issues.
+func GetEpicProgress(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "get_epic_progress",
+			Description: t("TOOL_GET_EPIC_PROGRESS_DESCRIPTION", fmt.Sprintf("Summarize the progress of a parent issue: the number of direct sub-issues, how many are closed, the percentage complete, and a breakdown by assignee and by label. Reads up to %d sub-issues.", MaxEpicProgressChildren)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_EPIC_PROGRESS_USER_TITLE", "Get epic progress"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the parent issue",
+					},
+					"include_children": {
+						Type:        "boolean",
+						Description: "Include a per-child entry for every sub-issue. By default only the aggregates are returned.",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includeChildren, err := OptionalParam[bool](args, "include_children")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			subIssues, truncated, result, err := listAllSubIssues(ctx, client, owner, repo, issueNumber)
+			if result != nil || err != nil {
+				return result, nil, err
+			}
+
+			progress := summarizeEpicProgress(issueNumber, subIssues)
+			progress.Truncated = truncated
+
+			if includeChildren {
+				children, result, err := epicChildren(ctx, deps, owner, repo, subIssues)
+				if result != nil || err != nil {
+					return result, nil, err
+				}
+				progress.Children = children
+			}
+
+			callResult := MarshalledTextResult(progress)
+			callResult = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, callResult, ifc.LabelRepoUserContent)
+			return callResult, nil, nil
+		})
+}
+
+// listAllSubIssues pages through the direct sub-issues of an issue, stopping
+// at MaxEpicProgressChildren. A non-nil result is an error to return as-is.
+func listAllSubIssues(ctx context.Context, client *github.Client, owner, repo string, issueNumber int) ([]*github.SubIssue, bool, *mcp.CallToolResult, error) {
+	var all []*github.SubIssue
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, false, utils.NewToolResultErrorFromErr(fmt.Sprintf("listing sub-issues canceled after %d results", len(all)), err), nil
+		}
+		page, resp, err := client.SubIssue.ListByIssue(ctx, owner, repo, int64(issueNumber), opts)
+		if err != nil {
+			return nil, false, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list sub-issues", resp, err), nil
+		}
+		if resp.StatusCode != http.StatusOK {
+			body, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				return nil, false, nil, fmt.Errorf("failed to read response body: %w", err)
+			}
+			return nil, false, ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list sub-issues", resp, body), nil
+		}
+		_ = resp.Body.Close()
+
+		all = append(all, page...)
+		if len(all) >= MaxEpicProgressChildren {
+			return all[:MaxEpicProgressChildren], len(all) > MaxEpicProgressChildren || resp.NextPage != 0, nil, nil
+		}
+		if resp.NextPage == 0 {
+			return all, false, nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// summarizeEpicProgress computes the aggregate counts for a set of sub-issues.
+// A sub-issue with several assignees or labels counts toward each of them.
+func summarizeEpicProgress(issueNumber int, subIssues []*github.SubIssue) EpicProgress {
+	progress := EpicProgress{
+		IssueNumber: issueNumber,
+		ByAssignee:  map[string]ProgressCount{},
+		ByLabel:     map[string]ProgressCount{},
+	}
+	bump := func(buckets map[string]ProgressCount, key string, closed bool) {
+		c := buckets[key]
+		c.Total++
+		if closed {
+			c.Closed++
+		}
+		buckets[key] = c
+	}
+
+	for _, subIssue := range subIssues {
+		sub := (*github.Issue)(subIssue)
+		closed := sub.GetState() == "closed"
+		progress.Total++
+		if closed {
+			progress.Closed++
+		}
+
+		if len(sub.Assignees) == 0 {
+			bump(progress.ByAssignee, unassignedGroup, closed)
+		}
+		for _, a := range sub.Assignees {
+			bump(progress.ByAssignee, a.GetLogin(), closed)
+		}
+		if len(sub.Labels) == 0 {
+			bump(progress.ByLabel, unlabeledGroup, closed)
+		}
+		for _, l := range sub.Labels {
+			bump(progress.ByLabel, l.GetName(), closed)
+		}
+	}
+
+	if progress.Total > 0 {
+		progress.PercentComplete = math.Round(float64(progress.Closed)/float64(progress.Total)*1000) / 10
+	}
+	return progress
+}
+
+// epicChildren converts sub-issues to per-child entries. Under lockdown mode,
+// children whose author lacks push access are omitted (mirroring
+// GetSubIssues); the aggregates still count them since they carry no content.
+func epicChildren(ctx context.Context, deps ToolDependencies, owner, repo string, subIssues []*github.SubIssue) ([]MinimalEpicChild, *mcp.CallToolResult, error) {
+	lockdown := deps.GetFlags(ctx).LockdownMode
+	cache, err := deps.GetRepoAccessCache(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get repo access cache: %w", err)
+	}
+	if lockdown && cache == nil {
+		return nil, nil, fmt.Errorf("lockdown cache is not configured")
+	}
+
+	children := make([]MinimalEpicChild, 0, len(subIssues))
+	for _, subIssue := range subIssues {
+		sub := (*github.Issue)(subIssue)
+		if lockdown {
+			login := sub.GetUser().GetLogin()
+			if login == "" {
+				continue
+			}
+			isSafeContent, err := cache.IsSafeContent(ctx, login, owner, repo)
+			if err != nil {
+				return nil, utils.NewToolResultError(fmt.Sprintf("failed to check lockdown mode: %v", err)), nil
+			}
+			if !isSafeContent {
+				continue
+			}
+		}
+
+		child := MinimalEpicChild{
+			Number:  sub.GetNumber(),
+			Title:   sanitize.Sanitize(sub.GetTitle()),
+			State:   sub.GetState(),
+			HTMLURL: sub.GetHTMLURL(),
+		}
+		for _, a := range sub.Assignees {
+			child.Assignees = append(child.Assignees, a.GetLogin())
+		}
+		for _, l := range sub.Labels {
+			child.Labels = append(child.Labels, l.GetName())
+		}
+		children = append(children, child)
+	}
+	return children, nil, nil
+}
@@ -0,0 +1,171 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetEpicProgress(t *testing.T) {
+	serverTool := GetEpicProgress(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_epic_progress", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	subIssue := func(number int, state string, assignees []string, labels []string) *github.SubIssue {
+		sub := &github.SubIssue{
+			Number:  github.Ptr(number),
+			Title:   github.Ptr(fmt.Sprintf("Task %d", number)),
+			State:   github.Ptr(state),
+			HTMLURL: github.Ptr(fmt.Sprintf("https://github.com/owner/repo/issues/%d", number)),
+			User:    &github.User{Login: github.Ptr("author")},
+		}
+		for _, a := range assignees {
+			sub.Assignees = append(sub.Assignees, &github.User{Login: github.Ptr(a)})
+		}
+		for _, l := range labels {
+			sub.Labels = append(sub.Labels, &github.Label{Name: github.Ptr(l)})
+		}
+		return sub
+	}
+
+	// Two pages: the first advertises a next page via the Link header.
+	pagedHandler := func(w http.ResponseWriter, r *http.Request) {
+		var body []*github.SubIssue
+		if r.URL.Query().Get("page") == "2" {
+			body = []*github.SubIssue{subIssue(3, "open", nil, nil)}
+		} else {
+			w.Header().Set("Link", `<https://api.github.com/repositories/1/issues/10/sub_issues?page=2>; rel="next"`)
+			body = []*github.SubIssue{
+				subIssue(1, "closed", []string{"alice"}, []string{"backend"}),
+				subIssue(2, "open", []string{"alice", "bob"}, []string{"backend", "frontend"}),
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+
+	t.Run("aggregates across pages", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: pagedHandler,
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(10),
+		})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var progress EpicProgress
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &progress))
+		assert.Equal(t, 10, progress.IssueNumber)
+		assert.Equal(t, 3, progress.Total)
+		assert.Equal(t, 1, progress.Closed)
+		assert.InDelta(t, 33.3, progress.PercentComplete, 0.001)
+		assert.Equal(t, map[string]ProgressCount{
+			"alice":         {Total: 2, Closed: 1},
+			"bob":           {Total: 1, Closed: 0},
+			unassignedGroup: {Total: 1, Closed: 0},
+		}, progress.ByAssignee)
+		assert.Equal(t, map[string]ProgressCount{
+			"backend":      {Total: 2, Closed: 1},
+			"frontend":     {Total: 1, Closed: 0},
+			unlabeledGroup: {Total: 1, Closed: 0},
+		}, progress.ByLabel)
+		assert.False(t, progress.Truncated)
+		assert.Empty(t, progress.Children)
+	})
+
+	t.Run("includes children when requested", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: pagedHandler,
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":            "owner",
+			"repo":             "repo",
+			"issue_number":     float64(10),
+			"include_children": true,
+		})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var progress EpicProgress
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &progress))
+		require.Len(t, progress.Children, 3)
+		assert.Equal(t, MinimalEpicChild{
+			Number:    2,
+			Title:     "Task 2",
+			State:     "open",
+			HTMLURL:   "https://github.com/owner/repo/issues/2",
+			Assignees: []string{"alice", "bob"},
+			Labels:    []string{"backend", "frontend"},
+		}, progress.Children[1])
+	})
+
+	t.Run("caps children and reports truncation", func(t *testing.T) {
+		fullPage := make([]*github.SubIssue, 100)
+		for i := range fullPage {
+			fullPage[i] = subIssue(i+1, "closed", nil, nil)
+		}
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: alwaysNextPageHandler(t, fullPage),
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(10),
+		})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var progress EpicProgress
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &progress))
+		assert.Equal(t, MaxEpicProgressChildren, progress.Total)
+		assert.InDelta(t, 100.0, progress.PercentComplete, 0.001)
+		assert.True(t, progress.Truncated)
+	})
+
+	t.Run("no sub-issues", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, []*github.SubIssue{}),
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(10),
+		})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var progress EpicProgress
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &progress))
+		assert.Zero(t, progress.Total)
+		assert.Zero(t, progress.PercentComplete)
+	})
+}
@@ -0,0 +1,129 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// MaxIssueProjects caps the project items list_issue_projects reads for an issue.
+const MaxIssueProjects = 10
+
+// IssueProject is a project in a list_issue_projects response.
+type IssueProject struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+}
+
+type issueProjectsQuery struct {
+	Repository struct {
+		Issue struct {
+			ProjectItems struct {
+				Nodes []struct {
+					Project struct {
+						Number githubv4.Int
+						Title  githubv4.String
+						URL    githubv4.String
+						Closed githubv4.Boolean
+						Public githubv4.Boolean
+					}
+				}
+			} `graphql:"projectItems(first: 10)"`
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// ListIssueProjects creates a tool that lists the open projects an issue is
+// tracked in.
+func ListIssueProjects(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataProjects,
+		mcp.Tool{
+			Name:        "list_issue_projects",
+			Description: t("TOOL_LIST_ISSUE_PROJECTS_DESCRIPTION", fmt.Sprintf("List the open projects an issue is tracked in, without scanning every project. Reads at most %d project items of the issue.", MaxIssueProjects)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ISSUE_PROJECTS_USER_TITLE", "List projects of an issue"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the issue",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo, scopes.ReadProject},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub graphql client", err), nil, nil
+			}
+
+			var query issueProjectsQuery
+			vars := map[string]any{
+				"owner":       githubv4.String(owner),
+				"repo":        githubv4.String(repo),
+				"issueNumber": githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get issue projects", err), nil, nil
+			}
+
+			projects := make([]IssueProject, 0)
+			var visibilities []bool
+			for _, node := range query.Repository.Issue.ProjectItems.Nodes {
+				project := node.Project
+				if project.Closed {
+					continue
+				}
+				projects = append(projects, IssueProject{
+					Number: int(project.Number),
+					Title:  sanitize.Sanitize(string(project.Title)),
+					URL:    string(project.URL),
+				})
+				visibilities = append(visibilities, !bool(project.Public))
+			}
+
+			result := MarshalledTextResult(map[string]any{
+				"projects":    projects,
+				"total_count": len(projects),
+			})
+			return attachJoinedIFCLabel(ctx, deps, result, visibilities, ifc.LabelProjectList), nil, nil
+		})
+}
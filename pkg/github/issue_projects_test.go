@@ -0,0 +1,99 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListIssueProjects(t *testing.T) {
+	serverTool := ListIssueProjects(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issue_projects", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	qProjects := "query($issueNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){issue(number: $issueNumber){projectItems(first: 10){nodes{project{number,title,url,closed,public}}}}}}"
+	vars := map[string]any{
+		"owner":       "owner",
+		"repo":        "repo",
+		"issueNumber": float64(42),
+	}
+
+	project := func(number int, title string, closed bool) map[string]any {
+		return map[string]any{
+			"project": map[string]any{
+				"number": number,
+				"title":  title,
+				"url":    "https://github.com/orgs/owner/projects/" + title,
+				"closed": closed,
+				"public": false,
+			},
+		}
+	}
+
+	deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+		githubv4mock.NewQueryMatcher(qProjects, vars, githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"issue": map[string]any{
+					"projectItems": map[string]any{
+						"nodes": []any{project(1, "roadmap", false), project(2, "archive", true), project(3, "sprint", false)},
+					},
+				},
+			},
+		})),
+	))}
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(42),
+	})
+
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var got struct {
+		Projects   []IssueProject `json:"projects"`
+		TotalCount int            `json:"total_count"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+	assert.Equal(t, []IssueProject{
+		{Number: 1, Title: "roadmap", URL: "https://github.com/orgs/owner/projects/roadmap"},
+		{Number: 3, Title: "sprint", URL: "https://github.com/orgs/owner/projects/sprint"},
+	}, got.Projects)
+	assert.Equal(t, 2, got.TotalCount)
+
+	t.Run("issue not found", func(t *testing.T) {
+		vars := map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"issueNumber": float64(404),
+		}
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(qProjects, vars, githubv4mock.ErrorResponse("Could not resolve to an Issue with the number of 404.")),
+		))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(404),
+		})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to get issue projects: Could not resolve to an Issue")
+	})
+}
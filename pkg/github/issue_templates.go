@@ -0,0 +1,551 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.yaml.in/yaml/v3"
+)
+
+// issueTemplateDir is the directory GitHub reads issue templates from.
+const issueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// issueTemplateStrings decodes a front matter value given either as a
+// comma-separated string or as a YAML list, as GitHub accepts both for
+// labels and assignees.
+type issueTemplateStrings []string
+
+func (s *issueTemplateStrings) UnmarshalYAML(value *yaml.Node) error {
+	var list []string
+	if value.Kind == yaml.SequenceNode {
+		if err := value.Decode(&list); err != nil {
+			return err
+		}
+	} else {
+		var joined string
+		if err := value.Decode(&joined); err != nil {
+			return err
+		}
+		list = strings.Split(joined, ",")
+	}
+	*s = (*s)[:0]
+	for _, item := range list {
+		if item = strings.TrimSpace(item); item != "" {
+			*s = append(*s, item)
+		}
+	}
+	return nil
+}
+
+// markdownIssueTemplate is a parsed .github/ISSUE_TEMPLATE/*.md file.
+type markdownIssueTemplate struct {
+	Name      string               `yaml:"name"`
+	About     string               `yaml:"about"`
+	Title     string               `yaml:"title"`
+	Labels    issueTemplateStrings `yaml:"labels"`
+	Assignees issueTemplateStrings `yaml:"assignees"`
+	// Body is the markdown after the front matter.
+	Body string `yaml:"-"`
+}
+
+// parseMarkdownIssueTemplate splits a markdown issue template into its YAML
+// front matter and body. A file without front matter is all body.
+func parseMarkdownIssueTemplate(content string) (markdownIssueTemplate, error) {
+	var tmpl markdownIssueTemplate
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(content, "---\n") {
+		tmpl.Body = content
+		return tmpl, nil
+	}
+	rest := content[len("---\n"):]
+	end := strings.Index(rest, "\n---")
+	if end < 0 {
+		return tmpl, errors.New("front matter is not terminated by ---")
+	}
+	if err := yaml.Unmarshal([]byte(rest[:end]), &tmpl); err != nil {
+		return tmpl, fmt.Errorf("invalid front matter: %w", err)
+	}
+	body := rest[end+len("\n---"):]
+	if i := strings.IndexByte(body, '\n'); i >= 0 {
+		body = body[i+1:]
+	} else {
+		body = ""
+	}
+	tmpl.Body = strings.TrimLeft(body, "\n")
+	return tmpl, nil
+}
+
+// issueTemplateHeadingPattern matches the lines that start a section of a
+// markdown issue template: a markdown heading or a line that is entirely bold.
+var issueTemplateHeadingPattern = regexp.MustCompile(`^(?:#{1,6}\s+(.+?)\s*#*|\*\*(.+?)\*\*)\s*$`)
+
+// issueTemplateSection returns the section name a template line starts, if any.
+func issueTemplateSection(line string) (string, bool) {
+	m := issueTemplateHeadingPattern.FindStringSubmatch(strings.TrimSpace(line))
+	if m == nil {
+		return "", false
+	}
+	name := m[1]
+	if name == "" {
+		name = m[2]
+	}
+	return strings.TrimSpace(strings.TrimSuffix(name, ":")), true
+}
+
+// issueTemplateSections returns the section names of a markdown template body
+// in order.
+func issueTemplateSections(body string) []string {
+	var sections []string
+	for _, line := range strings.Split(body, "\n") {
+		if name, ok := issueTemplateSection(line); ok {
+			sections = append(sections, name)
+		}
+	}
+	return sections
+}
+
+// applyIssueTemplateFields fills in the sections of a markdown template body.
+// Each key of fields names a section heading, matched case-insensitively, and
+// its value replaces the placeholder text under that heading. Sections without
+// a value are kept as the template wrote them.
+func applyIssueTemplateFields(body string, fields map[string]string) (string, error) {
+	lines := strings.Split(body, "\n")
+	sections := issueTemplateSections(body)
+
+	values := make(map[string]string, len(fields))
+	for key, value := range fields {
+		found := false
+		for _, section := range sections {
+			if strings.EqualFold(section, strings.TrimSpace(key)) {
+				values[strings.ToLower(section)] = value
+				found = true
+				break
+			}
+		}
+		if !found {
+			if len(sections) == 0 {
+				return "", fmt.Errorf("template has no section named %q; it has no sections to fill", key)
+			}
+			return "", fmt.Errorf("template has no section named %q; sections are: %s", key, strings.Join(sections, ", "))
+		}
+	}
+
+	out := make([]string, 0, len(lines))
+	for i := 0; i < len(lines); i++ {
+		out = append(out, lines[i])
+		name, ok := issueTemplateSection(lines[i])
+		if !ok {
+			continue
+		}
+		value, ok := values[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+		// Skip the placeholder text up to the next section.
+		for i+1 < len(lines) {
+			if _, next := issueTemplateSection(lines[i+1]); next {
+				break
+			}
+			i++
+		}
+		out = append(out, strings.TrimSpace(value), "")
+	}
+	return strings.Join(out, "\n"), nil
+}
+
+// listIssueTemplateFiles returns the files in the issue template directory.
+// A repository without the directory has no templates and yields an empty list.
+func listIssueTemplateFiles(ctx context.Context, client *github.Client, owner, repo string) ([]*github.RepositoryContent, *mcp.CallToolResult, error) {
+	_, dir, resp, err := client.Repositories.GetContents(ctx, owner, repo, issueTemplateDir, nil)
+	if resp != nil {
+		defer func() { _ = resp.Body.Close() }()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil, nil
+		}
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issue templates", resp, err), nil
+	}
+	files := make([]*github.RepositoryContent, 0, len(dir))
+	for _, entry := range dir {
+		if entry.GetType() == "file" {
+			files = append(files, entry)
+		}
+	}
+	return files, nil, nil
+}
+
+// getIssueTemplateFile returns the decoded content of a file in the issue
+// template directory.
+func getIssueTemplateFile(ctx context.Context, client *github.Client, owner, repo, name string) (string, *mcp.CallToolResult, error) {
+	file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path.Join(issueTemplateDir, name), nil)
+	if resp != nil {
+		defer func() { _ = resp.Body.Close() }()
+	}
+	if err != nil {
+		return "", ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get issue template %s", name), resp, err), nil
+	}
+	if file == nil {
+		return "", utils.NewToolResultError(fmt.Sprintf("issue template %s is not a file", name)), nil
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to decode issue template %s: %w", name, err)
+	}
+	return content, nil, nil
+}
+
+// findMarkdownIssueTemplate resolves template to one of the repository's
+// markdown issue templates, by file name (with or without .md) or by the name
+// in its front matter.
+func findMarkdownIssueTemplate(ctx context.Context, client *github.Client, owner, repo, template string) (markdownIssueTemplate, *mcp.CallToolResult, error) {
+	files, result, err := listIssueTemplateFiles(ctx, client, owner, repo)
+	if result != nil || err != nil {
+		return markdownIssueTemplate{}, result, err
+	}
+
+	var markdown []string
+	for _, file := range files {
+		if strings.EqualFold(path.Ext(file.GetName()), ".md") {
+			markdown = append(markdown, file.GetName())
+		}
+	}
+	if len(markdown) == 0 {
+		return markdownIssueTemplate{}, utils.NewToolResultError(fmt.Sprintf("%s/%s has no markdown issue templates in %s", owner, repo, issueTemplateDir)), nil
+	}
+
+	load := func(name string) (markdownIssueTemplate, *mcp.CallToolResult, error) {
+		content, result, err := getIssueTemplateFile(ctx, client, owner, repo, name)
+		if result != nil || err != nil {
+			return markdownIssueTemplate{}, result, err
+		}
+		tmpl, err := parseMarkdownIssueTemplate(content)
+		if err != nil {
+			return markdownIssueTemplate{}, utils.NewToolResultError(fmt.Sprintf("issue template %s: %v", name, err)), nil
+		}
+		return tmpl, nil, nil
+	}
+
+	for _, name := range markdown {
+		if strings.EqualFold(name, template) || strings.EqualFold(strings.TrimSuffix(name, path.Ext(name)), template) {
+			return load(name)
+		}
+	}
+
+	var available []string
+	for _, name := range markdown {
+		tmpl, result, err := load(name)
+		if result != nil || err != nil {
+			return markdownIssueTemplate{}, result, err
+		}
+		if strings.EqualFold(tmpl.Name, template) {
+			return tmpl, nil, nil
+		}
+		label := name
+		if tmpl.Name != "" {
+			label = fmt.Sprintf("%s (%s)", tmpl.Name, name)
+		}
+		available = append(available, label)
+	}
+	return markdownIssueTemplate{}, utils.NewToolResultError(fmt.Sprintf("issue template %q not found; available templates: %s", template, strings.Join(available, ", "))), nil
+}
+
+// CreateIssueFromTemplate creates a tool that creates an issue from one of the
+// repository's markdown issue templates.
+func CreateIssueFromTemplate(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "create_issue_from_template",
+			Description: t("TOOL_CREATE_ISSUE_FROM_TEMPLATE_DESCRIPTION", "Create an issue from one of the repository's markdown issue templates in "+issueTemplateDir+". The template's title prefix, labels and assignees are applied, and 'fields' fills in the sections of its body."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_ISSUE_FROM_TEMPLATE_USER_TITLE", "Create issue from template"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"template": {
+						Type:        "string",
+						Description: "The template to use: its file name in " + issueTemplateDir + " (e.g. bug_report.md) or the name in its front matter",
+					},
+					"title": {
+						Type:        "string",
+						Description: "Issue title. The template's title prefix (e.g. '[Bug] ') is prepended unless already present. Optional when the template has a title.",
+					},
+					"fields": {
+						Type:                 "object",
+						Description:          "Values for the sections of the template body, keyed by section heading (e.g. {\"Describe the bug\": \"...\"}). Each value replaces the placeholder text under its heading; sections left out keep the template text.",
+						AdditionalProperties: &jsonschema.Schema{Type: "string"},
+					},
+				},
+				Required: []string{"owner", "repo", "template"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			template, err := RequiredParam[string](args, "template")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			title, err := OptionalParam[string](args, "title")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			rawFields, err := OptionalParam[map[string]any](args, "fields")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			fields := make(map[string]string, len(rawFields))
+			for key, value := range rawFields {
+				s, ok := value.(string)
+				if !ok {
+					return utils.NewToolResultError(fmt.Sprintf("field %q must be a string", key)), nil, nil
+				}
+				fields[key] = s
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			tmpl, result, err := findMarkdownIssueTemplate(ctx, client, owner, repo, template)
+			if result != nil || err != nil {
+				return result, nil, err
+			}
+
+			body, err := applyIssueTemplateFields(tmpl.Body, fields)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if body, err = limitBodyLength("body", body, 0, false); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			prefix := tmpl.Title
+			if title == "" {
+				title = strings.TrimSpace(prefix)
+			} else if prefix != "" && !strings.HasPrefix(title, strings.TrimSpace(prefix)) {
+				title = prefix + title
+			}
+			if title == "" {
+				return utils.NewToolResultError("missing required parameter: title (the template does not define one)"), nil, nil
+			}
+
+			result, err = CreateIssue(ctx, client, owner, repo, title, body, []string(tmpl.Assignees), []string(tmpl.Labels), 0, "", nil)
+			return result, nil, err
+		})
+}
+
+// issueFormTemplate is a parsed .github/ISSUE_TEMPLATE/*.yml issue form.
+type issueFormTemplate struct {
+	Name        string               `yaml:"name"`
+	Description string               `yaml:"description"`
+	Title       string               `yaml:"title"`
+	Labels      issueTemplateStrings `yaml:"labels"`
+	Assignees   issueTemplateStrings `yaml:"assignees"`
+	Body        []struct {
+		Type       string `yaml:"type"`
+		ID         string `yaml:"id"`
+		Attributes struct {
+			Label string `yaml:"label"`
+		} `yaml:"attributes"`
+		Validations struct {
+			Required bool `yaml:"required"`
+		} `yaml:"validations"`
+	} `yaml:"body"`
+}
+
+// requiredFields returns the labels of the form's required inputs, falling
+// back to their ids when they have no label.
+func (f issueFormTemplate) requiredFields() []string {
+	var fields []string
+	for _, element := range f.Body {
+		if !element.Validations.Required {
+			continue
+		}
+		if element.Attributes.Label != "" {
+			fields = append(fields, element.Attributes.Label)
+		} else if element.ID != "" {
+			fields = append(fields, element.ID)
+		}
+	}
+	return fields
+}
+
+// issueTemplateConfig is the template chooser configuration in
+// .github/ISSUE_TEMPLATE/config.yml.
+type issueTemplateConfig struct {
+	BlankIssuesEnabled *bool                      `yaml:"blank_issues_enabled"`
+	ContactLinks       []IssueTemplateContactLink `yaml:"contact_links"`
+}
+
+// IssueTemplateContactLink is an external link offered in the template chooser.
+type IssueTemplateContactLink struct {
+	Name  string `yaml:"name" json:"name"`
+	URL   string `yaml:"url" json:"url"`
+	About string `yaml:"about" json:"about,omitempty"`
+}
+
+// IssueTemplateSummary describes one issue template for list_issue_templates.
+type IssueTemplateSummary struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	File        string `json:"file"`
+	// Type is "markdown" for .md templates and "form" for YAML issue forms.
+	Type      string   `json:"type"`
+	Title     string   `json:"title,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	// Sections are the headings of a markdown template, which
+	// create_issue_from_template accepts as fields.
+	Sections []string `json:"sections,omitempty"`
+	// RequiredFields are the inputs of an issue form marked as required.
+	RequiredFields []string `json:"required_fields,omitempty"`
+}
+
+// IssueTemplatesResponse is the output of list_issue_templates.
+type IssueTemplatesResponse struct {
+	Templates []IssueTemplateSummary `json:"templates"`
+	// BlankIssuesEnabled is unset when config.yml does not say, in which case
+	// GitHub allows blank issues.
+	BlankIssuesEnabled *bool                      `json:"blank_issues_enabled,omitempty"`
+	ContactLinks       []IssueTemplateContactLink `json:"contact_links,omitempty"`
+}
+
+// ListIssueTemplates creates a tool that lists a repository's issue templates
+// and its template chooser configuration.
+func ListIssueTemplates(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "list_issue_templates",
+			Description: t("TOOL_LIST_ISSUE_TEMPLATES_DESCRIPTION", "List the issue templates of a repository from "+issueTemplateDir+", with their names, descriptions and the fields they expect, and the template chooser settings from config.yml. Use this to pick a template before create_issue_from_template. Returns an empty list when the repository has no templates."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ISSUE_TEMPLATES_USER_TITLE", "List issue templates"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			files, result, err := listIssueTemplateFiles(ctx, client, owner, repo)
+			if result != nil || err != nil {
+				return result, nil, err
+			}
+
+			response := IssueTemplatesResponse{Templates: []IssueTemplateSummary{}}
+			for _, file := range files {
+				name := file.GetName()
+				ext := strings.ToLower(path.Ext(name))
+				isConfig := strings.EqualFold(strings.TrimSuffix(name, path.Ext(name)), "config")
+				if ext != ".md" && ext != ".yml" && ext != ".yaml" {
+					continue
+				}
+
+				content, result, err := getIssueTemplateFile(ctx, client, owner, repo, name)
+				if result != nil || err != nil {
+					return result, nil, err
+				}
+
+				switch {
+				case ext == ".md":
+					tmpl, err := parseMarkdownIssueTemplate(content)
+					if err != nil {
+						return utils.NewToolResultError(fmt.Sprintf("issue template %s: %v", name, err)), nil, nil
+					}
+					response.Templates = append(response.Templates, IssueTemplateSummary{
+						Name:        tmpl.Name,
+						Description: tmpl.About,
+						File:        name,
+						Type:        "markdown",
+						Title:       tmpl.Title,
+						Labels:      tmpl.Labels,
+						Assignees:   tmpl.Assignees,
+						Sections:    issueTemplateSections(tmpl.Body),
+					})
+				case isConfig:
+					var config issueTemplateConfig
+					if err := yaml.Unmarshal([]byte(content), &config); err != nil {
+						return utils.NewToolResultError(fmt.Sprintf("issue template config %s: %v", name, err)), nil, nil
+					}
+					response.BlankIssuesEnabled = config.BlankIssuesEnabled
+					response.ContactLinks = config.ContactLinks
+				default:
+					var form issueFormTemplate
+					if err := yaml.Unmarshal([]byte(content), &form); err != nil {
+						return utils.NewToolResultError(fmt.Sprintf("issue form %s: %v", name, err)), nil, nil
+					}
+					response.Templates = append(response.Templates, IssueTemplateSummary{
+						Name:           form.Name,
+						Description:    form.Description,
+						File:           name,
+						Type:           "form",
+						Title:          form.Title,
+						Labels:         form.Labels,
+						Assignees:      form.Assignees,
+						RequiredFields: form.requiredFields(),
+					})
+				}
+			}
+
+			return MarshalledTextResult(response), nil, nil
+		})
+}
@@ -0,0 +1,273 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bugReportTemplate = `---
+name: Bug report
+about: Create a report to help us improve
+title: "[Bug] "
+labels: bug, triage
+assignees:
+  - octocat
+---
+
+## Describe the bug
+A clear and concise description of what the bug is.
+
+## To reproduce
+Steps to reproduce the behavior.
+
+**Additional context**
+Add any other context about the problem here.
+`
+
+func issueTemplateFileResponse(t *testing.T, name, content string) http.HandlerFunc {
+	return mockResponse(t, http.StatusOK, &github.RepositoryContent{
+		Type:     github.Ptr("file"),
+		Name:     github.Ptr(name),
+		Path:     github.Ptr(".github/ISSUE_TEMPLATE/" + name),
+		Encoding: github.Ptr("base64"),
+		Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+	})
+}
+
+func issueTemplateDirResponse(t *testing.T, names ...string) http.HandlerFunc {
+	entries := make([]*github.RepositoryContent, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, &github.RepositoryContent{
+			Type: github.Ptr("file"),
+			Name: github.Ptr(name),
+			Path: github.Ptr(".github/ISSUE_TEMPLATE/" + name),
+		})
+	}
+	return mockResponse(t, http.StatusOK, entries)
+}
+
+func TestParseMarkdownIssueTemplate(t *testing.T) {
+	tmpl, err := parseMarkdownIssueTemplate(bugReportTemplate)
+	require.NoError(t, err)
+	assert.Equal(t, "Bug report", tmpl.Name)
+	assert.Equal(t, "[Bug] ", tmpl.Title)
+	assert.Equal(t, issueTemplateStrings{"bug", "triage"}, tmpl.Labels)
+	assert.Equal(t, issueTemplateStrings{"octocat"}, tmpl.Assignees)
+	assert.True(t, strings.HasPrefix(tmpl.Body, "## Describe the bug\n"))
+
+	tmpl, err = parseMarkdownIssueTemplate("Just a body\n")
+	require.NoError(t, err)
+	assert.Equal(t, "Just a body\n", tmpl.Body)
+
+	_, err = parseMarkdownIssueTemplate("---\nname: broken\n")
+	assert.Error(t, err)
+}
+
+func TestApplyIssueTemplateFields(t *testing.T) {
+	tmpl, err := parseMarkdownIssueTemplate(bugReportTemplate)
+	require.NoError(t, err)
+
+	body, err := applyIssueTemplateFields(tmpl.Body, map[string]string{
+		"describe the bug":   "The app crashes on save.",
+		"Additional context": "Only on Windows.",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `## Describe the bug
+The app crashes on save.
+
+## To reproduce
+Steps to reproduce the behavior.
+
+**Additional context**
+Only on Windows.
+`, body)
+
+	_, err = applyIssueTemplateFields(tmpl.Body, map[string]string{"Expected behavior": "x"})
+	assert.EqualError(t, err, `template has no section named "Expected behavior"; sections are: Describe the bug, To reproduce, Additional context`)
+}
+
+func Test_CreateIssueFromTemplate(t *testing.T) {
+	serverTool := CreateIssueFromTemplate(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_issue_from_template", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "template"})
+
+	const dirPath = "GET /repos/owner/repo/contents/.github/ISSUE_TEMPLATE"
+
+	t.Run("creates the issue from a template found by name", func(t *testing.T) {
+		var created github.IssueRequest
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			dirPath:                         issueTemplateDirResponse(t, "config.yml", "bug_report.md", "feature_request.md"),
+			dirPath + "/bug_report.md":      issueTemplateFileResponse(t, "bug_report.md", bugReportTemplate),
+			dirPath + "/feature_request.md": issueTemplateFileResponse(t, "feature_request.md", "---\nname: Feature request\n---\n## Idea\n"),
+			PostReposIssuesByOwnerByRepo: func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(&github.Issue{ID: github.Ptr(int64(7)), HTMLURL: github.Ptr("https://github.com/owner/repo/issues/7")})
+			},
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":    "owner",
+			"repo":     "repo",
+			"template": "bug report",
+			"title":    "Crash on save",
+			"fields":   map[string]any{"Describe the bug": "The app crashes on save."},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response MinimalResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "https://github.com/owner/repo/issues/7", response.URL)
+
+		assert.Equal(t, "[Bug] Crash on save", created.GetTitle())
+		assert.Contains(t, created.GetBody(), "## Describe the bug\nThe app crashes on save.\n")
+		assert.Contains(t, created.GetBody(), "Steps to reproduce the behavior.")
+		assert.Equal(t, []string{"bug", "triage"}, created.GetLabels())
+		assert.Equal(t, []string{"octocat"}, created.GetAssignees())
+	})
+
+	t.Run("repository without templates", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			dirPath: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":    "owner",
+			"repo":     "repo",
+			"template": "bug_report.md",
+			"title":    "Crash on save",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Equal(t, "owner/repo has no markdown issue templates in .github/ISSUE_TEMPLATE", getErrorResult(t, result).Text)
+	})
+
+	t.Run("unknown template lists the available ones", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			dirPath:                    issueTemplateDirResponse(t, "bug_report.md"),
+			dirPath + "/bug_report.md": issueTemplateFileResponse(t, "bug_report.md", bugReportTemplate),
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":    "owner",
+			"repo":     "repo",
+			"template": "security",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Equal(t, `issue template "security" not found; available templates: Bug report (bug_report.md)`, getErrorResult(t, result).Text)
+	})
+}
+
+func Test_ListIssueTemplates(t *testing.T) {
+	serverTool := ListIssueTemplates(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issue_templates", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo"})
+
+	const dirPath = "GET /repos/owner/repo/contents/.github/ISSUE_TEMPLATE"
+
+	t.Run("lists markdown templates, issue forms and the chooser config", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			dirPath:                    issueTemplateDirResponse(t, "bug_report.md", "config.yml", "feature.yml", "README.txt"),
+			dirPath + "/bug_report.md": issueTemplateFileResponse(t, "bug_report.md", bugReportTemplate),
+			dirPath + "/config.yml": issueTemplateFileResponse(t, "config.yml", `blank_issues_enabled: false
+contact_links:
+  - name: Community forum
+    url: https://github.com/orgs/owner/discussions
+    about: Ask questions here
+`),
+			dirPath + "/feature.yml": issueTemplateFileResponse(t, "feature.yml", `name: Feature request
+description: Suggest an idea
+labels: [enhancement]
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for the idea!
+  - type: textarea
+    id: problem
+    attributes:
+      label: Problem
+    validations:
+      required: true
+  - type: input
+    id: version
+    validations:
+      required: true
+  - type: textarea
+    id: extra
+    attributes:
+      label: Anything else?
+`),
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response IssueTemplatesResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, []IssueTemplateSummary{
+			{
+				Name:        "Bug report",
+				Description: "Create a report to help us improve",
+				File:        "bug_report.md",
+				Type:        "markdown",
+				Title:       "[Bug] ",
+				Labels:      []string{"bug", "triage"},
+				Assignees:   []string{"octocat"},
+				Sections:    []string{"Describe the bug", "To reproduce", "Additional context"},
+			},
+			{
+				Name:           "Feature request",
+				Description:    "Suggest an idea",
+				File:           "feature.yml",
+				Type:           "form",
+				Labels:         []string{"enhancement"},
+				RequiredFields: []string{"Problem", "version"},
+			},
+		}, response.Templates)
+		require.NotNil(t, response.BlankIssuesEnabled)
+		assert.False(t, *response.BlankIssuesEnabled)
+		assert.Equal(t, []IssueTemplateContactLink{{
+			Name:  "Community forum",
+			URL:   "https://github.com/orgs/owner/discussions",
+			About: "Ask questions here",
+		}}, response.ContactLinks)
+	})
+
+	t.Run("repository without templates", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			dirPath: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		assert.JSONEq(t, `{"templates":[]}`, getTextResult(t, result).Text)
+	})
+}
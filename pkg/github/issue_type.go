@@ -0,0 +1,192 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// orgIssueTypes holds the issue type names of each organization, so setting
+// types on several issues validates against one lookup.
+var orgIssueTypes = newSessionCache[[]string]("org-issue-types-cache", 10*time.Minute)
+
+// setIssueTypeRequest is the request body for setting or clearing an issue's
+// type. Type has no omitempty so that a nil Type is sent as "type": null,
+// which clears it.
+type setIssueTypeRequest struct {
+	Type *string `json:"type"`
+}
+
+// SetIssueTypeResult is the output of set_issue_type.
+type SetIssueTypeResult struct {
+	Number int     `json:"number"`
+	Type   *string `json:"type"`
+	URL    string  `json:"url"`
+}
+
+// listOrgIssueTypeNames returns the names of the issue types defined for org,
+// reusing the names cached for session when there are any.
+func listOrgIssueTypeNames(ctx context.Context, client *github.Client, session *mcp.ServerSession, org string) ([]string, *github.Response, error) {
+	if names, ok := orgIssueTypes.get(session, org); ok {
+		return names, nil, nil
+	}
+
+	issueTypes, resp, err := client.Organizations.ListIssueTypes(ctx, org)
+	if err != nil {
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+
+	names := make([]string, 0, len(issueTypes))
+	for _, issueType := range issueTypes {
+		names = append(names, issueType.GetName())
+	}
+	orgIssueTypes.set(session, org, names)
+	return names, resp, nil
+}
+
+// isUserAccount reports whether owner is a personal account rather than an
+// organization. Lookup failures report false so the original error is kept.
+func isUserAccount(ctx context.Context, client *github.Client, owner string) bool {
+	user, resp, err := client.Users.Get(ctx, owner)
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	return err == nil && user.GetType() == "User"
+}
+
+// SetIssueType creates a tool that sets or clears the type of an existing
+// issue, checking the type against the ones its organization defines first.
+func SetIssueType(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "set_issue_type",
+			Description: t("TOOL_SET_ISSUE_TYPE_DESCRIPTION", "Set or clear the type of an existing issue. The type name is checked against the issue types defined by the repository's organization, and the valid names are listed if it doesn't match. Pass null to clear the type. Issue types are only available for repositories owned by organizations."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_SET_ISSUE_TYPE_USER_TITLE", "Set issue type"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (an organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The issue number",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+					"type": {
+						Types:       []string{"string", "null"},
+						Description: "Name of the issue type to set, matched case-insensitively, or null to clear the issue's type. Use list_issue_types to see the valid names.",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number", "type"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo, scopes.ReadOrg},
+		func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			rawType, ok := args["type"]
+			if !ok {
+				return utils.NewToolResultError("missing required parameter: type"), nil, nil
+			}
+			var typeName *string
+			if rawType != nil {
+				name, isString := rawType.(string)
+				if !isString {
+					return utils.NewToolResultError(fmt.Sprintf("parameter type must be a string or null, got %T", rawType)), nil, nil
+				}
+				name = strings.TrimSpace(name)
+				if name == "" {
+					return utils.NewToolResultError("parameter type must be an issue type name, or null to clear the type"), nil, nil
+				}
+				typeName = &name
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			// Clearing needs no validation, and works even where issue types
+			// were never available.
+			if typeName != nil {
+				var session *mcp.ServerSession
+				if req != nil {
+					session = req.Session
+				}
+				names, resp, err := listOrgIssueTypeNames(ctx, client, session, owner)
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound && isUserAccount(ctx, client, owner) {
+						return utils.NewToolResultError(fmt.Sprintf("%s is a personal account; issue types are only available for repositories owned by organizations, so %s/%s issues can't have a type", owner, owner, repo)), nil, nil
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issue types", resp, err), nil, nil
+				}
+				if len(names) == 0 {
+					return utils.NewToolResultError(fmt.Sprintf("organization %s has no issue types defined", owner)), nil, nil
+				}
+				matched := ""
+				for _, name := range names {
+					if strings.EqualFold(name, *typeName) {
+						matched = name
+						break
+					}
+				}
+				if matched == "" {
+					return utils.NewToolResultError(fmt.Sprintf("issue type %q is not defined for organization %s; valid types: %s", *typeName, owner, strings.Join(names, ", "))), nil, nil
+				}
+				typeName = &matched
+			}
+
+			apiURL := fmt.Sprintf("repos/%s/%s/issues/%d", owner, repo, issueNumber)
+			httpReq, err := client.NewRequest(ctx, http.MethodPatch, apiURL, &setIssueTypeRequest{Type: typeName})
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to create request", err), nil, nil
+			}
+			issue := &github.Issue{}
+			resp, err := client.Do(httpReq, issue)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to set issue type", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := SetIssueTypeResult{
+				Number: issue.GetNumber(),
+				URL:    issue.GetHTMLURL(),
+			}
+			if issue.Type != nil {
+				result.Type = github.Ptr(issue.Type.GetName())
+			}
+			return MarshalledTextResult(result), nil, nil
+		})
+}
@@ -0,0 +1,163 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SetIssueType(t *testing.T) {
+	serverTool := SetIssueType(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_issue_type", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number", "type"})
+
+	issueTypes := []*github.IssueType{
+		{Name: github.Ptr("Bug")},
+		{Name: github.Ptr("Feature")},
+		{Name: github.Ptr("Task")},
+	}
+
+	// patchHandler records each PATCH body as raw JSON so an explicit null
+	// can be told apart from an omitted field.
+	patchHandler := func(t *testing.T, bodies *[]map[string]json.RawMessage) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]json.RawMessage
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			*bodies = append(*bodies, body)
+			issue := &github.Issue{Number: github.Ptr(42), HTMLURL: github.Ptr("https://github.com/acme/repo/issues/42")}
+			var name *string
+			require.NoError(t, json.Unmarshal(body["type"], &name))
+			if name != nil {
+				issue.Type = &github.IssueType{Name: name}
+			}
+			mockResponse(t, http.StatusOK, issue)(w, r)
+		}
+	}
+
+	t.Run("validates the name once per session and applies its canonical form", func(t *testing.T) {
+		lookups := 0
+		var bodies []map[string]json.RawMessage
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsIssueTypesByOrg: func(w http.ResponseWriter, r *http.Request) {
+				lookups++
+				mockResponse(t, http.StatusOK, issueTypes)(w, r)
+			},
+			PatchReposIssuesByOwnerByRepoByIssueNumber: patchHandler(t, &bodies),
+		}))}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequestWithCapabilities(t, &mcp.ClientCapabilities{})
+		request.Params = createMCPRequest(map[string]any{"owner": "acme", "repo": "repo", "issue_number": float64(42), "type": "bug"}).Params
+		for range 2 {
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response SetIssueTypeResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, 42, response.Number)
+			require.NotNil(t, response.Type)
+			assert.Equal(t, "Bug", *response.Type)
+		}
+
+		assert.Equal(t, 1, lookups)
+		require.Len(t, bodies, 2)
+		assert.JSONEq(t, `"Bug"`, string(bodies[0]["type"]))
+	})
+
+	t.Run("null clears the type without validation", func(t *testing.T) {
+		var bodies []map[string]json.RawMessage
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PatchReposIssuesByOwnerByRepoByIssueNumber: patchHandler(t, &bodies),
+		}))}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"owner": "acme", "repo": "repo", "issue_number": float64(42), "type": nil})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		require.Len(t, bodies, 1)
+		raw, ok := bodies[0]["type"]
+		require.True(t, ok, "type must be sent as null, not omitted")
+		assert.Equal(t, "null", string(raw))
+		assert.JSONEq(t, `{"number":42,"type":null,"url":"https://github.com/acme/repo/issues/42"}`, getTextResult(t, result).Text)
+	})
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		args           map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "unknown name lists the valid types",
+			handlers: map[string]http.HandlerFunc{
+				GetOrgsIssueTypesByOrg: mockResponse(t, http.StatusOK, issueTypes),
+			},
+			args:           map[string]any{"owner": "acme", "repo": "repo", "issue_number": float64(42), "type": "Bugg"},
+			expectedErrMsg: `issue type "Bugg" is not defined for organization acme; valid types: Bug, Feature, Task`,
+		},
+		{
+			name: "organization without issue types",
+			handlers: map[string]http.HandlerFunc{
+				GetOrgsIssueTypesByOrg: mockResponse(t, http.StatusOK, []*github.IssueType{}),
+			},
+			args:           map[string]any{"owner": "acme", "repo": "repo", "issue_number": float64(42), "type": "Bug"},
+			expectedErrMsg: "organization acme has no issue types defined",
+		},
+		{
+			name: "repository owned by a user account",
+			handlers: map[string]http.HandlerFunc{
+				GetOrgsIssueTypesByOrg: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				GetUsersByUsername:     mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("octocat"), Type: github.Ptr("User")}),
+			},
+			args:           map[string]any{"owner": "octocat", "repo": "repo", "issue_number": float64(42), "type": "Bug"},
+			expectedErrMsg: "octocat is a personal account; issue types are only available for repositories owned by organizations",
+		},
+		{
+			name: "missing organization",
+			handlers: map[string]http.HandlerFunc{
+				GetOrgsIssueTypesByOrg: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				GetUsersByUsername:     mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			},
+			args:           map[string]any{"owner": "ghost", "repo": "repo", "issue_number": float64(42), "type": "Bug"},
+			expectedErrMsg: "failed to list issue types",
+		},
+		{
+			name:           "missing type",
+			args:           map[string]any{"owner": "acme", "repo": "repo", "issue_number": float64(42)},
+			expectedErrMsg: "missing required parameter: type",
+		},
+		{
+			name:           "blank type",
+			args:           map[string]any{"owner": "acme", "repo": "repo", "issue_number": float64(42), "type": "  "},
+			expectedErrMsg: "parameter type must be an issue type name, or null to clear the type",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+		})
+	}
+}
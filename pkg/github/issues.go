@@ -2,13 +2,17 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -103,6 +107,150 @@ func fetchIssueIDs(ctx context.Context, gqlClient *githubv4.Client, owner, repo
 	return query.Repository.Issue.ID, query.Repository.DuplicateIssue.ID, nil
 }
 
+// fetchDuplicateIssueID retrieves the ID of an issue in another repository
+// that an issue is being closed as a duplicate of.
+func fetchDuplicateIssueID(ctx context.Context, gqlClient *githubv4.Client, ref issueReference) (githubv4.ID, error) {
+	var query struct {
+		Repository struct {
+			DuplicateIssue struct {
+				ID githubv4.ID
+			} `graphql:"duplicateIssue: issue(number: $duplicateOf)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	vars := map[string]any{
+		"owner":       githubv4.String(ref.Owner),
+		"repo":        githubv4.String(ref.Repo),
+		"duplicateOf": githubv4.Int(ref.Number), // #nosec G115 - issue numbers are always small positive integers
+	}
+	if err := gqlClient.Query(ctx, &query, vars); err != nil {
+		return "", fmt.Errorf("failed to get duplicate issue ID: %w", err)
+	}
+	return query.Repository.DuplicateIssue.ID, nil
+}
+
+// issueNotFoundPattern matches the GraphQL error for an issue number that does
+// not exist in the repository.
+var issueNotFoundPattern = regexp.MustCompile(`Could not resolve to an Issue with the number of (\d+)`)
+
+// issueNotFoundResult returns a concise "issue #N not found" tool error when
+// err is GraphQL's error for a missing issue, and nil otherwise. The full error
+// is still recorded in the context for middleware.
+func issueNotFoundResult(ctx context.Context, message string, err error) *mcp.CallToolResult {
+	if err == nil {
+		return nil
+	}
+	m := issueNotFoundPattern.FindStringSubmatch(err.Error())
+	if m == nil {
+		return nil
+	}
+	_, _ = ghErrors.NewGitHubGraphQLErrorToCtx(ctx, message, err)
+	return utils.NewToolResultError(fmt.Sprintf("issue #%s not found", m[1]))
+}
+
+// partialDataError records err, which a GraphQL query returned alongside data
+// that is still usable, in the context like a failed query, and returns the
+// message to report with that data.
+func partialDataError(ctx context.Context, message string, err error) string {
+	_, _ = ghErrors.NewGitHubGraphQLErrorToCtx(ctx, message, err)
+	return fmt.Sprintf("%s: %v", message, err)
+}
+
+// closeIssueByID closes an issue through the closeIssue mutation with the
+// given state reason. duplicateIssueID is only used when the reason is
+// "duplicate".
+func closeIssueByID(ctx context.Context, gqlClient *githubv4.Client, issueID githubv4.ID, stateReason string, duplicateIssueID githubv4.ID) error {
+	var mutation struct {
+		CloseIssue struct {
+			Issue struct {
+				ID     githubv4.ID
+				Number githubv4.Int
+				URL    githubv4.String
+				State  githubv4.String
+			}
+		} `graphql:"closeIssue(input: $input)"`
+	}
+
+	stateReasonValue := getCloseStateReason(stateReason)
+	closeInput := CloseIssueInput{
+		IssueID:     issueID,
+		StateReason: &stateReasonValue,
+	}
+
+	// Set duplicate issue ID if needed
+	if stateReason == "duplicate" {
+		closeInput.DuplicateIssueID = &duplicateIssueID
+	}
+
+	return gqlClient.Mutate(ctx, &mutation, closeInput, nil)
+}
+
+// validateIssueStateChange rejects state, state_reason and duplicate_of
+// combinations that the API would silently ignore.
+func validateIssueStateChange(state, stateReason string, duplicateOf int) error {
+	switch stateReason {
+	case "", "completed", "not_planned", "duplicate":
+	case "reopened":
+		return errors.New("state_reason 'reopened' cannot be set; pass state 'open' to reopen an issue")
+	default:
+		return fmt.Errorf("state_reason must be one of: completed, not_planned, duplicate, got '%s'", stateReason)
+	}
+	if stateReason != "" && state == "" {
+		return fmt.Errorf("state_reason '%s' requires state; pass state 'closed' to close the issue with this reason", stateReason)
+	}
+	if stateReason != "" && state != "closed" {
+		return fmt.Errorf("state_reason '%s' is only valid with state 'closed', not '%s'", stateReason, state)
+	}
+	if duplicateOf != 0 && state != "closed" {
+		if state == "" {
+			return errors.New("duplicate_of requires state 'closed' with state_reason 'duplicate'")
+		}
+		return fmt.Errorf("duplicate_of requires state 'closed' with state_reason 'duplicate', not state '%s'", state)
+	}
+	if duplicateOf != 0 && stateReason != "duplicate" {
+		if stateReason == "" {
+			return errors.New("duplicate_of requires state_reason 'duplicate'")
+		}
+		return fmt.Errorf("duplicate_of requires state_reason 'duplicate', not '%s'", stateReason)
+	}
+	return nil
+}
+
+// duplicateOfPattern matches the string forms duplicate_of accepts: "123",
+// "#123" and "owner/repo#123".
+var duplicateOfPattern = regexp.MustCompile(`^(?:([\w.-]+)/([\w.-]+)#|#)?(\d+)$`)
+
+// duplicateOfParam reads duplicate_of as an issue number in owner/repo or a
+// reference to an issue in any repository. It returns a zero reference when
+// the parameter is absent.
+func duplicateOfParam(args map[string]any, owner, repo string) (issueReference, error) {
+	ref := issueReference{Owner: owner, Repo: repo}
+	switch v := args["duplicate_of"].(type) {
+	case nil:
+		return issueReference{}, nil
+	case float64:
+		if v != float64(int(v)) || v < 1 {
+			return issueReference{}, fmt.Errorf("duplicate_of must be a positive issue number, got %v", v)
+		}
+		ref.Number = int(v)
+	case string:
+		m := duplicateOfPattern.FindStringSubmatch(strings.TrimSpace(v))
+		if m == nil {
+			return issueReference{}, fmt.Errorf("duplicate_of must be an issue number like 123 or a reference like \"owner/repo#123\", got %q", v)
+		}
+		if m[1] != "" {
+			ref.Owner, ref.Repo = m[1], m[2]
+		}
+		n, err := strconv.Atoi(m[3])
+		if err != nil || n < 1 {
+			return issueReference{}, fmt.Errorf("duplicate_of must be a positive issue number, got %q", v)
+		}
+		ref.Number = n
+	default:
+		return issueReference{}, fmt.Errorf("duplicate_of must be an issue number like 123 or a reference like \"owner/repo#123\", got %T", v)
+	}
+	return ref, nil
+}
+
 // getCloseStateReason converts a string state reason to the appropriate enum value
 func getCloseStateReason(stateReason string) IssueClosedStateReason {
 	switch stateReason {
@@ -486,17 +634,22 @@ type IssueFragment struct {
 	Body       githubv4.String
 	State      githubv4.String
 	DatabaseID int64
+	ID         githubv4.ID
+	URL        githubv4.String
 
 	Author struct {
 		Login githubv4.String
 	}
 	CreatedAt githubv4.DateTime
 	UpdatedAt githubv4.DateTime
-	Labels    struct {
+	// ClosedAt is nil for open issues.
+	ClosedAt *githubv4.DateTime
+	Labels   struct {
 		Nodes []struct {
 			Name        githubv4.String
 			ID          githubv4.String
 			Description githubv4.String
+			Color       githubv4.String
 		}
 	} `graphql:"labels(first: 100)"`
 	Comments struct {
@@ -617,10 +770,12 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 					"Options are:\n" +
 					"1. get - Get issue details. Also returns best-effort hierarchy flags (`has_parent`, `has_children`); `parent` and `sub_issues_summary` are optional relationship summaries.\n" +
 					"2. get_comments - Get issue comments.\n" +
-					"3. get_sub_issues - Get sub-issues (children) of the issue.\n" +
+					"3. get_sub_issues - Get sub-issues (children) of the issue. Uses REST page numbers by default; set use_graphql, or a perPage above 100, to walk them with GraphQL cursors instead.\n" +
 					"4. get_parent - Get the parent issue, if this issue is a sub-issue of another.\n" +
-					"5. get_labels - Get labels assigned to the issue.\n",
-				Enum: []any{"get", "get_comments", "get_sub_issues", "get_parent", "get_labels"},
+					"5. get_labels - Get labels assigned to the issue.\n" +
+					"6. get_linked_pull_requests - Get pull requests that will close the issue when merged, plus ones connected from its timeline, including closed and merged ones. Same response as get_issue_linked_prs.\n" +
+					"7. get_sub_issue_progress - Get only the sub-issue completion summary (total, completed, percent_completed). Much cheaper than get_sub_issues when only progress is needed.\n",
+				Enum: []any{"get", "get_comments", "get_sub_issues", "get_parent", "get_labels", "get_linked_pull_requests", "get_sub_issue_progress"},
 			},
 			"owner": {
 				Type:        "string",
@@ -634,10 +789,45 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 				Type:        "number",
 				Description: "The number of the issue",
 			},
+			"rich": {
+				Type:        "boolean",
+				Description: "Only used with method 'get'. When true, fetches the issue via GraphQL and additionally returns assignees, reaction counts, linked pull requests and sub-issue progress.",
+			},
+			"max_body_chars": {
+				Type:        "number",
+				Description: "Only used with method 'get'. Truncate the issue body to this many characters, appending a '" + bodyTruncationMarker + "' marker and setting 'body_truncated'. By default the body is returned in full.",
+				Minimum:     jsonschema.Ptr(1.0),
+			},
+			"use_graphql": {
+				Type:        "boolean",
+				Description: fmt.Sprintf("Only used with method 'get_sub_issues'. When true, lists sub-issues through the GraphQL subIssues connection, returning trimmed sub-issues with pageInfo cursors; pass pageInfo.endCursor as 'after' to get the next page. Implied when perPage is above 100 (up to %d).", MaxSubIssuesPerPage),
+			},
+			"after": {
+				Type:        "string",
+				Description: "Only used with method 'get_sub_issues' over GraphQL. Cursor from the previous page's pageInfo.endCursor.",
+			},
+			"exclude_bots": {
+				Type:        "boolean",
+				Description: "Only used with method 'get_comments'. When true, omits comments authored by bot accounts. Filtering is applied to the fetched page, so fewer than perPage comments may be returned.",
+			},
+			"include_reactions": {
+				Type:        "boolean",
+				Description: fmt.Sprintf("Only used with method 'get_comments'. When true, adds reaction_groups to each comment: its reactions grouped by type with the users who reacted, from up to %d reactions per comment. Costs one extra request per comment with reactions. Defaults to false.", MaxCommentReactions),
+			},
+			"author_association": {
+				Type:        "array",
+				Description: "Only used with method 'get_comments'. Only return comments whose author has one of these associations with the repository. Filtering is applied to the fetched page, so fewer than perPage comments may be returned.",
+				Items: &jsonschema.Schema{
+					Type: "string",
+					Enum: []any{"OWNER", "MEMBER", "COLLABORATOR", "CONTRIBUTOR", "FIRST_TIME_CONTRIBUTOR", "FIRST_TIMER", "MANNEQUIN", "NONE"},
+				},
+			},
 		},
 		Required: []string{"method", "owner", "repo", "issue_number"},
 	}
 	WithPagination(schema)
+	schema.Properties["perPage"].Description = fmt.Sprintf("Results per page for pagination (min 1, max 100; up to %d for method 'get_sub_issues', which then uses GraphQL)", MaxSubIssuesPerPage)
+	schema.Properties["perPage"].Maximum = jsonschema.Ptr(float64(MaxSubIssuesPerPage))
 
 	return NewTool(
 		ToolsetMetadataIssues,
@@ -665,7 +855,7 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -674,6 +864,38 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			// The schema allows up to MaxSubIssuesPerPage for get_sub_issues;
+			// every other method pages over REST, which caps pages at 100.
+			if method != "get_sub_issues" && pagination.PerPage > 100 {
+				return utils.NewToolResultError(fmt.Sprintf("perPage must be at most 100 for method '%s'; only 'get_sub_issues' accepts up to %d", method, MaxSubIssuesPerPage)), nil, nil
+			}
+
+			rich, err := OptionalParam[bool](args, "rich")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			maxBodyChars, err := OptionalIntParam(args, "max_body_chars")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxBodyChars < 0 {
+				return utils.NewToolResultError("max_body_chars must be a positive number"), nil, nil
+			}
+
+			var commentFilter IssueCommentFilter
+			commentFilter.ExcludeBots, err = OptionalParam[bool](args, "exclude_bots")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commentFilter.AuthorAssociations, err = OptionalStringArrayParam(args, "author_association")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includeReactions, err := OptionalParam[bool](args, "include_reactions")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
@@ -692,17 +914,38 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 
 			switch method {
 			case "get":
-				result, err := GetIssue(ctx, client, deps, owner, repo, issueNumber)
+				if rich {
+					result, err := GetIssueRich(ctx, gqlClient, deps, owner, repo, issueNumber, maxBodyChars)
+					return attachIFC(result), nil, err
+				}
+				result, err := GetIssue(ctx, client, deps, owner, repo, issueNumber, maxBodyChars)
 				return attachIFC(result), nil, err
 			case "get_comments":
-				result, err := GetIssueComments(ctx, client, deps, owner, repo, issueNumber, pagination)
+				result, err := GetIssueComments(ctx, client, deps, owner, repo, issueNumber, pagination, commentFilter, includeReactions)
 				return attachIFC(result), nil, err
 			case "get_sub_issues":
+				useGraphQL, err := OptionalParam[bool](args, "use_graphql")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				if useGraphQL || pagination.PerPage > 100 {
+					if pagination.Page > 1 {
+						return utils.NewToolResultError("page is not supported when listing sub-issues over GraphQL; use the after cursor instead"), nil, nil
+					}
+					result, err := GetSubIssuesGraphQL(ctx, gqlClient, deps, owner, repo, issueNumber, pagination.PerPage, pagination.After)
+					return attachIFC(result), nil, err
+				}
 				result, err := GetSubIssues(ctx, client, deps, owner, repo, issueNumber, pagination)
 				return attachIFC(result), nil, err
 			case "get_parent":
 				result, err := GetIssueParent(ctx, gqlClient, deps, owner, repo, issueNumber)
 				return attachIFC(result), nil, err
+			case "get_linked_pull_requests":
+				result, err := ListIssueLinkedPullRequests(ctx, gqlClient, deps, owner, repo, issueNumber, true)
+				return attachIFC(result), nil, err
+			case "get_sub_issue_progress":
+				result, err := GetSubIssueProgress(ctx, gqlClient, owner, repo, issueNumber)
+				return attachIFC(result), nil, err
 			case "get_labels":
 				result, err := GetIssueLabels(ctx, gqlClient, owner, repo, issueNumber)
 				return attachIFC(result), nil, err
@@ -712,7 +955,9 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 		})
 }
 
-func GetIssue(ctx context.Context, client *github.Client, deps ToolDependencies, owner string, repo string, issueNumber int) (*mcp.CallToolResult, error) {
+// GetIssue fetches a single issue over REST. A positive maxBodyChars truncates
+// the body (see truncateIssueBody); zero returns it in full.
+func GetIssue(ctx context.Context, client *github.Client, deps ToolDependencies, owner string, repo string, issueNumber int, maxBodyChars int) (*mcp.CallToolResult, error) {
 	cache, err := deps.GetRepoAccessCache(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
@@ -721,14 +966,14 @@ func GetIssue(ctx context.Context, client *github.Client, deps ToolDependencies,
 
 	issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get issue: %w", err)
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, err), nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read response body: %w", err)
+			return utils.NewToolResultErrorFromErr("failed to read response body", err), nil
 		}
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get issue", resp, body), nil
 	}
@@ -760,6 +1005,8 @@ func GetIssue(ctx context.Context, client *github.Client, deps ToolDependencies,
 	}
 
 	minimalIssue := convertToMinimalIssue(issue)
+	ref := issueRefFromIssue(issue, owner, repo)
+	minimalIssue.IssueRef = &ref
 
 	// Always drop the verbose REST IssueFieldValues; enrich with the GraphQL
 	// field_values view and the hierarchy relationship signals instead. The
@@ -773,10 +1020,272 @@ func GetIssue(ctx context.Context, client *github.Client, deps ToolDependencies,
 			}
 		}
 	}
+	truncateIssueBody(&minimalIssue, maxBodyChars)
+
+	return MarshalledTextResult(minimalIssue), nil
+}
+
+// issueRichQuery fetches a single issue with the fields the REST endpoint does not expose
+// (reaction groups, linked pull requests, sub-issue progress) alongside the core fields, so
+// the rich issue_read `get` path needs only one round-trip.
+type issueRichQuery struct {
+	Repository struct {
+		Issue struct {
+			ID                githubv4.ID
+			DatabaseID        int64
+			Number            githubv4.Int
+			Title             githubv4.String
+			Body              githubv4.String
+			State             githubv4.String
+			StateReason       *githubv4.String
+			Locked            githubv4.Boolean
+			URL               githubv4.String
+			AuthorAssociation githubv4.String
+			CreatedAt         githubv4.DateTime
+			UpdatedAt         githubv4.DateTime
+			ClosedAt          *githubv4.DateTime
+			Author            struct {
+				Login githubv4.String
+			}
+			Labels struct {
+				Nodes []struct {
+					Name  githubv4.String
+					Color githubv4.String
+				}
+			} `graphql:"labels(first: 100)"`
+			Assignees struct {
+				Nodes []struct {
+					Login githubv4.String
+				}
+			} `graphql:"assignees(first: 100)"`
+			Milestone *struct {
+				Title githubv4.String
+			}
+			IssueType *struct {
+				Name githubv4.String
+			}
+			Comments struct {
+				TotalCount githubv4.Int
+			}
+			ReactionGroups []struct {
+				Content  githubv4.ReactionContent
+				Reactors struct {
+					TotalCount githubv4.Int
+				}
+			}
+			ClosedByPullRequestsReferences struct {
+				Nodes []struct {
+					Number  githubv4.Int
+					Title   githubv4.String
+					State   githubv4.String
+					IsDraft githubv4.Boolean
+					URL     githubv4.String
+					Author  struct {
+						Login githubv4.String
+					}
+					Repository struct {
+						NameWithOwner githubv4.String
+					}
+				}
+			} `graphql:"closedByPullRequestsReferences(first: 25, includeClosedPrs: true)"`
+			IssueFieldValues struct {
+				Nodes []IssueFieldValueFragment
+			} `graphql:"issueFieldValues(first: 25)"`
+			Parent *struct {
+				Number githubv4.Int
+				Title  githubv4.String
+				State  githubv4.String
+				URL    githubv4.String
+				Author struct {
+					Login githubv4.String
+				}
+				Repository struct {
+					NameWithOwner githubv4.String
+				}
+			}
+			SubIssuesSummary struct {
+				Total            githubv4.Int
+				Completed        githubv4.Int
+				PercentCompleted githubv4.Int
+			}
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetIssueRich is the GraphQL-backed variant of GetIssue. It returns the same MinimalIssue
+// shape populated from a single query, plus assignees, reaction counts aggregated from the
+// reaction groups, and the pull requests that will close the issue. The lockdown rules of
+// GetIssue apply to the issue itself, its parent and each linked pull request.
+func GetIssueRich(ctx context.Context, client *githubv4.Client, deps ToolDependencies, owner string, repo string, issueNumber int, maxBodyChars int) (*mcp.CallToolResult, error) {
+	cache, err := deps.GetRepoAccessCache(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
+	}
+	flags := deps.GetFlags(ctx)
+
+	var query issueRichQuery
+	vars := map[string]any{
+		"owner":       githubv4.String(owner),
+		"repo":        githubv4.String(repo),
+		"issueNumber": githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+	}
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get issue", err), nil
+	}
+	issue := query.Repository.Issue
+
+	if flags.LockdownMode {
+		if cache == nil {
+			return nil, fmt.Errorf("lockdown cache is not configured")
+		}
+		login := string(issue.Author.Login)
+		if login != "" {
+			isSafeContent, err := cache.IsSafeContent(ctx, login, owner, repo)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("failed to check lockdown mode: %v", err)), nil
+			}
+			if !isSafeContent {
+				return utils.NewToolResultError("access to issue details is restricted by lockdown mode"), nil
+			}
+		}
+	}
+
+	minimalIssue := MinimalIssue{
+		Number:            int(issue.Number),
+		Title:             sanitize.Sanitize(string(issue.Title)),
+		Body:              sanitize.Sanitize(string(issue.Body)),
+		State:             strings.ToLower(string(issue.State)),
+		Locked:            bool(issue.Locked),
+		HTMLURL:           string(issue.URL),
+		User:              &MinimalUser{Login: string(issue.Author.Login)},
+		AuthorAssociation: string(issue.AuthorAssociation),
+		Comments:          int(issue.Comments.TotalCount),
+		CreatedAt:         issue.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         issue.UpdatedAt.Format(time.RFC3339),
+	}
+	nodeID, _ := issue.ID.(string)
+	minimalIssue.IssueRef = &IssueRef{
+		Owner:  owner,
+		Repo:   repo,
+		Number: minimalIssue.Number,
+		ID:     issue.DatabaseID,
+		NodeID: nodeID,
+		URL:    minimalIssue.HTMLURL,
+		Title:  minimalIssue.Title,
+		State:  minimalIssue.State,
+	}
+	if issue.StateReason != nil {
+		minimalIssue.StateReason = strings.ToLower(string(*issue.StateReason))
+	}
+	if issue.ClosedAt != nil {
+		minimalIssue.ClosedAt = issue.ClosedAt.Format(time.RFC3339)
+	}
+	if issue.Milestone != nil {
+		minimalIssue.Milestone = string(issue.Milestone.Title)
+	}
+	if issue.IssueType != nil {
+		minimalIssue.IssueType = string(issue.IssueType.Name)
+	}
+	for _, label := range issue.Labels.Nodes {
+		minimalIssue.Labels = append(minimalIssue.Labels, MinimalLabel{Name: string(label.Name), Color: string(label.Color)})
+	}
+	for _, assignee := range issue.Assignees.Nodes {
+		minimalIssue.Assignees = append(minimalIssue.Assignees, string(assignee.Login))
+	}
+
+	reactions := &MinimalReactions{}
+	for _, group := range issue.ReactionGroups {
+		count := int(group.Reactors.TotalCount)
+		switch group.Content {
+		case githubv4.ReactionContentThumbsUp:
+			reactions.PlusOne = count
+		case githubv4.ReactionContentThumbsDown:
+			reactions.MinusOne = count
+		case githubv4.ReactionContentLaugh:
+			reactions.Laugh = count
+		case githubv4.ReactionContentConfused:
+			reactions.Confused = count
+		case githubv4.ReactionContentHeart:
+			reactions.Heart = count
+		case githubv4.ReactionContentHooray:
+			reactions.Hooray = count
+		case githubv4.ReactionContentRocket:
+			reactions.Rocket = count
+		case githubv4.ReactionContentEyes:
+			reactions.Eyes = count
+		default:
+			continue
+		}
+		reactions.TotalCount += count
+	}
+	minimalIssue.Reactions = reactions
+
+	for _, pr := range issue.ClosedByPullRequestsReferences.Nodes {
+		repository := string(pr.Repository.NameWithOwner)
+		if flags.LockdownMode && !isSafeLinkedContent(ctx, cache, string(pr.Author.Login), repository) {
+			continue
+		}
+		minimalIssue.LinkedPullRequests = append(minimalIssue.LinkedPullRequests, MinimalLinkedPullRequest{
+			Number:     int(pr.Number),
+			Title:      sanitize.Sanitize(string(pr.Title)),
+			State:      string(pr.State),
+			Draft:      bool(pr.IsDraft),
+			URL:        string(pr.URL),
+			Repository: repository,
+		})
+	}
+
+	enrichment := &issueReadEnrichment{
+		FieldValues: make([]MinimalFieldValue, 0, len(issue.IssueFieldValues.Nodes)),
+		SubIssuesSummary: MinimalSubIssuesSummary{
+			Total:            int(issue.SubIssuesSummary.Total),
+			Completed:        int(issue.SubIssuesSummary.Completed),
+			PercentCompleted: int(issue.SubIssuesSummary.PercentCompleted),
+		},
+	}
+	for _, fv := range issue.IssueFieldValues.Nodes {
+		if m, ok := fragmentToMinimalFieldValue(fv); ok {
+			enrichment.FieldValues = append(enrichment.FieldValues, m)
+		}
+	}
+	if p := issue.Parent; p != nil {
+		enrichment.Parent = &issueReadParent{
+			Ref: MinimalIssueRef{
+				Number:     int(p.Number),
+				Title:      sanitize.Sanitize(string(p.Title)),
+				State:      string(p.State),
+				URL:        string(p.URL),
+				Repository: string(p.Repository.NameWithOwner),
+			},
+			AuthorLogin: string(p.Author.Login),
+		}
+	}
+	applyIssueReadEnrichment(ctx, &minimalIssue, enrichment, cache, flags.LockdownMode)
+	truncateIssueBody(&minimalIssue, maxBodyChars)
 
 	return MarshalledTextResult(minimalIssue), nil
 }
 
+// bodyTruncationMarker is appended to issue bodies cut short by max_body_chars.
+const bodyTruncationMarker = "…(truncated)"
+
+// truncateIssueBody shortens the body of issue to at most maxChars characters
+// followed by bodyTruncationMarker, and sets BodyTruncated. A non-positive
+// maxChars leaves the body untouched.
+func truncateIssueBody(issue *MinimalIssue, maxChars int) {
+	issue.Body, issue.BodyTruncated = truncateBody(issue.Body, maxChars)
+}
+
+// truncateBody returns body cut to at most maxChars characters followed by
+// bodyTruncationMarker, and whether it was cut. A non-positive maxChars
+// returns body unchanged.
+func truncateBody(body string, maxChars int) (string, bool) {
+	if maxChars <= 0 || utf8.RuneCountInString(body) <= maxChars {
+		return body, false
+	}
+	return string([]rune(body)[:maxChars]) + bodyTruncationMarker, true
+}
+
 // applyIssueReadEnrichment populates the hierarchy relationship signals (has_parent/has_children,
 // parent, sub_issues_summary) and field_values onto the minimal issue. In lockdown mode the parent
 // reference is omitted unless the parent content can be verified as safe; has_parent and the numeric
@@ -811,21 +1320,102 @@ func applyIssueReadEnrichment(ctx context.Context, minimalIssue *MinimalIssue, e
 // It fails closed: any inability to positively verify safe content (missing cache, missing author,
 // unparseable repository, or a lookup error) results in the parent reference being omitted.
 func isSafeParentContent(ctx context.Context, cache *lockdown.RepoAccessCache, parent *issueReadParent) bool {
-	if cache == nil || parent.AuthorLogin == "" {
+	return isSafeLinkedContent(ctx, cache, parent.AuthorLogin, parent.Ref.Repository)
+}
+
+// isSafeLinkedContent reports whether content authored by login in the "owner/repo" repository
+// can be exposed under lockdown mode. Like isSafeParentContent it fails closed.
+func isSafeLinkedContent(ctx context.Context, cache *lockdown.RepoAccessCache, login, nameWithOwner string) bool {
+	if cache == nil || login == "" {
 		return false
 	}
-	owner, repo, ok := strings.Cut(parent.Ref.Repository, "/")
+	owner, repo, ok := strings.Cut(nameWithOwner, "/")
 	if !ok || owner == "" || repo == "" {
 		return false
 	}
-	safe, err := cache.IsSafeContent(ctx, parent.AuthorLogin, owner, repo)
+	safe, err := cache.IsSafeContent(ctx, login, owner, repo)
 	if err != nil {
 		return false
 	}
 	return safe
 }
 
-func GetIssueComments(ctx context.Context, client *github.Client, deps ToolDependencies, owner string, repo string, issueNumber int, pagination PaginationParams) (*mcp.CallToolResult, error) {
+// IssueCommentFilter narrows the comments returned by GetIssueComments. The zero value
+// matches every comment.
+type IssueCommentFilter struct {
+	// ExcludeBots drops comments authored by bot accounts.
+	ExcludeBots bool
+	// AuthorAssociations, when non-empty, keeps only comments whose author_association
+	// matches one of the given values (case-insensitive).
+	AuthorAssociations []string
+}
+
+func (f IssueCommentFilter) matches(comment *github.IssueComment) bool {
+	if f.ExcludeBots && isBotUser(comment.GetUser()) {
+		return false
+	}
+	if len(f.AuthorAssociations) == 0 {
+		return true
+	}
+	for _, association := range f.AuthorAssociations {
+		if strings.EqualFold(association, comment.GetAuthorAssociation()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBotUser reports whether the user is a bot account, either by its account type or by
+// the "[bot]" suffix GitHub Apps use for their logins.
+func isBotUser(user *github.User) bool {
+	if user == nil {
+		return false
+	}
+	return user.GetType() == "Bot" || strings.HasSuffix(user.GetLogin(), "[bot]")
+}
+
+// MaxCommentReactions caps the reactions fetched per comment for
+// include_reactions; the reactions rollup still has the full counts.
+const MaxCommentReactions = 100
+
+// reactionContents lists the reaction types in the order GitHub displays them.
+var reactionContents = []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"}
+
+// listCommentReactionGroups fetches up to MaxCommentReactions reactions on an
+// issue comment and groups them by type, in reactionContents order.
+func listCommentReactionGroups(ctx context.Context, client *github.Client, owner, repo string, commentID int64) ([]CommentReactionGroup, *github.Response, error) {
+	reactions, resp, err := client.Reactions.ListIssueCommentReactions(ctx, owner, repo, commentID, &github.ListReactionOptions{
+		ListOptions: github.ListOptions{PerPage: MaxCommentReactions},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+
+	byContent := make(map[string]*CommentReactionGroup)
+	for _, reaction := range reactions {
+		content := reaction.GetContent()
+		group, ok := byContent[content]
+		if !ok {
+			group = &CommentReactionGroup{Content: content, Users: []string{}}
+			byContent[content] = group
+		}
+		group.Count++
+		if login := reaction.GetUser().GetLogin(); login != "" {
+			group.Users = append(group.Users, login)
+		}
+	}
+
+	groups := make([]CommentReactionGroup, 0, len(byContent))
+	for _, content := range reactionContents {
+		if group, ok := byContent[content]; ok {
+			groups = append(groups, *group)
+		}
+	}
+	return groups, resp, nil
+}
+
+func GetIssueComments(ctx context.Context, client *github.Client, deps ToolDependencies, owner string, repo string, issueNumber int, pagination PaginationParams, filter IssueCommentFilter, includeReactions bool) (*mcp.CallToolResult, error) {
 	cache, err := deps.GetRepoAccessCache(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
@@ -841,14 +1431,14 @@ func GetIssueComments(ctx context.Context, client *github.Client, deps ToolDepen
 
 	comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get issue comments: %w", err)
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue comments", resp, err), nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read response body: %w", err)
+			return utils.NewToolResultErrorFromErr("failed to read response body", err), nil
 		}
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get issue comments", resp, body), nil
 	}
@@ -879,7 +1469,21 @@ func GetIssueComments(ctx context.Context, client *github.Client, deps ToolDepen
 
 	minimalComments := make([]MinimalIssueComment, 0, len(comments))
 	for _, comment := range comments {
-		minimalComments = append(minimalComments, convertToMinimalIssueComment(comment))
+		if !filter.matches(comment) {
+			continue
+		}
+		minimalComment := convertToMinimalIssueComment(comment)
+		// The reactions rollup tells which comments have none, so those
+		// don't cost a request.
+		if includeReactions && (comment.Reactions == nil || comment.Reactions.GetTotalCount() > 0) {
+			groups, resp, err := listCommentReactionGroups(ctx, client, owner, repo, comment.GetID())
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list comment reactions", resp, err), nil
+			}
+			minimalComment.ReactionGroups = groups
+			minimalComment.ReactionsTruncated = comment.Reactions.GetTotalCount() > MaxCommentReactions
+		}
+		minimalComments = append(minimalComments, minimalComment)
 	}
 
 	return MarshalledTextResult(minimalComments), nil
@@ -941,7 +1545,12 @@ func GetSubIssues(ctx context.Context, client *github.Client, deps ToolDependenc
 		subIssues = filteredSubIssues
 	}
 
-	r, err := json.Marshal(subIssues)
+	items := make([]IssueWithRef, 0, len(subIssues))
+	for _, subIssue := range subIssues {
+		items = append(items, newIssueWithRef((*github.Issue)(subIssue), owner, repo))
+	}
+
+	r, err := json.Marshal(items)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal response: %w", err)
 	}
@@ -1025,6 +1634,39 @@ func GetIssueParent(ctx context.Context, client *githubv4.Client, deps ToolDepen
 	}), nil
 }
 
+// GetSubIssueProgress returns the native subIssuesSummary counts of an issue.
+// The summary carries no user content, so no lockdown check is needed.
+func GetSubIssueProgress(ctx context.Context, client *githubv4.Client, owner string, repo string, issueNumber int) (*mcp.CallToolResult, error) {
+	var query struct {
+		Repository struct {
+			Issue struct {
+				SubIssuesSummary struct {
+					Total            githubv4.Int
+					Completed        githubv4.Int
+					PercentCompleted githubv4.Int
+				}
+			} `graphql:"issue(number: $issueNumber)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	vars := map[string]any{
+		"owner":       githubv4.String(owner),
+		"repo":        githubv4.String(repo),
+		"issueNumber": githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+	}
+
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get sub-issue progress", err), nil
+	}
+
+	summary := query.Repository.Issue.SubIssuesSummary
+	return MarshalledTextResult(MinimalSubIssuesSummary{
+		Total:            int(summary.Total),
+		Completed:        int(summary.Completed),
+		PercentCompleted: int(summary.PercentCompleted),
+	}), nil
+}
+
 func GetIssueLabels(ctx context.Context, client *githubv4.Client, owner string, repo string, issueNumber int) (*mcp.CallToolResult, error) {
 	// Get current labels on the issue using GraphQL
 	var query struct {
@@ -1105,7 +1747,7 @@ func ListIssueTypes(t translations.TranslationHelperFunc) inventory.ServerTool {
 			},
 		},
 		[]scopes.Scope{scopes.Repo, scopes.ReadOrg},
-		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+		func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
 			owner, err := RequiredParam[string](args, "owner")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -1119,6 +1761,10 @@ func ListIssueTypes(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
+			var session *mcp.ServerSession
+			if req != nil {
+				session = req.Session
+			}
 
 			if repo != "" {
 				apiURL := fmt.Sprintf("repos/%s/%s/issue-types", owner, repo)
@@ -1153,6 +1799,10 @@ func ListIssueTypes(t translations.TranslationHelperFunc) inventory.ServerTool {
 
 			issueTypes, resp, err := client.Organizations.ListIssueTypes(ctx, owner)
 			if err != nil {
+				// The endpoint 404s for user accounts as if the org did not exist.
+				if resp != nil && resp.StatusCode == http.StatusNotFound && ownerAccountType(ctx, client, session, owner) == "user" {
+					return utils.NewToolResultError(fmt.Sprintf("issue types are only available for organizations; '%s' is a user account", owner)), nil, nil
+				}
 				return utils.NewToolResultErrorFromErr("failed to list issue types", err), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -1220,6 +1870,15 @@ func AddIssueComment(t translations.TranslationHelperFunc) inventory.ServerTool
 						Description: "Emoji reaction to add. Required unless body is provided.",
 						Enum:        []any{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"},
 					},
+					"auto_truncate": {
+						Type:        "boolean",
+						Description: autoTruncateDescription,
+					},
+					"reply_to_comment_id": {
+						Type:        "number",
+						Description: fmt.Sprintf("The numeric ID of a comment on the same issue or pull request to reply to. Its first %d lines (at most %d characters) are quoted with attribution above body. Requires body.", maxReplyQuoteLines, maxReplyQuoteChars),
+						Minimum:     jsonschema.Ptr(1.0),
+					},
 				},
 				Required: []string{"owner", "repo", "issue_number"},
 			},
@@ -1234,7 +1893,7 @@ func AddIssueComment(t translations.TranslationHelperFunc) inventory.ServerTool
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -1273,12 +1932,59 @@ func AddIssueComment(t translations.TranslationHelperFunc) inventory.ServerTool
 			if hasReaction && reactionContent == "" {
 				return utils.NewToolResultError("reaction cannot be empty when provided"), nil, nil
 			}
+			autoTruncate, err := OptionalParam[bool](args, "auto_truncate")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if hasBody {
+				if body, err = limitBodyLength("body", body, 0, autoTruncate); err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+			var replyToCommentID int64
+			if _, ok := args["reply_to_comment_id"]; ok {
+				replyToCommentID, err = RequiredBigInt(args, "reply_to_comment_id")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				if replyToCommentID < 1 {
+					return utils.NewToolResultError("reply_to_comment_id must be greater than 0"), nil, nil
+				}
+				if !hasBody {
+					return utils.NewToolResultError("reply_to_comment_id can only be provided when body is provided"), nil, nil
+				}
+			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
 
+			// Resolve the quoted comment before anything is written so a bad
+			// reference never leaves a half-applied reaction or comment behind.
+			if replyToCommentID != 0 {
+				replyTo, resp, err := client.Issues.GetComment(ctx, owner, repo, replyToCommentID)
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						return utils.NewToolResultError(fmt.Sprintf("reply_to_comment_id %d not found", replyToCommentID)), nil, nil
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get comment to reply to", resp, err), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				replyIssueNumber, err := issueNumberFromIssueURL(replyTo.GetIssueURL())
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to determine issue number for comment", err), nil, nil
+				}
+				if replyIssueNumber != issueNumber {
+					return utils.NewToolResultError(fmt.Sprintf("reply_to_comment_id does not belong to issue_number %d", issueNumber)), nil, nil
+				}
+				body = quoteIssueComment(replyTo) + "\n\n" + body
+				if body, err = limitBodyLength("body with the quoted comment", body, 0, autoTruncate); err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+
 			var reactionResponse *MinimalResponse
 			if hasReaction {
 				if hasCommentID {
@@ -1360,13 +2066,58 @@ func AddIssueComment(t translations.TranslationHelperFunc) inventory.ServerTool
 
 			r, err := json.Marshal(result)
 			if err != nil {
-				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
 
 			return utils.NewToolResultText(string(r)), nil, nil
 		})
 }
 
+const (
+	// maxReplyQuoteLines caps how many lines of a replied-to comment are quoted.
+	maxReplyQuoteLines = 10
+	// maxReplyQuoteChars caps how many characters of a replied-to comment are quoted.
+	maxReplyQuoteChars = 1000
+)
+
+// quoteIssueComment renders comment as a Markdown quote block headed by an
+// "@author wrote:" line linking to the comment. The quoted text is cut to
+// maxReplyQuoteLines lines and maxReplyQuoteChars characters, with an ellipsis
+// marking the cut.
+func quoteIssueComment(comment *github.IssueComment) string {
+	text := strings.TrimSpace(strings.ReplaceAll(comment.GetBody(), "\r\n", "\n"))
+	truncated := false
+
+	lines := strings.Split(text, "\n")
+	if len(lines) > maxReplyQuoteLines {
+		lines = lines[:maxReplyQuoteLines]
+		truncated = true
+	}
+	text = strings.Join(lines, "\n")
+	if runes := []rune(text); len(runes) > maxReplyQuoteChars {
+		text = string(runes[:maxReplyQuoteChars])
+		truncated = true
+	}
+	if truncated {
+		text = strings.TrimRight(text, " \n") + "…"
+	}
+
+	author := comment.GetUser().GetLogin()
+	if author == "" {
+		author = "ghost"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "> [@%s wrote:](%s)", author, comment.GetHTMLURL())
+	for _, line := range strings.Split(text, "\n") {
+		b.WriteString("\n>")
+		if line != "" {
+			b.WriteString(" " + line)
+		}
+	}
+	return b.String()
+}
+
 func issueNumberFromIssueURL(issueURL string) (int, error) {
 	issueNumberString := issueURL[strings.LastIndex(issueURL, "/")+1:]
 	issueNumber, err := strconv.Atoi(issueNumberString)
@@ -1396,8 +2147,9 @@ func SubIssueWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 							"Options are:\n" +
 							"- 'add' - add a sub-issue to a parent issue in a GitHub repository.\n" +
 							"- 'remove' - remove a sub-issue from a parent issue in a GitHub repository.\n" +
-							"- 'reprioritize' - change the order of sub-issues within a parent issue in a GitHub repository. Use either 'after_id' or 'before_id' to specify the new position.\n" +
-							"Writes issue hierarchy. To move a sub-issue to a new parent, use `add` with `replace_parent=true`; there is no writable parent field.\n",
+							"- 'reprioritize' - change the order of sub-issues within a parent issue in a GitHub repository. Use either 'after_id' or 'before_id' to specify the new position, or 'position' to move it to the top or bottom.\n" +
+							"- 'move' - move a sub-issue from the parent 'issue_number' to the parent 'new_parent' in one call that replaces its parent, so it is never left without one.\n" +
+							"Writes issue hierarchy. To move a sub-issue to a new parent, use `move` or `add` with `replace_parent=true`; there is no writable parent field.\n",
 					},
 					"owner": {
 						Type:        "string",
@@ -1427,6 +2179,15 @@ func SubIssueWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 						Type:        "number",
 						Description: "The ID of the sub-issue to be prioritized before (either after_id OR before_id should be specified)",
 					},
+					"position": {
+						Type:        "string",
+						Description: "Move the sub-issue to the top or bottom of the list instead of specifying after_id/before_id. Use with 'reprioritize' method only.",
+						Enum:        []any{"top", "bottom"},
+					},
+					"new_parent": {
+						Type:        "number",
+						Description: "The number of the issue to move the sub-issue to. Required for 'move' method.",
+					},
 				},
 				Required: []string{"method", "owner", "repo", "issue_number", "sub_issue_id"},
 			},
@@ -1446,7 +2207,7 @@ func SubIssueWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -1466,6 +2227,10 @@ func SubIssueWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			position, err := OptionalParam[string](args, "position")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
@@ -1473,6 +2238,17 @@ func SubIssueWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 			}
 
 			switch strings.ToLower(method) {
+			case "move":
+				newParent, err := RequiredInt(args, "new_parent")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				gqlClient, err := deps.GetGQLClient(ctx)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
+				}
+				result, err := MoveSubIssueToParent(ctx, client, gqlClient, owner, repo, subIssueID, 0, issueNumber, newParent)
+				return result, nil, err
 			case "add":
 				result, err := AddSubIssue(ctx, client, owner, repo, issueNumber, subIssueID, replaceParent)
 				return result, nil, err
@@ -1482,7 +2258,7 @@ func SubIssueWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return result, nil, err
 			case "reprioritize":
 				// Call the reprioritize sub-issue function
-				result, err := ReprioritizeSubIssue(ctx, client, owner, repo, issueNumber, subIssueID, afterID, beforeID)
+				result, err := ReprioritizeSubIssue(ctx, client, owner, repo, issueNumber, subIssueID, afterID, beforeID, position)
 				return result, nil, err
 			default:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
@@ -1492,7 +2268,27 @@ func SubIssueWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return st
 }
 
+// validateSubIssueIDs rejects non-positive IDs before they reach the API,
+// which otherwise answers with an unhelpful 400/422. afterID and beforeID are
+// optional; zero means not provided.
+func validateSubIssueIDs(subIssueID, afterID, beforeID int) error {
+	if subIssueID <= 0 {
+		return fmt.Errorf("sub_issue_id must be a positive integer (the issue ID, not the issue number), got %d", subIssueID)
+	}
+	if afterID < 0 {
+		return fmt.Errorf("after_id must be a positive integer, got %d", afterID)
+	}
+	if beforeID < 0 {
+		return fmt.Errorf("before_id must be a positive integer, got %d", beforeID)
+	}
+	return nil
+}
+
 func AddSubIssue(ctx context.Context, client *github.Client, owner string, repo string, issueNumber int, subIssueID int, replaceParent bool) (*mcp.CallToolResult, error) {
+	if err := validateSubIssueIDs(subIssueID, 0, 0); err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
 	subIssueRequest := github.SubIssueRequest{
 		SubIssueID:    int64(subIssueID),
 		ReplaceParent: github.Ptr(replaceParent),
@@ -1517,7 +2313,7 @@ func AddSubIssue(ctx context.Context, client *github.Client, owner string, repo
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to add sub-issue", resp, body), nil
 	}
 
-	r, err := json.Marshal(subIssue)
+	r, err := json.Marshal(newIssueWithRef((*github.Issue)(subIssue), owner, repo))
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal response: %w", err)
 	}
@@ -1526,6 +2322,10 @@ func AddSubIssue(ctx context.Context, client *github.Client, owner string, repo
 }
 
 func RemoveSubIssue(ctx context.Context, client *github.Client, owner string, repo string, issueNumber int, subIssueID int) (*mcp.CallToolResult, error) {
+	if err := validateSubIssueIDs(subIssueID, 0, 0); err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
 	subIssueRequest := github.SubIssueRequest{
 		SubIssueID: int64(subIssueID),
 	}
@@ -1548,7 +2348,7 @@ func RemoveSubIssue(ctx context.Context, client *github.Client, owner string, re
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to remove sub-issue", resp, body), nil
 	}
 
-	r, err := json.Marshal(subIssue)
+	r, err := json.Marshal(newIssueWithRef((*github.Issue)(subIssue), owner, repo))
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal response: %w", err)
 	}
@@ -1556,10 +2356,29 @@ func RemoveSubIssue(ctx context.Context, client *github.Client, owner string, re
 	return utils.NewToolResultText(string(r)), nil
 }
 
-func ReprioritizeSubIssue(ctx context.Context, client *github.Client, owner string, repo string, issueNumber int, subIssueID int, afterID int, beforeID int) (*mcp.CallToolResult, error) {
+// ReprioritizeSubIssue moves a sub-issue within its parent. The new place is
+// given either by afterID/beforeID or by position ("top" or "bottom"), which
+// is resolved to a neighbor by listing the parent's sub-issues.
+func ReprioritizeSubIssue(ctx context.Context, client *github.Client, owner string, repo string, issueNumber int, subIssueID int, afterID int, beforeID int, position string) (*mcp.CallToolResult, error) {
+	if err := validateSubIssueIDs(subIssueID, afterID, beforeID); err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	if position != "" {
+		if afterID != 0 || beforeID != 0 {
+			return utils.NewToolResultError("position cannot be combined with after_id or before_id"), nil
+		}
+		var result *mcp.CallToolResult
+		var err error
+		afterID, beforeID, result, err = resolveSubIssuePosition(ctx, client, owner, repo, issueNumber, subIssueID, position)
+		if result != nil || err != nil {
+			return result, err
+		}
+	}
+
 	// Validate that either after_id or before_id is specified, but not both
 	if afterID == 0 && beforeID == 0 {
-		return utils.NewToolResultError("either after_id or before_id must be specified"), nil
+		return utils.NewToolResultError("either after_id or before_id must be specified (or use position)"), nil
 	}
 	if afterID != 0 && beforeID != 0 {
 		return utils.NewToolResultError("only one of after_id or before_id should be specified, not both"), nil
@@ -1597,7 +2416,7 @@ func ReprioritizeSubIssue(ctx context.Context, client *github.Client, owner stri
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to reprioritize sub-issue", resp, body), nil
 	}
 
-	r, err := json.Marshal(subIssue)
+	r, err := json.Marshal(newIssueWithRef((*github.Issue)(subIssue), owner, repo))
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal response: %w", err)
 	}
@@ -1605,6 +2424,53 @@ func ReprioritizeSubIssue(ctx context.Context, client *github.Client, owner stri
 	return utils.NewToolResultText(string(r)), nil
 }
 
+// resolveSubIssuePosition turns a "top" or "bottom" position into the
+// before_id or after_id of the neighbor to place the sub-issue against. A
+// non-nil result is an error to return as-is.
+func resolveSubIssuePosition(ctx context.Context, client *github.Client, owner, repo string, issueNumber, subIssueID int, position string) (int, int, *mcp.CallToolResult, error) {
+	if position != "top" && position != "bottom" {
+		return 0, 0, utils.NewToolResultError(fmt.Sprintf("position must be 'top' or 'bottom', got %q", position)), nil
+	}
+
+	var neighbor int64
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.SubIssue.ListByIssue(ctx, owner, repo, int64(issueNumber), opts)
+		if err != nil {
+			return 0, 0, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list sub-issues", resp, err), nil
+		}
+		if resp.StatusCode != http.StatusOK {
+			body, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				return 0, 0, nil, fmt.Errorf("failed to read response body: %w", err)
+			}
+			return 0, 0, ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list sub-issues", resp, body), nil
+		}
+		_ = resp.Body.Close()
+
+		for _, sub := range page {
+			id := (*github.Issue)(sub).GetID()
+			if id == int64(subIssueID) {
+				continue
+			}
+			neighbor = id
+			if position == "top" {
+				return 0, int(neighbor), nil, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	if neighbor == 0 {
+		return 0, 0, utils.NewToolResultError(fmt.Sprintf("issue #%d has no other sub-issues to position against", issueNumber)), nil
+	}
+	return int(neighbor), 0, nil, nil
+}
+
 // SearchIssues creates a tool to search for issues.
 func SearchIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 	schema := &jsonschema.Schema{
@@ -1644,6 +2510,11 @@ func SearchIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 				Description: "Sort order",
 				Enum:        []any{"asc", "desc"},
 			},
+			"resolve_me": {
+				Type:        "boolean",
+				Description: "Replace @me in assignee:, author:, mentions: and involves: qualifiers with the authenticated user's login. Use when the token does not support @me.",
+			},
+			"body_preview_chars": bodyPreviewCharsSchema(),
 		},
 		Required: []string{"query"},
 	}
@@ -1658,12 +2529,13 @@ func SearchIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 				Title:        t("TOOL_SEARCH_ISSUES_USER_TITLE", "Search issues"),
 				ReadOnlyHint: true,
 			},
-			InputSchema: schema,
+			InputSchema:  schema,
+			OutputSchema: searchIssuesOutputSchema(),
 		},
 		[]scopes.Scope{scopes.Repo},
-		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
-			result, err := searchIssuesHandler(ctx, deps, args, ifcSearchPostProcessOption(ctx, deps))
-			return result, nil, err
+		func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			result, err := searchIssuesHandler(ctx, deps, args, ifcSearchPostProcessOption(ctx, deps), withSearchSession(req))
+			return limitResponseSize(deps, result, "Request a smaller page with perPage or narrow the query, and fetch the rest with page."), nil, err
 		})
 }
 
@@ -1753,6 +2625,9 @@ func parseRepositoryURL(repoURL string) (string, string, bool) {
 type SearchIssueResult struct {
 	*github.Issue
 	FieldValues []MinimalFieldValue `json:"field_values,omitempty"`
+	IssueRef    *IssueRef           `json:"issue_ref,omitempty"`
+	// BodyTruncated is set when the body was cut to body_preview_chars.
+	BodyTruncated bool `json:"body_truncated,omitempty"`
 }
 
 // MarshalJSON serializes SearchIssueResult, suppressing the raw issue_field_values from the
@@ -1774,6 +2649,16 @@ func (r SearchIssueResult) MarshalJSON() ([]byte, error) {
 		}
 		m["field_values"] = fv
 	}
+	if r.IssueRef != nil {
+		ref, err := json.Marshal(r.IssueRef)
+		if err != nil {
+			return nil, err
+		}
+		m["issue_ref"] = ref
+	}
+	if r.BodyTruncated {
+		m["body_truncated"] = json.RawMessage("true")
+	}
 	return json.Marshal(m)
 }
 
@@ -1783,6 +2668,88 @@ type SearchIssuesResponse struct {
 	Total             *int                `json:"total_count,omitempty"`
 	IncompleteResults *bool               `json:"incomplete_results,omitempty"`
 	Items             []SearchIssueResult `json:"items"`
+	// Truncated is set when more issues match than the search API returns
+	// for one query, so some can not be reached by paging.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// bodyPreviewCharsSchema describes the body_preview_chars parameter of the
+// issue search tools.
+func bodyPreviewCharsSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:        "number",
+		Description: "Cut each result's body to a preview of this many characters, appending a '" + bodyTruncationMarker + "' marker and setting 'body_truncated'. By default bodies are returned in full.",
+		Minimum:     jsonschema.Ptr(1.0),
+	}
+}
+
+// searchIssuesOutputSchema describes the structured content of search_issues.
+// Items are REST issue objects, so only the fields callers rely on are pinned
+// and the rest are left open.
+func searchIssuesOutputSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"total_count": {
+				Type:        "integer",
+				Description: "Total number of issues matching the query",
+			},
+			"incomplete_results": {
+				Type:        "boolean",
+				Description: "Whether the search timed out before collecting all results",
+			},
+			"truncated": {
+				Type:        "boolean",
+				Description: fmt.Sprintf("Whether more than %d issues match, the most the search API returns for a query", MaxSearchResults),
+			},
+			"items": {
+				Type: "array",
+				Items: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"number":         {Type: "integer"},
+						"title":          {Type: "string"},
+						"state":          {Type: "string"},
+						"html_url":       {Type: "string"},
+						"repository_url": {Type: "string"},
+						"issue_ref":      issueRefOutputSchema(),
+						"body_truncated": {Type: "boolean"},
+						"field_values": {
+							Type: "array",
+							Items: &jsonschema.Schema{
+								Type: "object",
+								Properties: map[string]*jsonschema.Schema{
+									"field":  {Type: "string"},
+									"value":  {Type: "string"},
+									"values": {Type: "array", Items: &jsonschema.Schema{Type: "string"}},
+								},
+							},
+						},
+					},
+					Required: []string{"number", "title", "state"},
+				},
+			},
+		},
+		Required: []string{"items"},
+	}
+}
+
+// issueRefOutputSchema describes an IssueRef in hand-written output schemas.
+func issueRefOutputSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner":   {Type: "string"},
+			"repo":    {Type: "string"},
+			"number":  {Type: "integer"},
+			"id":      {Type: "integer"},
+			"node_id": {Type: "string"},
+			"url":     {Type: "string"},
+			"title":   {Type: "string"},
+			"state":   {Type: "string"},
+		},
+		Required: []string{"owner", "repo", "number", "title", "state"},
+	}
 }
 
 // searchIssuesNodesQuery batches a nodes(ids:) lookup over the REST search results to retrieve
@@ -1933,18 +2900,33 @@ func fetchIssueReadEnrichment(ctx context.Context, gqlClient *githubv4.Client, n
 func searchIssuesHandler(ctx context.Context, deps ToolDependencies, args map[string]any, options ...searchOption) (*mcp.CallToolResult, error) {
 	const errorPrefix = "failed to search issues"
 
-	query, opts, err := prepareSearchArgs(args, "issue")
+	cfg := searchConfig{}
+	for _, opt := range options {
+		opt(&cfg)
+	}
+	query, opts, err := prepareSearchArgs(args, "issue", deps.GetRepoPolicy())
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	bodyPreviewChars, err := OptionalIntParam(args, "body_preview_chars")
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil
 	}
+	if bodyPreviewChars < 0 {
+		return utils.NewToolResultError("body_preview_chars must be a positive number"), nil
+	}
 
 	client, err := deps.GetClient(ctx)
 	if err != nil {
 		return utils.NewToolResultErrorFromErr(errorPrefix+": failed to get GitHub client", err), nil
 	}
+	query, errResult := resolveSearchMe(ctx, client, cfg.session, args, query)
+	if errResult != nil {
+		return errResult, nil
+	}
 	result, resp, err := client.Search.Issues(ctx, query, opts)
 	if err != nil {
-		return utils.NewToolResultErrorFromErr(errorPrefix, err), nil
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, errorPrefix, resp, err), nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -1955,6 +2937,7 @@ func searchIssuesHandler(ctx context.Context, deps ToolDependencies, args map[st
 		}
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, errorPrefix, resp, body), nil
 	}
+	trimSearchIssueLabels(result)
 
 	var fieldValuesByID map[string][]MinimalFieldValue
 	if len(result.Issues) > 0 {
@@ -1971,8 +2954,16 @@ func searchIssuesHandler(ctx context.Context, deps ToolDependencies, args map[st
 	items := make([]SearchIssueResult, 0, len(result.Issues))
 	for _, iss := range result.Issues {
 		hit := SearchIssueResult{Issue: iss}
-		if iss != nil && iss.NodeID != nil {
-			hit.FieldValues = fieldValuesByID[*iss.NodeID]
+		if iss != nil {
+			ref := issueRefFromIssue(iss, "", "")
+			hit.IssueRef = &ref
+			if iss.NodeID != nil {
+				hit.FieldValues = fieldValuesByID[*iss.NodeID]
+			}
+			if body, truncated := truncateBody(iss.GetBody(), bodyPreviewChars); truncated {
+				iss.Body = &body
+				hit.BodyTruncated = true
+			}
 		}
 		items = append(items, hit)
 	}
@@ -1981,17 +2972,12 @@ func searchIssuesHandler(ctx context.Context, deps ToolDependencies, args map[st
 		Total:             result.Total,
 		IncompleteResults: result.IncompleteResults,
 		Items:             items,
+		Truncated:         result.GetTotal() > MaxSearchResults,
 	}
 
-	r, err := json.Marshal(response)
-	if err != nil {
-		return utils.NewToolResultErrorFromErr(errorPrefix+": failed to marshal response", err), nil
-	}
-
-	callResult := utils.NewToolResultText(string(r))
-	cfg := searchConfig{}
-	for _, opt := range options {
-		opt(&cfg)
+	callResult := StructuredTextResult(response)
+	if callResult.IsError {
+		return callResult, nil
 	}
 	if cfg.postProcess != nil {
 		cfg.postProcess(ctx, result, callResult)
@@ -2111,6 +3097,10 @@ Options are:
 						Type:        "string",
 						Description: "Issue body content",
 					},
+					"auto_truncate": {
+						Type:        "boolean",
+						Description: autoTruncateDescription,
+					},
 					"assignees": {
 						Type:        "array",
 						Description: "Usernames to assign to this issue",
@@ -2140,12 +3130,24 @@ Options are:
 					},
 					"state_reason": {
 						Type:        "string",
-						Description: "Reason for the state change. Ignored unless state is changed.",
+						Description: "Reason for closing the issue. Requires state 'closed'.",
 						Enum:        []any{"completed", "not_planned", "duplicate"},
 					},
 					"duplicate_of": {
-						Type:        "number",
-						Description: "Issue number that this issue is a duplicate of. Only used when state_reason is 'duplicate'.",
+						Types:       []string{"number", "string"},
+						Description: "Issue this issue is a duplicate of: an issue number in the same repository, or a reference like 'owner/repo#123' for an issue in another repository. Requires state 'closed' and state_reason 'duplicate'.",
+					},
+					"comment": {
+						Type:        "string",
+						Description: "Comment to post after changing the issue state, e.g. to explain why it was closed or reopened. Only used when state is set.",
+					},
+					"return_changes": {
+						Type:        "boolean",
+						Description: "For 'update', include a 'changes' object listing the assignees and labels that were added or removed. Costs one extra API call.",
+					},
+					"dedupe_key": {
+						Type:        "string",
+						Description: "Idempotency key for 'create'. If an open issue you created in the last 24 hours carries the same key, it is returned with 'deduplicated: true' instead of creating a new one.",
 					},
 					"issue_fields": {
 						Type:        "array",
@@ -2207,7 +3209,7 @@ Options are:
 			if shouldDeferToForm(ctx, deps, req, args, issueWriteFormParams) {
 				issueNumber := 0
 				if method == "update" {
-					n, numErr := RequiredInt(args, "issue_number")
+					n, numErr := RequiredPositiveInt(args, "issue_number")
 					if numErr != nil {
 						return utils.NewToolResultError("issue_number is required for update method"), nil, nil
 					}
@@ -2271,12 +3273,48 @@ Options are:
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			duplicateOf, err := OptionalIntParam(args, "duplicate_of")
+			duplicateOf, err := duplicateOfParam(args, owner, repo)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if err := deps.GetRepoPolicy().checkRepo(duplicateOf.Owner, duplicateOf.Repo); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if err := validateIssueStateChange(state, stateReason, duplicateOf.Number); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			returnChanges, err := OptionalParam[bool](args, "return_changes")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			stateComment, err := OptionalParam[string](args, "comment")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if stateComment != "" && (method != "update" || state == "") {
+				return utils.NewToolResultError("comment can only be used when changing issue state (method 'update' with state set)"), nil, nil
+			}
+
+			dedupeKey, err := OptionalParam[string](args, "dedupe_key")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			autoTruncate, err := OptionalParam[bool](args, "auto_truncate")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			if duplicateOf != 0 && stateReason != "duplicate" {
-				return utils.NewToolResultError("duplicate_of can only be used when state_reason is 'duplicate'"), nil, nil
+			reserved := 0
+			if method == "create" && dedupeKey != "" {
+				reserved = dedupeMarkerLength(dedupeKey)
+			}
+			if body, err = limitBodyLength("body", body, reserved, autoTruncate); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if stateComment, err = limitBodyLength("comment", stateComment, 0, autoTruncate); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
 			var issueFields []issueWriteFieldInput
@@ -2306,16 +3344,22 @@ Options are:
 
 			switch method {
 			case "create":
-				result, err := CreateIssue(ctx, client, owner, repo, title, body, assignees, labels, milestoneNum, issueType, issueFieldValues)
+				result, err := CreateIssue(ctx, client, owner, repo, title, body, assignees, labels, milestoneNum, issueType, issueFieldValues, CreateIssueOptions{
+					DedupeKey: dedupeKey,
+				})
 				return result, nil, err
 			case "update":
-				issueNumber, err := RequiredInt(args, "issue_number")
+				issueNumber, err := RequiredPositiveInt(args, "issue_number")
 				if err != nil {
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
-				result, err := UpdateIssue(ctx, client, gqlClient, owner, repo, issueNumber, title, body, assignees, labels, milestoneNum, issueType, issueFieldValues, fieldIDsToDelete, state, stateReason, duplicateOf, UpdateIssueOptions{
+				result, err := UpdateIssue(ctx, client, gqlClient, owner, repo, issueNumber, title, body, assignees, labels, milestoneNum, issueType, issueFieldValues, fieldIDsToDelete, state, stateReason, duplicateOf.Number, UpdateIssueOptions{
+					DuplicateOwner:    duplicateOf.Owner,
+					DuplicateRepo:     duplicateOf.Repo,
 					AssigneesProvided: assigneesProvided,
 					LabelsProvided:    labelsProvided,
+					StateComment:      stateComment,
+					ReturnChanges:     returnChanges,
 				})
 				return result, nil, err
 			default:
@@ -2326,11 +3370,33 @@ Options are:
 	return st
 }
 
-func CreateIssue(ctx context.Context, client *github.Client, owner string, repo string, title string, body string, assignees []string, labels []string, milestoneNum int, issueType string, issueFieldValues []*github.IssueRequestFieldValue) (*mcp.CallToolResult, error) {
+// CreateIssueOptions controls optional behaviour of issue creation.
+type CreateIssueOptions struct {
+	// DedupeKey makes creation idempotent: an open issue created by the
+	// authenticated user within dedupeWindow whose body carries the same
+	// key is returned instead of creating a new one.
+	DedupeKey string
+}
+
+func CreateIssue(ctx context.Context, client *github.Client, owner string, repo string, title string, body string, assignees []string, labels []string, milestoneNum int, issueType string, issueFieldValues []*github.IssueRequestFieldValue, opts ...CreateIssueOptions) (*mcp.CallToolResult, error) {
 	if title == "" {
 		return utils.NewToolResultError("missing required parameter: title"), nil
 	}
 
+	var createOptions CreateIssueOptions
+	for _, opt := range opts {
+		if opt.DedupeKey != "" {
+			createOptions.DedupeKey = opt.DedupeKey
+		}
+	}
+
+	if createOptions.DedupeKey != "" {
+		if result := dedupedIssueResult(ctx, client, owner, repo, createOptions.DedupeKey); result != nil {
+			return result, nil
+		}
+		body = appendDedupeMarker(body, createOptions.DedupeKey)
+	}
+
 	// Create the issue request
 	issueRequest := &github.IssueRequest{
 		Title:            github.Ptr(title),
@@ -2374,18 +3440,200 @@ func CreateIssue(ctx context.Context, client *github.Client, owner string, repo
 
 	r, err := json.Marshal(minimalResponse)
 	if err != nil {
-		return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
 	}
 
 	return utils.NewToolResultText(string(r)), nil
 }
 
+// dedupeWindow bounds how far back issue creation looks for an existing
+// issue carrying the same dedupe key.
+const dedupeWindow = 24 * time.Hour
+
+// escapeDedupeKey percent-encodes every byte outside [A-Za-z0-9_.:] so a key
+// can never terminate the HTML comment it is embedded in.
+func escapeDedupeKey(key string) string {
+	var b strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_', c == '.', c == ':':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// dedupeMarker returns the hidden HTML comment that tags an issue body with key.
+func dedupeMarker(key string) string {
+	return "<!-- mcp-dedupe:" + escapeDedupeKey(key) + " -->"
+}
+
+// appendDedupeMarker appends the dedupe marker for key to body.
+func appendDedupeMarker(body, key string) string {
+	if body == "" {
+		return dedupeMarker(key)
+	}
+	return body + "\n\n" + dedupeMarker(key)
+}
+
+// dedupeMarkerLength is the number of characters appendDedupeMarker adds to a
+// non-empty body.
+func dedupeMarkerLength(key string) int {
+	return utf8.RuneCountInString("\n\n" + dedupeMarker(key))
+}
+
+// findDedupedIssue looks for an open issue created by the authenticated user
+// within dedupeWindow whose body carries the dedupe marker for key. It lists
+// the repository's issues rather than searching, since the search index can
+// lag behind an issue created moments earlier. It returns nil when no such
+// issue exists.
+func findDedupedIssue(ctx context.Context, client *github.Client, owner, repo, key string) (*github.Issue, *github.Response, error) {
+	// An app installation has no user to filter by; the marker alone still
+	// identifies the issue.
+	creator, resp, err := authenticatedLogin(ctx, client, nil)
+	if err != nil && !errors.Is(err, errAppTokenHasNoUser) {
+		return nil, resp, err
+	}
+
+	windowStart := time.Now().UTC().Add(-dedupeWindow)
+	opts := &github.IssueListByRepoOptions{
+		Creator:     creator,
+		State:       "open",
+		Since:       windowStart,
+		Sort:        "created",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	marker := dedupeMarker(key)
+	for {
+		issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+
+		for _, issue := range issues {
+			// Since filters on the update time, so older issues that were
+			// updated recently are listed too; they end the scan.
+			if issue.GetCreatedAt().Before(windowStart) {
+				return nil, resp, nil
+			}
+			if !issue.IsPullRequest() && strings.Contains(issue.GetBody(), marker) {
+				return issue, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, resp, nil
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+}
+
+// dedupedIssueResult returns the result for an existing issue carrying the
+// dedupe marker for key, or an error result if the lookup failed. It returns
+// nil when a new issue should be created.
+func dedupedIssueResult(ctx context.Context, client *github.Client, owner, repo, key string) *mcp.CallToolResult {
+	existing, resp, err := findDedupedIssue(ctx, client, owner, repo, key)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to look up duplicate issue", resp, err)
+	}
+	if existing == nil {
+		return nil
+	}
+	return MarshalledTextResult(MinimalResponse{
+		ID:           fmt.Sprintf("%d", existing.GetID()),
+		URL:          existing.GetHTMLURL(),
+		Deduplicated: true,
+	})
+}
+
 // UpdateIssueOptions controls which optional fields are included in an issue update request.
 type UpdateIssueOptions struct {
 	// AssigneesProvided sends the assignees field even when the slice is empty.
 	AssigneesProvided bool
 	// LabelsProvided sends the labels field even when the slice is empty.
 	LabelsProvided bool
+	// StateComment is posted as an issue comment after a successful close
+	// or reopen.
+	StateComment string
+	// ReturnChanges fetches the issue before editing and reports the
+	// assignees and labels that were added or removed.
+	ReturnChanges bool
+	// DuplicateOwner and DuplicateRepo locate the duplicateOf issue when it
+	// is in another repository. They default to the updated issue's.
+	DuplicateOwner string
+	DuplicateRepo  string
+}
+
+// updateIssueResponse extends the minimal update response with the optional
+// change summary and the outcome of the comment posted after closing or
+// reopening.
+type updateIssueResponse struct {
+	MinimalResponse
+	Changes      *issueChanges    `json:"changes,omitempty"`
+	Comment      *MinimalResponse `json:"comment,omitempty"`
+	CommentError string           `json:"comment_error,omitempty"`
+}
+
+// issueChanges summarizes the assignee and label changes made by an update.
+type issueChanges struct {
+	Assignees setChanges `json:"assignees"`
+	Labels    setChanges `json:"labels"`
+}
+
+// setChanges lists the values added to and removed from a set.
+type setChanges struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
+
+// diffStringSets returns the values in after but not before, and vice versa,
+// each in the order they appear in their source slice.
+func diffStringSets(before, after []string) setChanges {
+	changes := setChanges{Added: []string{}, Removed: []string{}}
+	inBefore := make(map[string]bool, len(before))
+	for _, v := range before {
+		inBefore[v] = true
+	}
+	inAfter := make(map[string]bool, len(after))
+	for _, v := range after {
+		inAfter[v] = true
+		if !inBefore[v] {
+			changes.Added = append(changes.Added, v)
+		}
+	}
+	for _, v := range before {
+		if !inAfter[v] {
+			changes.Removed = append(changes.Removed, v)
+		}
+	}
+	return changes
+}
+
+// diffIssueChanges compares the assignees and labels of an issue before and
+// after an update.
+func diffIssueChanges(before, after *github.Issue) *issueChanges {
+	assigneeLogins := func(issue *github.Issue) []string {
+		logins := make([]string, 0, len(issue.Assignees))
+		for _, a := range issue.Assignees {
+			logins = append(logins, a.GetLogin())
+		}
+		return logins
+	}
+	labelNames := func(issue *github.Issue) []string {
+		names := make([]string, 0, len(issue.Labels))
+		for _, l := range issue.Labels {
+			names = append(names, l.GetName())
+		}
+		return names
+	}
+	return &issueChanges{
+		Assignees: diffStringSets(assigneeLogins(before), assigneeLogins(after)),
+		Labels:    diffStringSets(labelNames(before), labelNames(after)),
+	}
 }
 
 func UpdateIssue(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner string, repo string, issueNumber int, title string, body string, assignees []string, labels []string, milestoneNum int, issueType string, issueFieldValues []*github.IssueRequestFieldValue, fieldIDsToDelete []int64, state string, stateReason string, duplicateOf int, opts ...UpdateIssueOptions) (*mcp.CallToolResult, error) {
@@ -2396,6 +3644,13 @@ func UpdateIssue(ctx context.Context, client *github.Client, gqlClient *githubv4
 	for _, opt := range opts {
 		updateOptions.AssigneesProvided = updateOptions.AssigneesProvided || opt.AssigneesProvided
 		updateOptions.LabelsProvided = updateOptions.LabelsProvided || opt.LabelsProvided
+		if opt.StateComment != "" {
+			updateOptions.StateComment = opt.StateComment
+		}
+		updateOptions.ReturnChanges = updateOptions.ReturnChanges || opt.ReturnChanges
+		if opt.DuplicateOwner != "" && opt.DuplicateRepo != "" {
+			updateOptions.DuplicateOwner, updateOptions.DuplicateRepo = opt.DuplicateOwner, opt.DuplicateRepo
+		}
 	}
 
 	// Create the issue request with only provided fields
@@ -2469,6 +3724,16 @@ func UpdateIssue(ctx context.Context, client *github.Client, gqlClient *githubv4
 		}
 	}
 
+	var previousIssue *github.Issue
+	if updateOptions.ReturnChanges {
+		issue, getResp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue before update", getResp, err), nil
+		}
+		_ = getResp.Body.Close()
+		previousIssue = issue
+	}
+
 	updatedIssue, resp, err := client.Issues.Edit(ctx, owner, repo, issueNumber, issueRequest)
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -2531,11 +3796,35 @@ func UpdateIssue(ctx context.Context, client *github.Client, gqlClient *githubv4
 			return utils.NewToolResultError("duplicate_of must be provided when state_reason is 'duplicate'"), nil
 		}
 
+		// A duplicate in the same repository is looked up together with the
+		// target issue; one in another repository needs its own query.
+		duplicate := issueReference{Owner: updateOptions.DuplicateOwner, Repo: updateOptions.DuplicateRepo, Number: duplicateOf}
+		crossRepoDuplicate := duplicateOf != 0 && duplicate.Owner != "" && !duplicate.matches(owner, repo, duplicateOf)
+		sameRepoDuplicate := duplicateOf
+		if crossRepoDuplicate {
+			sameRepoDuplicate = 0
+		}
+
 		// Get target issue ID (and duplicate issue ID if needed)
-		issueID, duplicateIssueID, err := fetchIssueIDs(ctx, gqlClient, owner, repo, issueNumber, duplicateOf)
+		issueID, duplicateIssueID, err := fetchIssueIDs(ctx, gqlClient, owner, repo, issueNumber, sameRepoDuplicate)
 		if err != nil {
+			if result := issueNotFoundResult(ctx, "Failed to find issues", err); result != nil {
+				return result, nil
+			}
 			return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to find issues", err), nil
 		}
+		var duplicateRef string
+		if crossRepoDuplicate {
+			duplicateRef = fmt.Sprintf("%s/%s#%d", duplicate.Owner, duplicate.Repo, duplicate.Number)
+			duplicateIssueID, err = fetchDuplicateIssueID(ctx, gqlClient, duplicate)
+			if err != nil {
+				message := fmt.Sprintf("Failed to find duplicate issue %s", duplicateRef)
+				if result := issueNotFoundResult(ctx, message, err); result != nil {
+					return utils.NewToolResultError(fmt.Sprintf("issue %s not found", duplicateRef)), nil
+				}
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, message, err), nil
+			}
+		}
 
 		switch state {
 		case "open":
@@ -2558,48 +3847,56 @@ func UpdateIssue(ctx context.Context, client *github.Client, gqlClient *githubv4
 				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to reopen issue", err), nil
 			}
 		case "closed":
-			// Use CloseIssue mutation for closing
-			var mutation struct {
-				CloseIssue struct {
-					Issue struct {
-						ID     githubv4.ID
-						Number githubv4.Int
-						URL    githubv4.String
-						State  githubv4.String
-					}
-				} `graphql:"closeIssue(input: $input)"`
-			}
-
-			stateReasonValue := getCloseStateReason(stateReason)
-			closeInput := CloseIssueInput{
-				IssueID:     issueID,
-				StateReason: &stateReasonValue,
-			}
-
-			// Set duplicate issue ID if needed
-			if stateReason == "duplicate" {
-				closeInput.DuplicateIssueID = &duplicateIssueID
-			}
-
-			err = gqlClient.Mutate(ctx, &mutation, closeInput, nil)
-			if err != nil {
+			if err := closeIssueByID(ctx, gqlClient, issueID, stateReason, duplicateIssueID); err != nil {
+				if crossRepoDuplicate {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("Failed to close issue as a duplicate of %s in another repository", duplicateRef), err), nil
+				}
 				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to close issue", err), nil
 			}
 		}
 	}
 
 	// Return minimal response with just essential information
-	minimalResponse := MinimalResponse{
-		ID:  fmt.Sprintf("%d", updatedIssue.GetID()),
-		URL: updatedIssue.GetHTMLURL(),
+	response := updateIssueResponse{
+		MinimalResponse: MinimalResponse{
+			ID:  fmt.Sprintf("%d", updatedIssue.GetID()),
+			URL: updatedIssue.GetHTMLURL(),
+		},
 	}
 
-	r, err := json.Marshal(minimalResponse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	if previousIssue != nil {
+		response.Changes = diffIssueChanges(previousIssue, updatedIssue)
 	}
 
-	return utils.NewToolResultText(string(r)), nil
+	if state != "" && updateOptions.StateComment != "" {
+		postStateComment(ctx, client, owner, repo, issueNumber, state, updateOptions.StateComment, &response)
+	}
+
+	return MarshalledTextResult(response), nil
+}
+
+// postStateComment posts comment on an issue whose state was just changed to
+// state. The state change has already landed, so a failed comment is recorded
+// in response alongside the success rather than returned as a tool error.
+func postStateComment(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, state, comment string, response *updateIssueResponse) {
+	posted, resp, err := client.Issues.CreateComment(ctx, owner, repo, issueNumber, &github.IssueComment{
+		Body: github.Ptr(comment),
+	})
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		action := "reopened"
+		if state == "closed" {
+			action = "closed"
+		}
+		response.CommentError = fmt.Sprintf("issue %s but failed to post comment: %v", action, err)
+		return
+	}
+	response.Comment = &MinimalResponse{
+		ID:  fmt.Sprintf("%d", posted.GetID()),
+		URL: posted.GetHTMLURL(),
+	}
 }
 
 // ListIssues creates a tool to list and filter repository issues. It exposes the
@@ -2640,7 +3937,7 @@ func ListIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 			},
 			"since": {
 				Type:        "string",
-				Description: "Filter by date (ISO 8601 timestamp)",
+				Description: "Filter by date (ISO 8601 timestamp, or relative such as '7 days ago', 'yesterday' or 'today')",
 			},
 			"field_filters": {
 				Type:        "array",
@@ -2660,6 +3957,23 @@ func ListIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 					Required: []string{"field_name", "value"},
 				},
 			},
+			"count_only": {
+				Type:        "boolean",
+				Description: "When true, returns only the totalCount of issues matching the filters, without fetching any issues. Pagination parameters are ignored.",
+			},
+			"sync_token": {
+				Type:        "string",
+				Description: "Opaque token for incremental sync. Pass an empty string to start a sync, then pass back the 'sync_token' from each response to fetch only issues updated since. Forces ordering by UPDATED_AT ASC and cannot be combined with 'since' or 'after'. The last issue of a completed sync may be returned again.",
+			},
+			"max_body_chars": {
+				Type:        "number",
+				Description: "Truncate each issue body to this many characters, appending a '" + bodyTruncationMarker + "' marker and setting 'body_truncated'. By default bodies are returned in full.",
+				Minimum:     jsonschema.Ptr(1.0),
+			},
+			"group_by_label": {
+				Type:        "boolean",
+				Description: "When true, adds a 'groups' map from label name to the numbers of the returned issues whose first matching label it is. When 'labels' is provided, only those labels are considered. Issues without a matching label are grouped under '(unlabeled)'.",
+			},
 		},
 		Required: []string{"owner", "repo"},
 	}
@@ -2674,7 +3988,8 @@ func ListIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 				Title:        t("TOOL_LIST_ISSUES_USER_TITLE", "List issues"),
 				ReadOnlyHint: true,
 			},
-			InputSchema: schema,
+			InputSchema:  schema,
+			OutputSchema: listIssuesOutputSchema(),
 		},
 		[]scopes.Scope{scopes.Repo},
 		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
@@ -2720,6 +4035,15 @@ func ListIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
+			// orderBy and direction only make sense together; reject a half-specified
+			// ordering rather than silently filling in the other half.
+			if orderBy != "" && direction == "" {
+				return utils.NewToolResultError("orderBy requires direction: pass direction (ASC or DESC) together with orderBy"), nil, nil
+			}
+			if direction != "" && orderBy == "" {
+				return utils.NewToolResultError("direction requires orderBy: pass orderBy (CREATED_AT, UPDATED_AT or COMMENTS) together with direction"), nil, nil
+			}
+
 			// Normalize and validate orderBy
 			orderBy = strings.ToUpper(orderBy)
 			switch orderBy {
@@ -2743,11 +4067,32 @@ func ListIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
+			syncTokenValue, syncMode, err := OptionalParamOK[string](args, "sync_token")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			var syncToken issueSyncToken
+			if syncMode {
+				if since != "" {
+					return utils.NewToolResultError("sync_token cannot be combined with since"), nil, nil
+				}
+				if _, afterProvided := args["after"]; afterProvided {
+					return utils.NewToolResultError("sync_token cannot be combined with after"), nil, nil
+				}
+				syncToken, err = decodeIssueSyncToken(syncTokenValue)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				since = syncToken.Since
+				orderBy = "UPDATED_AT"
+				direction = "ASC"
+			}
+
 			// There are two optional parameters: since and labels.
 			var sinceTime time.Time
 			var hasSince bool
 			if since != "" {
-				sinceTime, err = parseISOTimestamp(since)
+				sinceTime, err = parseFlexibleTimestamp(since)
 				if err != nil {
 					return utils.NewToolResultError(fmt.Sprintf("failed to list issues: %s", err.Error())), nil, nil
 				}
@@ -2760,6 +4105,24 @@ func ListIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
+			countOnly, err := OptionalParam[bool](args, "count_only")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			groupByLabel, err := OptionalParam[bool](args, "group_by_label")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			maxBodyChars, err := OptionalIntParam(args, "max_body_chars")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxBodyChars < 0 {
+				return utils.NewToolResultError("max_body_chars must be a positive number"), nil, nil
+			}
+
 			// Get pagination parameters and convert to GraphQL format
 			pagination, err := OptionalCursorPaginationParams(args)
 			if err != nil {
@@ -2780,12 +4143,23 @@ func ListIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return nil, nil, err
 			}
 
-			// Use default of 30 if pagination was not explicitly provided
+			// Use the configured default page size if pagination was not explicitly provided
 			if !paginationExplicit {
-				defaultFirst := int32(DefaultGraphQLPageSize)
+				defaultFirst := int32(defaultPageSize(deps, DefaultGraphQLPageSize, 100)) // #nosec G115 - bounded by 100
 				paginationParams.First = &defaultFirst
 			}
 
+			if syncToken.After != "" {
+				paginationParams.After = &syncToken.After
+			}
+
+			// A count only needs the connection's totalCount, so request no nodes at all.
+			if countOnly {
+				noNodes := int32(0)
+				paginationParams.First = &noNodes
+				paginationParams.After = nil
+			}
+
 			client, err := deps.GetGQLClient(ctx)
 			if err != nil {
 				return utils.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil, nil
@@ -2841,28 +4215,131 @@ func ListIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 			// input type unconditionally, so we always opt into the feature via header. This
 			// is a no-op once the flags are globally rolled out.
 			ctxWithFeatures := ghcontext.WithGraphQLFeatures(ctx, "issue_fields", "repo_issue_fields")
-			if err := client.Query(ctxWithFeatures, issueQuery, vars); err != nil {
-				return ghErrors.NewGitHubGraphQLErrorResponse(
-					ctx,
-					"failed to list issues",
-					err,
-				), nil, nil
-			}
+			queryErr := client.Query(ctxWithFeatures, issueQuery, vars)
 
 			var resp MinimalIssuesResponse
 			var isPrivate bool
 			if queryResult, ok := issueQuery.(IssueQueryResult); ok {
-				resp = convertToMinimalIssuesResponse(queryResult.GetIssueFragment())
+				resp = convertToMinimalIssuesResponse(queryResult.GetIssueFragment(), owner, repo)
 				isPrivate = queryResult.GetIsPrivate()
 			}
 
-			result := MarshalledTextResult(resp)
+			// GraphQL returns the data it could resolve alongside errors for
+			// the fields it could not, so a page of issues is still returned,
+			// marked partial, when only some fields failed.
+			if queryErr != nil {
+				if countOnly || len(resp.Issues) == 0 {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list issues", queryErr), nil, nil
+				}
+				resp.Partial = true
+				resp.PartialErrors = []string{partialDataError(ctx, "failed to list issues", queryErr)}
+			}
+
+			if countOnly {
+				result := StructuredTextResult(MinimalIssuesCountResponse{TotalCount: resp.TotalCount})
+				result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelListIssues(isPrivate))
+				return result, nil, nil
+			}
+
+			if groupByLabel {
+				resp.Groups = groupIssuesByLabel(resp.Issues, labels)
+			}
+
+			for i := range resp.Issues {
+				truncateIssueBody(&resp.Issues[i], maxBodyChars)
+			}
+
+			if syncMode {
+				resp.SyncToken = nextIssueSyncToken(syncToken, resp)
+			}
+
+			result := StructuredTextResult(resp)
 			result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelListIssues(isPrivate))
-			return result, nil, nil
+			return limitResponseSize(deps, result, "Request a smaller page with perPage, shorten bodies with max_body_chars, and fetch the rest with the after cursor."), nil, nil
 		})
 	return st
 }
 
+// listIssuesOutputSchema describes the structured content of list_issues. Only
+// totalCount is required because count_only responses carry nothing else.
+func listIssuesOutputSchema() *jsonschema.Schema {
+	schema := mustOutputSchemaFor[MinimalIssuesResponse]()
+	schema.Required = []string{"totalCount"}
+	return schema
+}
+
+// issueSyncToken is the decoded form of the opaque list_issues sync_token.
+type issueSyncToken struct {
+	Since string `json:"since,omitempty"`
+	After string `json:"after,omitempty"`
+}
+
+func encodeIssueSyncToken(token issueSyncToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeIssueSyncToken parses a sync_token. The empty token starts a new sync.
+func decodeIssueSyncToken(value string) (issueSyncToken, error) {
+	var token issueSyncToken
+	if value == "" {
+		return token, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return token, fmt.Errorf("invalid sync_token: %w", err)
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return token, fmt.Errorf("invalid sync_token: %w", err)
+	}
+	if token.Since != "" {
+		if _, err := time.Parse(time.RFC3339, token.Since); err != nil {
+			return token, fmt.Errorf("invalid sync_token: %w", err)
+		}
+	}
+	return token, nil
+}
+
+// nextIssueSyncToken returns the token for the call after resp. While pages
+// remain it continues the cursor under the same since; once the sync is
+// complete it restarts from the updatedAt of the last (most recently updated)
+// issue so the next call only sees later changes.
+func nextIssueSyncToken(previous issueSyncToken, resp MinimalIssuesResponse) string {
+	if resp.PageInfo.HasNextPage {
+		return encodeIssueSyncToken(issueSyncToken{Since: previous.Since, After: resp.PageInfo.EndCursor})
+	}
+	next := issueSyncToken{Since: previous.Since}
+	if n := len(resp.Issues); n > 0 && resp.Issues[n-1].UpdatedAt != "" {
+		next.Since = resp.Issues[n-1].UpdatedAt
+	}
+	return encodeIssueSyncToken(next)
+}
+
+// unlabeledGroup is the groups key for issues that carry no matching label.
+const unlabeledGroup = "(unlabeled)"
+
+// groupIssuesByLabel buckets issue numbers by each issue's first matching label.
+// When filter is non-empty only labels in it (compared case-insensitively) match.
+func groupIssuesByLabel(issues []MinimalIssue, filter []string) map[string][]int {
+	allowed := make(map[string]bool, len(filter))
+	for _, label := range filter {
+		allowed[strings.ToLower(label)] = true
+	}
+
+	groups := make(map[string][]int)
+	for _, issue := range issues {
+		key := unlabeledGroup
+		for _, label := range issue.Labels {
+			if len(allowed) == 0 || allowed[strings.ToLower(label.Name)] {
+				key = label.Name
+				break
+			}
+		}
+		groups[key] = append(groups[key], issue.Number)
+	}
+	return groups
+}
+
 // rawFieldFilter is the user-supplied {field_name, value} pair before type resolution.
 type rawFieldFilter struct {
 	Name  string
@@ -2973,6 +4450,286 @@ func resolveFieldFilters(rawFilters []rawFieldFilter, fields []IssueField) ([]Is
 	return out, nil
 }
 
+// MaxBulkLabelIssues caps how many issues a single add_labels_to_issues call may touch.
+const MaxBulkLabelIssues = 50
+
+// BulkLabelResult reports the outcome of adding labels to one issue.
+type BulkLabelResult struct {
+	IssueNumber int               `json:"issue_number"`
+	Status      utils.BatchStatus `json:"status"`
+	Labels      []string          `json:"labels,omitempty"`
+	Error       string            `json:"error,omitempty"`
+	Reason      string            `json:"reason,omitempty"`
+}
+
+// BatchStatus implements utils.BatchEntry.
+func (r BulkLabelResult) BatchStatus() utils.BatchStatus { return r.Status }
+
+// AddLabelsToIssuesBulk creates a tool that adds labels to several issues at once.
+func AddLabelsToIssuesBulk(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "add_labels_to_issues",
+			Description: t("TOOL_ADD_LABELS_TO_ISSUES_DESCRIPTION", fmt.Sprintf("Add labels to multiple issues in a GitHub repository, keeping each issue's existing labels. Up to %d issues per call. Failures are reported per issue without aborting the batch, and repeated issue numbers are skipped. The response counts the issues that succeeded, failed and were skipped.", MaxBulkLabelIssues)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_ADD_LABELS_TO_ISSUES_USER_TITLE", "Add labels to multiple issues"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_numbers": {
+						Type:        "array",
+						Description: "Numbers of the issues to label",
+						Items: &jsonschema.Schema{
+							Type: "number",
+						},
+						MinItems: jsonschema.Ptr(1),
+						MaxItems: jsonschema.Ptr(MaxBulkLabelIssues),
+					},
+					"labels": {
+						Type:        "array",
+						Description: "Label names to add to every issue",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+						MinItems: jsonschema.Ptr(1),
+					},
+				},
+				Required: []string{"owner", "repo", "issue_numbers", "labels"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumbers, err := RequiredPositiveIntArray(args, "issue_numbers")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if len(issueNumbers) > MaxBulkLabelIssues {
+				return utils.NewToolResultError(fmt.Sprintf("issue_numbers may contain at most %d issues, got %d", MaxBulkLabelIssues, len(issueNumbers))), nil, nil
+			}
+			labels, err := OptionalStringArrayParam(args, "labels")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if len(labels) == 0 {
+				return utils.NewToolResultError("missing required parameter: labels"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			batch := utils.NewBatchResult[BulkLabelResult](len(issueNumbers))
+			seen := make(map[int]bool, len(issueNumbers))
+			for i, issueNumber := range issueNumbers {
+				// Stop between issues once the client cancels the call and
+				// report what was done so far instead of labeling the rest.
+				if ctx.Err() != nil {
+					return MarshalledTextResult(struct {
+						*utils.BatchResult[BulkLabelResult]
+						Canceled     bool   `json:"canceled"`
+						NotAttempted []int  `json:"not_attempted"`
+						Summary      string `json:"summary"`
+					}{
+						BatchResult:  batch,
+						Canceled:     true,
+						NotAttempted: issueNumbers[i:],
+						Summary:      fmt.Sprintf("labeled %d of %d issues before cancellation", batch.Succeeded, len(issueNumbers)),
+					}), nil, nil
+				}
+				if seen[issueNumber] {
+					batch.Add(BulkLabelResult{IssueNumber: issueNumber, Status: utils.BatchSkipped, Reason: "duplicate of an earlier entry"})
+					continue
+				}
+				seen[issueNumber] = true
+
+				applied, err := addLabelsToIssue(ctx, client, owner, repo, issueNumber, labels)
+				if err != nil {
+					batch.Add(BulkLabelResult{IssueNumber: issueNumber, Status: utils.BatchFailed, Error: err.Error()})
+					continue
+				}
+				batch.Add(BulkLabelResult{IssueNumber: issueNumber, Status: utils.BatchSucceeded, Labels: applied})
+			}
+
+			return MarshalledTextResult(batch), nil, nil
+		})
+}
+
+// addLabelsToIssue adds labels to an issue without removing its existing ones
+// and returns the issue's resulting label names.
+func addLabelsToIssue(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, labels []string) ([]string, error) {
+	applied, resp, err := client.Issues.AddLabelsToIssue(ctx, owner, repo, issueNumber, labels)
+	if err != nil {
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to add labels to issue", resp, err)
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	names := make([]string, 0, len(applied))
+	for _, label := range applied {
+		names = append(names, label.GetName())
+	}
+	return names, nil
+}
+
+// ListStaleIssues creates a tool to list issues in a repository that have not
+// been updated for a number of days, oldest first.
+func ListStaleIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"days": {
+				Type:        "number",
+				Description: "Return issues not updated in at least this many days",
+				Minimum:     jsonschema.Ptr(1.0),
+			},
+			"state": {
+				Type:        "string",
+				Description: "Filter by state (default: open)",
+				Enum:        []any{"open", "closed"},
+			},
+		},
+		Required: []string{"owner", "repo", "days"},
+	}
+	WithPagination(schema)
+
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "list_stale_issues",
+			Description: t("TOOL_LIST_STALE_ISSUES_DESCRIPTION", "List issues in a GitHub repository that have not been updated in the given number of days, sorted by oldest update first."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_STALE_ISSUES_USER_TITLE", "List stale issues"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			days, err := RequiredInt(args, "days")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if days < 1 {
+				return utils.NewToolResultError("days must be at least 1"), nil, nil
+			}
+			state, err := OptionalParam[string](args, "state")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			state = strings.ToLower(state)
+			switch state {
+			case "":
+				state = "open"
+			case "open", "closed":
+			default:
+				return utils.NewToolResultError("state must be one of: open, closed"), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			now := time.Now().UTC()
+			cutoff := now.AddDate(0, 0, -days).Format(isoDateLayout)
+			query := fmt.Sprintf("repo:%s/%s is:issue is:%s updated:<=%s", owner, repo, state, cutoff)
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			result, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{
+				Sort:  "updated",
+				Order: "asc",
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list stale issues", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list stale issues", resp, body), nil, nil
+			}
+
+			staleIssues := make([]MinimalStaleIssue, 0, len(result.Issues))
+			for _, issue := range result.Issues {
+				if issue == nil {
+					continue
+				}
+				m := convertToMinimalIssue(issue)
+				stale := MinimalStaleIssue{
+					Number:    m.Number,
+					Title:     m.Title,
+					State:     m.State,
+					HTMLURL:   m.HTMLURL,
+					UpdatedAt: m.UpdatedAt,
+					Labels:    labelNames(m.Labels),
+					Assignees: m.Assignees,
+				}
+				if issue.UpdatedAt != nil {
+					stale.DaysSinceUpdate = int(now.Sub(issue.UpdatedAt.Time).Hours() / 24)
+				}
+				staleIssues = append(staleIssues, stale)
+			}
+
+			callResult := MarshalledTextResult(MinimalStaleIssuesResponse{
+				Cutoff:            cutoff,
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Issues:            staleIssues,
+			})
+			callResult = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, callResult, ifc.LabelListIssues)
+			return callResult, nil, nil
+		})
+}
+
+// isoDateLayout is the date-only ISO 8601 layout accepted by parseISOTimestamp.
+const isoDateLayout = "2006-01-02"
+
 // parseISOTimestamp parses an ISO 8601 timestamp string into a time.Time object.
 // Returns the parsed time or an error if parsing fails.
 // Example formats supported: "2023-01-15T14:30:00Z", "2023-01-15"
@@ -2988,7 +4745,7 @@ func parseISOTimestamp(timestamp string) (time.Time, error) {
 	}
 
 	// Try simple date format (YYYY-MM-DD)
-	t, err = time.Parse("2006-01-02", timestamp)
+	t, err = time.Parse(isoDateLayout, timestamp)
 	if err == nil {
 		return t, nil
 	}
@@ -2996,3 +4753,48 @@ func parseISOTimestamp(timestamp string) (time.Time, error) {
 	// Return error with supported formats
 	return time.Time{}, fmt.Errorf("invalid ISO 8601 timestamp: %s (supported formats: YYYY-MM-DDThh:mm:ssZ or YYYY-MM-DD)", timestamp)
 }
+
+// relativeTimestampPattern matches relative expressions such as "7 days ago".
+var relativeTimestampPattern = regexp.MustCompile(`^(\d+)\s+(hour|day|week)s?\s+ago$`)
+
+// parseFlexibleTimestamp parses a timestamp for "since"-style parameters. It
+// accepts everything parseISOTimestamp does, plus relative expressions
+// resolved against the current UTC time.
+func parseFlexibleTimestamp(timestamp string) (time.Time, error) {
+	return parseFlexibleTimestampAt(timestamp, time.Now().UTC())
+}
+
+// parseFlexibleTimestampAt is parseFlexibleTimestamp with an explicit
+// reference time. Supported relative forms are "N hours/days/weeks ago",
+// "yesterday" and "today" (the latter two resolve to midnight UTC).
+func parseFlexibleTimestampAt(timestamp string, now time.Time) (time.Time, error) {
+	if t, err := parseISOTimestamp(timestamp); err == nil {
+		return t, nil
+	}
+
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	expr := strings.ToLower(strings.TrimSpace(timestamp))
+	switch expr {
+	case "today":
+		return today, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	if m := relativeTimestampPattern.FindStringSubmatch(expr); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err == nil {
+			switch m[2] {
+			case "hour":
+				return now.Add(-time.Duration(n) * time.Hour), nil
+			case "day":
+				return now.AddDate(0, 0, -n), nil
+			case "week":
+				return now.AddDate(0, 0, -7*n), nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid timestamp: %s (supported formats: YYYY-MM-DDThh:mm:ssZ, YYYY-MM-DD, 'N hours/days/weeks ago', 'yesterday' or 'today')", timestamp)
+}
@@ -0,0 +1,330 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// DefaultExportMaxIssues is the number of issues export_issues returns
+	// when max_issues is not provided.
+	DefaultExportMaxIssues = 500
+	// MaxExportIssues is the upper bound accepted for max_issues.
+	MaxExportIssues = 5000
+
+	exportPageSize = 100
+)
+
+// exportCSVColumns is the fixed column order of CSV exports.
+var exportCSVColumns = []string{"number", "title", "state", "author", "labels", "comments", "created_at", "updated_at", "body"}
+
+// exportTruncation describes an export that stopped at max_issues.
+type exportTruncation struct {
+	Truncated  bool `json:"truncated"`
+	Exported   int  `json:"exported"`
+	TotalCount int  `json:"total_count"`
+}
+
+// ExportIssues creates a tool that serializes the issues of a repository to
+// CSV or JSONL, paging through the list internally.
+func ExportIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "export_issues",
+			Description: t("TOOL_EXPORT_ISSUES_DESCRIPTION", "Export issues in a GitHub repository as CSV or JSONL for offline analysis. Pages through all matching issues up to 'max_issues' and returns the serialized content as a single text item."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_EXPORT_ISSUES_USER_TITLE", "Export issues"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"format": {
+						Type:        "string",
+						Description: "Output format. CSV columns are: " + strings.Join(exportCSVColumns, ", ") + ". JSONL emits one issue object per line.",
+						Enum:        []any{"csv", "jsonl"},
+					},
+					"state": {
+						Type:        "string",
+						Description: "Filter by state, by default both open and closed issues are exported when not provided",
+						Enum:        []any{"OPEN", "CLOSED"},
+					},
+					"labels": {
+						Type:        "array",
+						Description: "Filter by labels",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"orderBy": {
+						Type:        "string",
+						Description: "Order issues by field. If provided, the 'direction' also needs to be provided.",
+						Enum:        []any{"CREATED_AT", "UPDATED_AT", "COMMENTS"},
+					},
+					"direction": {
+						Type:        "string",
+						Description: "Order direction. If provided, the 'orderBy' also needs to be provided.",
+						Enum:        []any{"ASC", "DESC"},
+					},
+					"since": {
+						Type:        "string",
+						Description: "Filter by date (ISO 8601 timestamp, or relative such as '7 days ago', 'yesterday' or 'today')",
+					},
+					"max_issues": {
+						Type:        "number",
+						Description: fmt.Sprintf("Maximum number of issues to export (default %d, max %d). When reached, a trailing metadata line notes the truncation.", DefaultExportMaxIssues, MaxExportIssues),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(float64(MaxExportIssues)),
+					},
+				},
+				Required: []string{"owner", "repo", "format"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			format, err := RequiredParam[string](args, "format")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			format = strings.ToLower(format)
+			if format != "csv" && format != "jsonl" {
+				return utils.NewToolResultError("format must be one of: csv, jsonl"), nil, nil
+			}
+
+			state, err := OptionalParam[string](args, "state")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			var states []githubv4.IssueState
+			switch strings.ToUpper(state) {
+			case "OPEN", "CLOSED":
+				states = []githubv4.IssueState{githubv4.IssueState(strings.ToUpper(state))}
+			default:
+				states = []githubv4.IssueState{githubv4.IssueStateOpen, githubv4.IssueStateClosed}
+			}
+
+			labels, err := OptionalStringArrayParam(args, "labels")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			orderBy, err := OptionalParam[string](args, "orderBy")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			orderBy = strings.ToUpper(orderBy)
+			switch orderBy {
+			case "CREATED_AT", "UPDATED_AT", "COMMENTS":
+			default:
+				orderBy = "CREATED_AT"
+			}
+
+			direction, err := OptionalParam[string](args, "direction")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			direction = strings.ToUpper(direction)
+			switch direction {
+			case "ASC", "DESC":
+			default:
+				direction = "DESC"
+			}
+
+			since, err := OptionalParam[string](args, "since")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			maxIssues, err := OptionalIntParamWithDefault(args, "max_issues", DefaultExportMaxIssues)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxIssues < 1 || maxIssues > MaxExportIssues {
+				return utils.NewToolResultError(fmt.Sprintf("max_issues must be between 1 and %d", MaxExportIssues)), nil, nil
+			}
+
+			vars := map[string]any{
+				"owner":            githubv4.String(owner),
+				"repo":             githubv4.String(repo),
+				"states":           states,
+				"orderBy":          githubv4.IssueOrderField(orderBy),
+				"direction":        githubv4.OrderDirection(direction),
+				"issueFieldValues": []IssueFieldValueFilter{},
+				"after":            (*githubv4.String)(nil),
+			}
+			hasLabels := len(labels) > 0
+			if hasLabels {
+				labelStrings := make([]githubv4.String, len(labels))
+				for i, label := range labels {
+					labelStrings[i] = githubv4.String(label)
+				}
+				vars["labels"] = labelStrings
+			}
+			hasSince := since != ""
+			if hasSince {
+				sinceTime, err := parseFlexibleTimestamp(since)
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("failed to export issues: %s", err.Error())), nil, nil
+				}
+				vars["since"] = githubv4.DateTime{Time: sinceTime}
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil, nil
+			}
+
+			// See ListIssues: the query always references the issue_fields-gated
+			// filter input type.
+			ctxWithFeatures := ghcontext.WithGraphQLFeatures(ctx, "issue_fields", "repo_issue_fields")
+
+			issues := make([]MinimalIssue, 0, min(maxIssues, exportPageSize))
+			var totalCount int
+			var isPrivate bool
+			for {
+				if err := ctx.Err(); err != nil {
+					return utils.NewToolResultErrorFromErr(fmt.Sprintf("export canceled after fetching %d issues", len(issues)), err), nil, nil
+				}
+				vars["first"] = githubv4.Int(min(exportPageSize, maxIssues-len(issues))) // #nosec G115 - bounded by exportPageSize
+				issueQuery := getIssueQueryType(hasLabels, hasSince)
+				if err := client.Query(ctxWithFeatures, issueQuery, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to export issues", err), nil, nil
+				}
+				queryResult, ok := issueQuery.(IssueQueryResult)
+				if !ok {
+					break
+				}
+				page := convertToMinimalIssuesResponse(queryResult.GetIssueFragment(), owner, repo)
+				isPrivate = queryResult.GetIsPrivate()
+				totalCount = page.TotalCount
+				issues = append(issues, page.Issues...)
+
+				if !page.PageInfo.HasNextPage || len(issues) >= maxIssues || len(page.Issues) == 0 {
+					break
+				}
+				vars["after"] = githubv4.String(page.PageInfo.EndCursor)
+			}
+
+			var truncation *exportTruncation
+			if totalCount > len(issues) {
+				truncation = &exportTruncation{Truncated: true, Exported: len(issues), TotalCount: totalCount}
+			}
+
+			var content string
+			if format == "csv" {
+				content, err = writeIssuesCSV(issues, truncation)
+			} else {
+				content, err = writeIssuesJSONL(issues, truncation)
+			}
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to serialize issues: %w", err)
+			}
+
+			result := utils.NewToolResultText(content)
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelListIssues(isPrivate))
+			return limitResponseSize(deps, result, "Lower max_issues or narrow the export with state, labels or since."), nil, nil
+		})
+}
+
+// writeIssuesCSV serializes issues with exportCSVColumns as the header. Cells
+// that could be read as spreadsheet formulas are neutralized. When the export
+// was truncated a trailing "#"-prefixed metadata line is appended.
+func writeIssuesCSV(issues []MinimalIssue, truncation *exportTruncation) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(exportCSVColumns); err != nil {
+		return "", err
+	}
+	for _, issue := range issues {
+		author := ""
+		if issue.User != nil {
+			author = issue.User.Login
+		}
+		record := []string{
+			strconv.Itoa(issue.Number),
+			issue.Title,
+			issue.State,
+			author,
+			strings.Join(labelNames(issue.Labels), ";"),
+			strconv.Itoa(issue.Comments),
+			issue.CreatedAt,
+			issue.UpdatedAt,
+			issue.Body,
+		}
+		for i, cell := range record {
+			record[i] = neutralizeCSVFormula(cell)
+		}
+		if err := w.Write(record); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	if truncation != nil {
+		fmt.Fprintf(&buf, "# truncated: exported %d of %d issues (max_issues reached)\n", truncation.Exported, truncation.TotalCount)
+	}
+	return buf.String(), nil
+}
+
+// neutralizeCSVFormula prefixes cell with a single quote when it starts with a
+// character that spreadsheet applications treat as the start of a formula, so
+// opening an export cannot evaluate user-controlled issue text.
+func neutralizeCSVFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
+
+// writeIssuesJSONL serializes issues one JSON object per line. When the export
+// was truncated a trailing {"_meta": {...}} line is appended.
+func writeIssuesJSONL(issues []MinimalIssue, truncation *exportTruncation) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, issue := range issues {
+		if err := enc.Encode(issue); err != nil {
+			return "", err
+		}
+	}
+	if truncation != nil {
+		if err := enc.Encode(map[string]any{"_meta": truncation}); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
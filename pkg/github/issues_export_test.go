@@ -0,0 +1,249 @@
+package github
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportIssueNode(number int, title, body string) map[string]any {
+	return map[string]any{
+		"number":     number,
+		"title":      title,
+		"body":       body,
+		"state":      "OPEN",
+		"databaseId": number,
+		"author":     map[string]any{"login": "octocat"},
+		"createdAt":  "2024-01-01T00:00:00Z",
+		"updatedAt":  "2024-01-02T00:00:00Z",
+		"labels": map[string]any{
+			"nodes": []map[string]any{
+				{"name": "bug", "id": "label-1", "description": ""},
+				{"name": "p1", "id": "label-2", "description": ""},
+			},
+		},
+		"comments":         map[string]any{"totalCount": 3},
+		"issueFieldValues": map[string]any{"nodes": []map[string]any{}},
+	}
+}
+
+func exportIssuesPage(nodes []map[string]any, hasNextPage bool, endCursor string, totalCount int) githubv4mock.GQLResponse {
+	return githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"issues": map[string]any{
+				"nodes": nodes,
+				"pageInfo": map[string]any{
+					"hasNextPage":     hasNextPage,
+					"hasPreviousPage": false,
+					"startCursor":     "",
+					"endCursor":       endCursor,
+				},
+				"totalCount": totalCount,
+			},
+			"isPrivate": false,
+		},
+	})
+}
+
+func Test_ExportIssues(t *testing.T) {
+	serverTool := ExportIssues(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "export_issues", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "format"})
+
+	issueFieldValuesSelection := "issueFieldValues(first: 25){nodes{__typename,... on IssueFieldDateValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldNumberValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},valueNumber: value},... on IssueFieldSingleSelectValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldTextValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value}}}"
+	exportIssuesQuery := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,id,url,author{login},createdAt,updatedAt,closedAt,labels(first: 100){nodes{name,id,description,color}},comments{totalCount}," + issueFieldValuesSelection + "},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
+	varsFor := func(first int, after any) map[string]any {
+		return map[string]any{
+			"owner":            "owner",
+			"repo":             "repo",
+			"states":           []any{"OPEN", "CLOSED"},
+			"orderBy":          "CREATED_AT",
+			"direction":        "DESC",
+			"first":            float64(first),
+			"after":            after,
+			"issueFieldValues": []any{},
+		}
+	}
+
+	t.Run("pages through all issues as jsonl", func(t *testing.T) {
+		// githubv4mock keys matchers by query string, so the two pages of the
+		// identical query are answered by a handler keyed on $after instead.
+		var seen []map[string]any
+		mux := http.NewServeMux()
+		mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Variables map[string]any `json:"variables"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			seen = append(seen, body.Variables)
+
+			page := exportIssuesPage([]map[string]any{exportIssueNode(1, "First", ""), exportIssueNode(2, "Second", "")}, true, "cursor-1", 3)
+			if body.Variables["after"] == "cursor-1" {
+				page = exportIssuesPage([]map[string]any{exportIssueNode(3, "Third", "")}, false, "cursor-2", 3)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(page)
+		})
+		gqlClient := githubv4.NewClient(&http.Client{Transport: recorderTransport{handler: mux}})
+		deps := BaseDeps{GQLClient: gqlClient}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"owner":      "owner",
+			"repo":       "repo",
+			"format":     "jsonl",
+			"max_issues": float64(100),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		require.Len(t, seen, 2)
+		assert.Equal(t, float64(100), seen[0]["first"])
+		assert.Nil(t, seen[0]["after"])
+		assert.Equal(t, float64(98), seen[1]["first"])
+		assert.Equal(t, "cursor-1", seen[1]["after"])
+
+		lines := strings.Split(strings.TrimSuffix(getTextResult(t, result).Text, "\n"), "\n")
+		require.Len(t, lines, 3)
+		for i, line := range lines {
+			var issue MinimalIssue
+			require.NoError(t, json.Unmarshal([]byte(line), &issue))
+			assert.Equal(t, i+1, issue.Number)
+		}
+	})
+
+	t.Run("notes truncation when max_issues is reached", func(t *testing.T) {
+		gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(exportIssuesQuery, varsFor(2, (*string)(nil)), exportIssuesPage(
+				[]map[string]any{exportIssueNode(1, "First", ""), exportIssueNode(2, "Second", "")}, true, "cursor-1", 5,
+			)),
+		))
+		deps := BaseDeps{GQLClient: gqlClient}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"owner":      "owner",
+			"repo":       "repo",
+			"format":     "csv",
+			"max_issues": float64(2),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		text := getTextResult(t, result).Text
+		assert.True(t, strings.HasSuffix(text, "# truncated: exported 2 of 5 issues (max_issues reached)\n"), text)
+	})
+
+	t.Run("refuses exports over the response size limit", func(t *testing.T) {
+		gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(exportIssuesQuery, varsFor(2, (*string)(nil)), exportIssuesPage(
+				[]map[string]any{exportIssueNode(1, "First", strings.Repeat("a", 600)), exportIssueNode(2, "Second", strings.Repeat("b", 600))}, false, "cursor-1", 2,
+			)),
+		))
+		deps := BaseDeps{GQLClient: gqlClient, MaxResponseBytes: 1_000}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"owner":      "owner",
+			"repo":       "repo",
+			"format":     "csv",
+			"max_issues": float64(2),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Lower max_issues")
+	})
+
+	t.Run("rejects unknown format", func(t *testing.T) {
+		deps := BaseDeps{}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":  "owner",
+			"repo":   "repo",
+			"format": "xlsx",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "format must be one of")
+	})
+}
+
+func Test_writeIssuesCSV(t *testing.T) {
+	issues := []MinimalIssue{
+		{
+			Number:    1,
+			Title:     `Crash when name contains "quotes", commas`,
+			Body:      "line one\nline two, with comma\r\n\"quoted\"",
+			State:     "OPEN",
+			User:      &MinimalUser{Login: "octocat"},
+			Labels:    []MinimalLabel{{Name: "bug"}, {Name: "needs, triage"}},
+			Comments:  2,
+			CreatedAt: "2024-01-01T00:00:00Z",
+			UpdatedAt: "2024-01-02T00:00:00Z",
+		},
+		{
+			Number: 2,
+			Title:  "=SUM(A1:A2)\n# not a comment",
+			State:  "CLOSED",
+		},
+	}
+
+	out, err := writeIssuesCSV(issues, nil)
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, exportCSVColumns, records[0])
+	assert.Equal(t, []string{
+		"1",
+		`Crash when name contains "quotes", commas`,
+		"OPEN",
+		"octocat",
+		"bug;needs, triage",
+		"2",
+		"2024-01-01T00:00:00Z",
+		"2024-01-02T00:00:00Z",
+		// encoding/csv normalizes \r\n inside quoted fields to \n on read.
+		"line one\nline two, with comma\n\"quoted\"",
+	}, records[1])
+	assert.Equal(t, []string{"2", "'=SUM(A1:A2)\n# not a comment", "CLOSED", "", "", "0", "", "", ""}, records[2])
+
+	for _, cell := range []string{"+1", "-2+3", "@SUM(A1)", "\tcmd", "\rcmd"} {
+		out, err := writeIssuesCSV([]MinimalIssue{{Number: 3, Title: cell, Body: cell}}, nil)
+		require.NoError(t, err)
+		records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, "'"+cell, records[1][1], "title %q", cell)
+		assert.Equal(t, "'"+cell, records[1][8], "body %q", cell)
+	}
+
+	truncated, err := writeIssuesCSV(issues[:1], &exportTruncation{Truncated: true, Exported: 1, TotalCount: 9})
+	require.NoError(t, err)
+	reader := csv.NewReader(strings.NewReader(truncated))
+	reader.Comment = '#'
+	records, err = reader.ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, records, 2, "metadata line should be skippable as a CSV comment")
+	assert.Contains(t, truncated, fmt.Sprintf("# truncated: exported %d of %d issues", 1, 9))
+}
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
 	"strings"
@@ -77,7 +78,7 @@ func issueUpdateTool(
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -112,6 +113,73 @@ func issueUpdateTool(
 	return st
 }
 
+// issueProjectTarget is the project create_issue adds a new issue to.
+type issueProjectTarget struct {
+	Owner     string
+	OwnerType string
+	Number    int
+}
+
+// optionalIssueProjectTarget reads create_issue's project parameters. It
+// returns nil if none are set.
+func optionalIssueProjectTarget(args map[string]any) (*issueProjectTarget, error) {
+	owner, err := OptionalParam[string](args, "project_owner")
+	if err != nil {
+		return nil, err
+	}
+	ownerType, err := OptionalParam[string](args, "project_owner_type")
+	if err != nil {
+		return nil, err
+	}
+	number, err := OptionalIntParam(args, "project_number")
+	if err != nil {
+		return nil, err
+	}
+	if owner == "" && number == 0 {
+		if ownerType != "" {
+			return nil, errors.New("project_owner_type requires project_owner and project_number")
+		}
+		return nil, nil
+	}
+	if owner == "" || number < 1 {
+		return nil, errors.New("project_owner and a positive project_number must be provided together")
+	}
+	return &issueProjectTarget{Owner: owner, OwnerType: ownerType, Number: number}, nil
+}
+
+// addIssueToProject adds the issue with the given database ID to a project
+// and returns the new project item's ID.
+func addIssueToProject(ctx context.Context, client *github.Client, issueID int64, project *issueProjectTarget) (int64, error) {
+	ownerType := project.OwnerType
+	if ownerType == "" {
+		var err error
+		ownerType, err = detectOwnerType(ctx, client, project.Owner, project.Number)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	opts := &github.AddProjectItemOptions{
+		Type: github.Ptr(github.ProjectV2ItemContentTypeIssue),
+		ID:   github.Ptr(issueID),
+	}
+	var item *github.ProjectV2Item
+	var resp *github.Response
+	var err error
+	if ownerType == "org" {
+		item, resp, err = client.Projects.AddOrganizationProjectItem(ctx, project.Owner, project.Number, opts)
+	} else {
+		item, resp, err = client.Projects.AddUserProjectItem(ctx, project.Owner, project.Number, opts)
+	}
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return 0, err
+	}
+	return item.GetID(), nil
+}
+
 // GranularCreateIssue creates a tool to create a new issue.
 func GranularCreateIssue(t translations.TranslationHelperFunc) inventory.ServerTool {
 	st := NewTool(
@@ -144,6 +212,27 @@ func GranularCreateIssue(t translations.TranslationHelperFunc) inventory.ServerT
 						Type:        "string",
 						Description: "Issue body content (optional)",
 					},
+					"dedupe_key": {
+						Type:        "string",
+						Description: "Idempotency key. If an open issue you created in the last 24 hours carries the same key, it is returned with 'deduplicated: true' instead of creating a new one.",
+					},
+					"auto_truncate": {
+						Type:        "boolean",
+						Description: autoTruncateDescription,
+					},
+					"project_owner": {
+						Type:        "string",
+						Description: "Owner (user or organization login) of a project to add the new issue to. Requires project_number.",
+					},
+					"project_owner_type": {
+						Type:        "string",
+						Description: "Owner type of the project. If not provided, will be automatically detected.",
+						Enum:        []any{"user", "org"},
+					},
+					"project_number": {
+						Type:        "number",
+						Description: "Number of a project to add the new issue to. Requires project_owner. If adding fails, the issue is still created and the response carries a warning.",
+					},
 				},
 				Required: []string{"owner", "repo", "title"},
 			},
@@ -163,12 +252,24 @@ func GranularCreateIssue(t translations.TranslationHelperFunc) inventory.ServerT
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 			body, _ := OptionalParam[string](args, "body")
-
-			issueReq := &github.IssueRequest{
-				Title: &title,
+			dedupeKey, err := OptionalParam[string](args, "dedupe_key")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			if body != "" {
-				issueReq.Body = &body
+			autoTruncate, err := OptionalParam[bool](args, "auto_truncate")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			reserved := 0
+			if dedupeKey != "" {
+				reserved = dedupeMarkerLength(dedupeKey)
+			}
+			if body, err = limitBodyLength("body", body, reserved, autoTruncate); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			project, err := optionalIssueProjectTarget(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
 			client, err := deps.GetClient(ctx)
@@ -176,16 +277,42 @@ func GranularCreateIssue(t translations.TranslationHelperFunc) inventory.ServerT
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
 
+			if dedupeKey != "" {
+				if result := dedupedIssueResult(ctx, client, owner, repo, dedupeKey); result != nil {
+					return result, nil, nil
+				}
+				body = appendDedupeMarker(body, dedupeKey)
+			}
+
+			issueReq := &github.IssueRequest{
+				Title: &title,
+			}
+			if body != "" {
+				issueReq.Body = &body
+			}
+
 			issue, resp, err := client.Issues.Create(ctx, owner, repo, issueReq)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create issue", resp, err), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(MinimalResponse{
+			response := MinimalResponse{
 				ID:  fmt.Sprintf("%d", issue.GetID()),
 				URL: issue.GetHTMLURL(),
-			})
+			}
+			// The issue exists now, so a failure to add it to the project is
+			// reported alongside it rather than as an error.
+			if project != nil {
+				itemID, err := addIssueToProject(ctx, client, issue.GetID(), project)
+				if err != nil {
+					response.Warning = fmt.Sprintf("issue was created but could not be added to project %s/%d: %v", project.Owner, project.Number, err)
+				} else {
+					response.ProjectItemID = itemID
+				}
+			}
+
+			r, err := json.Marshal(response)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
 			}
@@ -223,7 +350,8 @@ func GranularUpdateIssueBody(t translations.TranslationHelperFunc) inventory.Ser
 		"Update the body content of an existing issue.",
 		"Update Issue Body",
 		map[string]*jsonschema.Schema{
-			"body": {Type: "string", Description: "The new body content for the issue"},
+			"body":          {Type: "string", Description: "The new body content for the issue"},
+			"auto_truncate": {Type: "boolean", Description: autoTruncateDescription},
 		},
 		[]string{"body"},
 		func(args map[string]any) (*github.IssueRequest, error) {
@@ -231,6 +359,13 @@ func GranularUpdateIssueBody(t translations.TranslationHelperFunc) inventory.Ser
 			if err != nil {
 				return nil, err
 			}
+			autoTruncate, err := OptionalParam[bool](args, "auto_truncate")
+			if err != nil {
+				return nil, err
+			}
+			if body, err = limitBodyLength("body", body, 0, autoTruncate); err != nil {
+				return nil, err
+			}
 			return &github.IssueRequest{Body: &body}, nil
 		},
 	)
@@ -314,7 +449,7 @@ func GranularUpdateIssueAssignees(t translations.TranslationHelperFunc) inventor
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -532,7 +667,7 @@ func GranularUpdateIssueLabels(t translations.TranslationHelperFunc) inventory.S
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -742,7 +877,7 @@ func GranularUpdateIssueType(t translations.TranslationHelperFunc) inventory.Ser
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -894,6 +1029,10 @@ func GranularUpdateIssueState(t translations.TranslationHelperFunc) inventory.Se
 						Description: "The issue number of the canonical issue this issue duplicates. Only valid when state_reason is 'duplicate'. Required when is_suggestion is true and state_reason is 'duplicate'. The issue number is resolved to a database ID before being sent to the API.",
 						Minimum:     jsonschema.Ptr(1.0),
 					},
+					"comment": {
+						Type:        "string",
+						Description: "Comment to post after changing the issue state, e.g. to explain why it was closed or reopened. Cannot be combined with is_suggestion.",
+					},
 				},
 				Required: []string{"owner", "repo", "issue_number", "state"},
 			},
@@ -908,7 +1047,7 @@ func GranularUpdateIssueState(t translations.TranslationHelperFunc) inventory.Se
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -944,15 +1083,22 @@ func GranularUpdateIssueState(t translations.TranslationHelperFunc) inventory.Se
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			if stateReason != "" && state != "closed" {
-				return utils.NewToolResultError("state_reason can only be used when state is 'closed'"), nil, nil
-			}
-			if duplicateOf != 0 && stateReason != "duplicate" {
-				return utils.NewToolResultError("duplicate_of can only be used when state_reason is 'duplicate'"), nil, nil
+			if err := validateIssueStateChange(state, stateReason, duplicateOf); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 			if isSuggestion && stateReason == "duplicate" && duplicateOf == 0 {
 				return utils.NewToolResultError("duplicate_of is required when suggesting a close as duplicate"), nil, nil
 			}
+			comment, err := OptionalParam[string](args, "comment")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if comment != "" && isSuggestion {
+				return utils.NewToolResultError("comment cannot be combined with is_suggestion, since a suggestion does not change the state"), nil, nil
+			}
+			if comment, err = limitBodyLength("comment", comment, 0, false); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
@@ -1001,10 +1147,17 @@ func GranularUpdateIssueState(t translations.TranslationHelperFunc) inventory.Se
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(MinimalResponse{
-				ID:  fmt.Sprintf("%d", issue.GetID()),
-				URL: issue.GetHTMLURL(),
-			})
+			response := updateIssueResponse{
+				MinimalResponse: MinimalResponse{
+					ID:  fmt.Sprintf("%d", issue.GetID()),
+					URL: issue.GetHTMLURL(),
+				},
+			}
+			if comment != "" {
+				postStateComment(ctx, client, owner, repo, issueNumber, state, comment, &response)
+			}
+
+			r, err := json.Marshal(response)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
 			}
@@ -1066,7 +1219,7 @@ func GranularAddSubIssue(t translations.TranslationHelperFunc) inventory.ServerT
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -1136,7 +1289,7 @@ func GranularRemoveSubIssue(t translations.TranslationHelperFunc) inventory.Serv
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -1158,6 +1311,99 @@ func GranularRemoveSubIssue(t translations.TranslationHelperFunc) inventory.Serv
 	return st
 }
 
+// GranularMoveSubIssue creates a tool to move a sub-issue to a different parent.
+func GranularMoveSubIssue(t translations.TranslationHelperFunc) inventory.ServerTool {
+	st := NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "move_sub_issue",
+			Description: t("TOOL_MOVE_SUB_ISSUE_DESCRIPTION", "Move a sub-issue from its current parent issue to a different parent issue in the same repository. "+
+				"The move is a single call that replaces the parent, so the sub-issue is never left without one. "+
+				"Give the sub-issue by sub_issue_number to have its current parent checked and reported as old_parent."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_MOVE_SUB_ISSUE_USER_TITLE", "Move Sub-Issue"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(false),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"current_parent": {
+						Type:        "number",
+						Description: "The number of the issue the sub-issue currently belongs to. When given with sub_issue_number, the move is refused if the sub-issue has a different parent",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+					"new_parent": {
+						Type:        "number",
+						Description: "The number of the issue to move the sub-issue to",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+					"sub_issue_id": {
+						Type:        "number",
+						Description: "The ID of the sub-issue to move. ID is not the same as issue number. Provide either sub_issue_id or sub_issue_number",
+					},
+					"sub_issue_number": {
+						Type:        "number",
+						Description: "The number of the sub-issue to move. Provide either sub_issue_id or sub_issue_number",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+				},
+				Required: []string{"owner", "repo", "new_parent"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			currentParent, err := OptionalIntParam(args, "current_parent")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			newParent, err := RequiredPositiveInt(args, "new_parent")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			subIssueID, err := OptionalIntParam(args, "sub_issue_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			subIssueNumber, err := OptionalIntParam(args, "sub_issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub graphql client", err), nil, nil
+			}
+
+			result, err := MoveSubIssueToParent(ctx, client, gqlClient, owner, repo, subIssueID, subIssueNumber, currentParent, newParent)
+			return result, nil, err
+		},
+	)
+	st.FeatureFlagEnable = FeatureFlagIssuesGranular
+	return st
+}
+
 // GranularReprioritizeSubIssue creates a tool to reorder a sub-issue.
 func GranularReprioritizeSubIssue(t translations.TranslationHelperFunc) inventory.ServerTool {
 	st := NewTool(
@@ -1199,6 +1445,11 @@ func GranularReprioritizeSubIssue(t translations.TranslationHelperFunc) inventor
 						Type:        "number",
 						Description: "The ID of the sub-issue to place this before (either after_id OR before_id should be specified)",
 					},
+					"position": {
+						Type:        "string",
+						Description: "Move the sub-issue to the top or bottom of the list instead of specifying after_id/before_id",
+						Enum:        []any{"top", "bottom"},
+					},
 				},
 				Required: []string{"owner", "repo", "issue_number", "sub_issue_id"},
 			},
@@ -1213,7 +1464,7 @@ func GranularReprioritizeSubIssue(t translations.TranslationHelperFunc) inventor
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -1229,13 +1480,17 @@ func GranularReprioritizeSubIssue(t translations.TranslationHelperFunc) inventor
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			position, err := OptionalParam[string](args, "position")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
 
-			result, err := ReprioritizeSubIssue(ctx, client, owner, repo, issueNumber, subIssueID, afterID, beforeID)
+			result, err := ReprioritizeSubIssue(ctx, client, owner, repo, issueNumber, subIssueID, afterID, beforeID, position)
 			return result, nil, err
 		},
 	)
@@ -1357,7 +1612,7 @@ func GranularSetIssueFields(t translations.TranslationHelperFunc) inventory.Serv
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -1483,6 +1738,9 @@ func GranularSetIssueFields(t translations.TranslationHelperFunc) inventory.Serv
 			// Resolve issue node ID
 			issueID, _, err := fetchIssueIDs(ctx, gqlClient, owner, repo, issueNumber, 0)
 			if err != nil {
+				if result := issueNotFoundResult(ctx, "failed to get issue", err); result != nil {
+					return result, nil, nil
+				}
 				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get issue", err), nil, nil
 			}
 
@@ -1585,7 +1843,7 @@ func GranularAddIssueReaction(t translations.TranslationHelperFunc) inventory.Se
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			issueNumber, err := RequiredInt(args, "issue_number")
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
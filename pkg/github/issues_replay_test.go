@@ -0,0 +1,89 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The tests in this file replay API traffic from __fixtures__ rather than
+// hand-built mocks. They read this project's own repository and assert only
+// the shape of the results, so a fixture can be re-recorded without editing
+// the test:
+//
+//	RECORD_FIXTURES=true GITHUB_PERSONAL_ACCESS_TOKEN=... go test ./pkg/github -run Test_Replay
+//
+// Recording stores response bodies as they are; see internal/fixtures.
+const (
+	replayOwner       = "github"
+	replayRepo        = "github-mcp-server"
+	replayIssueNumber = 1
+)
+
+func Test_Replay_GetIssue(t *testing.T) {
+	deps := replayDeps(t, "issue_read_get")
+	serverTool := IssueRead(translations.NullTranslationHelper)
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"method":       "get",
+		"owner":        replayOwner,
+		"repo":         replayRepo,
+		"issue_number": float64(replayIssueNumber),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var issue MinimalIssue
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &issue))
+	assert.Equal(t, replayIssueNumber, issue.Number)
+	assert.NotEmpty(t, issue.Title)
+	assert.Contains(t, []string{"open", "closed"}, issue.State)
+	require.NotNil(t, issue.User)
+	assert.NotEmpty(t, issue.User.Login)
+	assert.Equal(t, fmt.Sprintf("https://github.com/%s/%s/issues/%d", replayOwner, replayRepo, replayIssueNumber), issue.HTMLURL)
+}
+
+func Test_Replay_ListIssues(t *testing.T) {
+	deps := replayDeps(t, "list_issues")
+	serverTool := ListIssues(translations.NullTranslationHelper)
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":   replayOwner,
+		"repo":    replayRepo,
+		"perPage": float64(2),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response struct {
+		Issues     []MinimalIssue `json:"issues"`
+		TotalCount int            `json:"totalCount"`
+		PageInfo   struct {
+			HasNextPage bool   `json:"hasNextPage"`
+			EndCursor   string `json:"endCursor"`
+		} `json:"pageInfo"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.NotEmpty(t, response.Issues)
+	assert.LessOrEqual(t, len(response.Issues), 2)
+	assert.GreaterOrEqual(t, response.TotalCount, len(response.Issues))
+	for _, issue := range response.Issues {
+		assert.Positive(t, issue.Number)
+		assert.NotEmpty(t, issue.Title)
+	}
+	for i := 1; i < len(response.Issues); i++ {
+		assert.NotEqual(t, response.Issues[i-1].Number, response.Issues[i].Number)
+	}
+	if response.PageInfo.HasNextPage {
+		assert.NotEmpty(t, response.PageInfo.EndCursor)
+	}
+}
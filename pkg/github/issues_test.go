@@ -18,6 +18,7 @@ import (
 	transportpkg "github.com/github/github-mcp-server/pkg/http/transport"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/shurcooL/githubv4"
@@ -168,15 +169,14 @@ func Test_GetIssue(t *testing.T) {
 	}
 
 	tests := []struct {
-		name               string
-		mockedClient       *http.Client
-		requestArgs        map[string]any
-		expectHandlerError bool
-		expectResultError  bool
-		expectedIssue      *github.Issue
-		expectedErrMsg     string
-		lockdownEnabled    bool
-		restPermission     string
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]any
+		expectResultError bool
+		expectedIssue     *github.Issue
+		expectedErrMsg    string
+		lockdownEnabled   bool
+		restPermission    string
 	}{
 		{
 			name: "successful issue retrieval",
@@ -202,8 +202,8 @@ func Test_GetIssue(t *testing.T) {
 				"repo":         "repo",
 				"issue_number": float64(999),
 			},
-			expectHandlerError: true,
-			expectedErrMsg:     "failed to get issue",
+			expectResultError: true,
+			expectedErrMsg:    "failed to get issue",
 		},
 		{
 			name: "lockdown enabled - private repository",
@@ -260,12 +260,6 @@ func Test_GetIssue(t *testing.T) {
 			request := createMCPRequest(tc.requestArgs)
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
-			if tc.expectHandlerError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
-				return
-			}
-
 			require.NoError(t, err)
 			require.NotNil(t, result)
 
@@ -290,6 +284,148 @@ func Test_GetIssue(t *testing.T) {
 	}
 }
 
+func Test_IssueNumberMustBePositive(t *testing.T) {
+	tools := []struct {
+		tool inventory.ServerTool
+		args map[string]any
+	}{
+		{IssueRead(translations.NullTranslationHelper), map[string]any{"method": "get"}},
+		{AddIssueComment(translations.NullTranslationHelper), map[string]any{"body": "hello"}},
+		{IssueWrite(translations.NullTranslationHelper), map[string]any{"method": "update", "title": "New title"}},
+	}
+
+	for _, tc := range tools {
+		for _, number := range []float64{0, -3} {
+			t.Run(fmt.Sprintf("%s %v", tc.tool.Tool.Name, number), func(t *testing.T) {
+				// Any request reaching the API fails the test.
+				deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}))}
+				handler := tc.tool.Handler(deps)
+
+				args := map[string]any{"owner": "owner", "repo": "repo", "issue_number": number}
+				for k, v := range tc.args {
+					args[k] = v
+				}
+				request := createMCPRequest(args)
+				result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				assert.Equal(t, fmt.Sprintf("parameter issue_number must be a positive integer, got %v", number), getErrorResult(t, result).Text)
+			})
+		}
+	}
+}
+
+func Test_IssueWriteTools_InsufficientPermissions(t *testing.T) {
+	forbidden := func(header, value, message string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set(header, value)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]string{"message": message})
+		}
+	}
+
+	t.Run("fine-grained token missing issues write", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PostReposIssuesCommentsByOwnerByRepoByIssueNumber: forbidden("X-Accepted-GitHub-Permissions", "issues=write; pull_requests=write", "Resource not accessible by personal access token"),
+		}))}
+		serverTool := AddIssueComment(translations.NullTranslationHelper)
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42), "body": "hello"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Equal(t, "failed to create comment: token lacks 'issues: write' or 'pull_requests: write' permission for owner/repo; grant it and retry", getErrorResult(t, result).Text)
+	})
+
+	t.Run("classic token missing repo scope", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PostReposIssuesByOwnerByRepo: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-OAuth-Scopes", "read:user")
+				forbidden("X-Accepted-OAuth-Scopes", "repo, public_repo", "Forbidden")(w, r)
+			},
+		}))}
+		serverTool := GranularCreateIssue(translations.NullTranslationHelper)
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "title": "Bug"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Equal(t, "failed to create issue: token lacks the 'repo' or 'public_repo' scope for owner/repo; grant it and retry (endpoint accepts: repo, public_repo; token has: read:user)", getErrorResult(t, result).Text)
+	})
+}
+
+func Test_TruncateIssueBody(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		maxChars      int
+		expectedBody  string
+		expectedTrunc bool
+	}{
+		{name: "no limit", body: "hello world", maxChars: 0, expectedBody: "hello world"},
+		{name: "under limit", body: "hello", maxChars: 10, expectedBody: "hello"},
+		{name: "exactly at limit", body: "hello", maxChars: 5, expectedBody: "hello"},
+		{name: "over limit", body: "hello world", maxChars: 5, expectedBody: "hello" + bodyTruncationMarker, expectedTrunc: true},
+		{name: "counts characters not bytes", body: "héllo wörld", maxChars: 4, expectedBody: "héll" + bodyTruncationMarker, expectedTrunc: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			issue := MinimalIssue{Body: tc.body}
+			truncateIssueBody(&issue, tc.maxChars)
+			assert.Equal(t, tc.expectedBody, issue.Body)
+			assert.Equal(t, tc.expectedTrunc, issue.BodyTruncated)
+		})
+	}
+}
+
+func Test_GetIssueMaxBodyChars(t *testing.T) {
+	serverTool := IssueRead(translations.NullTranslationHelper)
+	mockIssue := &github.Issue{
+		Number:  github.Ptr(42),
+		Title:   github.Ptr("Long issue"),
+		Body:    github.Ptr(strings.Repeat("a", 50)),
+		State:   github.Ptr("open"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/42"),
+		User:    &github.User{Login: github.Ptr("testuser")},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		maxBodyChars  any
+		expectedBody  string
+		expectedTrunc bool
+	}{
+		{name: "truncates long body", maxBodyChars: float64(10), expectedBody: strings.Repeat("a", 10) + bodyTruncationMarker, expectedTrunc: true},
+		{name: "default returns full body", maxBodyChars: nil, expectedBody: strings.Repeat("a", 50)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockIssue),
+			}))}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"method":         "get",
+				"owner":          "owner",
+				"repo":           "repo",
+				"issue_number":   float64(42),
+				"max_body_chars": tc.maxBodyChars,
+			})
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var returnedIssue MinimalIssue
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedIssue))
+			assert.Equal(t, tc.expectedBody, returnedIssue.Body)
+			assert.Equal(t, tc.expectedTrunc, returnedIssue.BodyTruncated)
+		})
+	}
+}
+
 func Test_IssueRead_IFC_InsidersMode(t *testing.T) {
 	t.Parallel()
 
@@ -761,6 +897,137 @@ func Test_GetIssue_HierarchyEnrichment_Lockdown(t *testing.T) {
 	assert.Equal(t, github.Ptr(true), returnedIssue.HasParent, "has_parent should still be true so agents can route to get_parent")
 }
 
+func Test_GetIssue_Rich(t *testing.T) {
+	vars := map[string]any{
+		"owner":       githubv4.String("owner"),
+		"repo":        githubv4.String("repo"),
+		"issueNumber": githubv4.Int(42),
+	}
+	issueNode := map[string]any{
+		"id":                "I_node_42",
+		"number":            42,
+		"title":             "Rich issue",
+		"body":              "Issue body",
+		"state":             "CLOSED",
+		"stateReason":       "COMPLETED",
+		"locked":            false,
+		"url":               "https://github.com/owner/repo/issues/42",
+		"authorAssociation": "MEMBER",
+		"createdAt":         "2024-01-01T00:00:00Z",
+		"updatedAt":         "2024-01-02T00:00:00Z",
+		"closedAt":          "2024-01-03T00:00:00Z",
+		"author":            map[string]any{"login": "author"},
+		"labels":            map[string]any{"nodes": []map[string]any{{"name": "bug", "color": "d73a4a"}}},
+		"assignees":         map[string]any{"nodes": []map[string]any{{"login": "alice"}, {"login": "bob"}}},
+		"milestone":         map[string]any{"title": "v1.0"},
+		"issueType":         map[string]any{"name": "Bug"},
+		"comments":          map[string]any{"totalCount": 3},
+		"reactionGroups": []map[string]any{
+			{"content": "THUMBS_UP", "reactors": map[string]any{"totalCount": 5}},
+			{"content": "HEART", "reactors": map[string]any{"totalCount": 2}},
+			{"content": "EYES", "reactors": map[string]any{"totalCount": 0}},
+		},
+		"closedByPullRequestsReferences": map[string]any{
+			"nodes": []map[string]any{
+				{
+					"number":     7,
+					"title":      "Fix the bug",
+					"state":      "MERGED",
+					"isDraft":    false,
+					"url":        "https://github.com/owner/repo/pull/7",
+					"author":     map[string]any{"login": "author"},
+					"repository": map[string]any{"nameWithOwner": "owner/repo"},
+				},
+			},
+		},
+		"issueFieldValues": map[string]any{"nodes": []map[string]any{}},
+		"parent":           nil,
+		"subIssuesSummary": map[string]any{"total": 4, "completed": 3, "percentCompleted": 75},
+	}
+
+	tests := []struct {
+		name           string
+		response       githubv4mock.GQLResponse
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "rich issue fetched via GraphQL",
+			response: githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{"issue": issueNode},
+			}),
+		},
+		{
+			name:           "GraphQL failure",
+			response:       githubv4mock.ErrorResponse("Could not resolve to an Issue with the number of 42."),
+			expectError:    true,
+			expectedErrMsg: "failed to get issue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher := githubv4mock.NewQueryMatcher(issueRichQuery{}, vars, tc.response)
+			gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))
+
+			deps := BaseDeps{
+				Client:          mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+				GQLClient:       gqlClient,
+				RepoAccessCache: stubRepoAccessCache(nil, 15*time.Minute),
+				Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": false}),
+			}
+			serverTool := IssueRead(translations.NullTranslationHelper)
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"method":       "get",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"rich":         true,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, "expected result to not be an error")
+
+			var returnedIssue MinimalIssue
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedIssue))
+
+			assert.Equal(t, 42, returnedIssue.Number)
+			assert.Equal(t, "Rich issue", returnedIssue.Title)
+			assert.Equal(t, "closed", returnedIssue.State)
+			assert.Equal(t, "completed", returnedIssue.StateReason)
+			assert.Equal(t, "author", returnedIssue.User.Login)
+			assert.Equal(t, []MinimalLabel{{Name: "bug", Color: "d73a4a"}}, returnedIssue.Labels)
+			assert.Equal(t, []string{"alice", "bob"}, returnedIssue.Assignees)
+			assert.Equal(t, "v1.0", returnedIssue.Milestone)
+			assert.Equal(t, "Bug", returnedIssue.IssueType)
+			assert.Equal(t, 3, returnedIssue.Comments)
+
+			require.NotNil(t, returnedIssue.Reactions)
+			assert.Equal(t, 7, returnedIssue.Reactions.TotalCount)
+			assert.Equal(t, 5, returnedIssue.Reactions.PlusOne)
+			assert.Equal(t, 2, returnedIssue.Reactions.Heart)
+
+			require.Len(t, returnedIssue.LinkedPullRequests, 1)
+			assert.Equal(t, 7, returnedIssue.LinkedPullRequests[0].Number)
+			assert.Equal(t, "MERGED", returnedIssue.LinkedPullRequests[0].State)
+			assert.Equal(t, "owner/repo", returnedIssue.LinkedPullRequests[0].Repository)
+
+			assert.Equal(t, github.Ptr(false), returnedIssue.HasParent)
+			assert.Equal(t, github.Ptr(true), returnedIssue.HasChildren)
+			require.NotNil(t, returnedIssue.SubIssuesSummary)
+			assert.Equal(t, 75, returnedIssue.SubIssuesSummary.PercentCompleted)
+		})
+	}
+}
+
 func Test_GetIssue_HierarchyEnrichment_QueryFailureReturnsBaseIssue(t *testing.T) {
 	mockIssue := &github.Issue{
 		Number:  github.Ptr(2990),
@@ -1134,6 +1401,7 @@ func Test_SearchIssues(t *testing.T) {
 
 			require.NoError(t, err)
 			require.False(t, result.IsError, "expected result to not be an error")
+			requireStructuredContentMatchesSchema(t, serverTool.Tool, result)
 
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
@@ -1415,6 +1683,85 @@ func Test_SearchIssues_FieldValuesEnrichment(t *testing.T) {
 	assert.Empty(t, response.Items[1].FieldValues)
 }
 
+func Test_SearchIssues_BodyPreview(t *testing.T) {
+	serverTool := SearchIssues(translations.NullTranslationHelper)
+
+	mockSearchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(2),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{
+				Number: github.Ptr(42),
+				Title:  github.Ptr("Long report"),
+				State:  github.Ptr("open"),
+				Body:   github.Ptr("Steps: héllo wörld, then restart the server"),
+			},
+			{
+				Number: github.Ptr(43),
+				Title:  github.Ptr("Short report"),
+				State:  github.Ptr("open"),
+				Body:   github.Ptr("Crash"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		previewChars   any
+		expectedBodies []string
+		expectedTrunc  []bool
+		expectedErrMsg string
+	}{
+		{
+			name:           "bodies are returned in full by default",
+			expectedBodies: []string{"Steps: héllo wörld, then restart the server", "Crash"},
+			expectedTrunc:  []bool{false, false},
+		},
+		{
+			name:           "long bodies are cut to the preview length",
+			previewChars:   float64(12),
+			expectedBodies: []string{"Steps: héllo" + bodyTruncationMarker, "Crash"},
+			expectedTrunc:  []bool{true, false},
+		},
+		{
+			name:           "negative preview length",
+			previewChars:   float64(-1),
+			expectedErrMsg: "body_preview_chars must be a positive number",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchIssues: mockResponse(t, http.StatusOK, mockSearchResult),
+			}))}
+			handler := serverTool.Handler(deps)
+
+			args := map[string]any{"query": "repo:owner/repo crash"}
+			if tc.previewChars != nil {
+				args["body_preview_chars"] = tc.previewChars
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response SearchIssuesResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			require.Len(t, response.Items, 2)
+			for i, item := range response.Items {
+				assert.Equal(t, tc.expectedBodies[i], item.GetBody())
+				assert.Equal(t, tc.expectedTrunc[i], item.BodyTruncated)
+			}
+		})
+	}
+}
+
 func Test_CreateIssue(t *testing.T) {
 	// Verify tool definition once
 	serverTool := IssueWrite(translations.NullTranslationHelper)
@@ -1511,6 +1858,36 @@ func Test_CreateIssue(t *testing.T) {
 				State:   github.Ptr("open"),
 			},
 		},
+		{
+			name: "null optional fields are treated as absent on create",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposIssuesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"title":     "Null Fields",
+					"body":      "",
+					"labels":    []any{},
+					"assignees": []any{},
+				}).andThen(
+					mockResponse(t, http.StatusCreated, &github.Issue{
+						Number:  github.Ptr(126),
+						HTMLURL: github.Ptr("https://github.com/owner/repo/issues/126"),
+					}),
+				),
+			}),
+			requestArgs: map[string]any{
+				"method":    "create",
+				"owner":     "owner",
+				"repo":      "repo",
+				"title":     "Null Fields",
+				"body":      nil,
+				"labels":    nil,
+				"milestone": nil,
+			},
+			expectError: false,
+			expectedIssue: &github.Issue{
+				Number:  github.Ptr(126),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/126"),
+			},
+		},
 		{
 			name: "successful issue creation with issue fields reconciled by names",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -1664,6 +2041,136 @@ func Test_CreateIssue(t *testing.T) {
 	}
 }
 
+func Test_CreateIssue_DedupeKey(t *testing.T) {
+	existingIssue := &github.Issue{
+		ID:        github.Ptr(int64(42)),
+		Number:    github.Ptr(7),
+		Body:      github.Ptr("Already filed\n\n<!-- mcp-dedupe:run-1 -->"),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/7"),
+		CreatedAt: &github.Timestamp{Time: time.Now().Add(-time.Hour)},
+	}
+	// The marker for "run-1" escapes the hyphen, so the body above does not
+	// carry it and must not be treated as a duplicate.
+	escapedIssue := &github.Issue{
+		ID:        github.Ptr(int64(43)),
+		Number:    github.Ptr(8),
+		Body:      github.Ptr("Already filed\n\n<!-- mcp-dedupe:run%2D1 -->"),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/8"),
+		CreatedAt: &github.Timestamp{Time: time.Now().Add(-2 * time.Hour)},
+	}
+	// Listed because it was updated recently, but created before the window.
+	staleIssue := &github.Issue{
+		ID:        github.Ptr(int64(41)),
+		Number:    github.Ptr(6),
+		Body:      github.Ptr("Filed long ago\n\n<!-- mcp-dedupe:run%2D1 -->"),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/6"),
+		CreatedAt: &github.Timestamp{Time: time.Now().Add(-2 * dedupeWindow)},
+	}
+
+	tests := []struct {
+		name            string
+		listedIssues    []*github.Issue
+		expectCreate    bool
+		expectedURL     string
+		expectedDeduped bool
+	}{
+		{
+			name:            "existing issue with marker is returned",
+			listedIssues:    []*github.Issue{existingIssue, escapedIssue},
+			expectedURL:     "https://github.com/owner/repo/issues/8",
+			expectedDeduped: true,
+		},
+		{
+			name:         "issue created before the window is ignored",
+			listedIssues: []*github.Issue{existingIssue, staleIssue},
+			expectCreate: true,
+			expectedURL:  "https://github.com/owner/repo/issues/9",
+		},
+		{
+			name:         "no matching marker creates a new issue",
+			listedIssues: []*github.Issue{existingIssue},
+			expectCreate: true,
+			expectedURL:  "https://github.com/owner/repo/issues/9",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			created := false
+			mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUser: mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("octocat")}),
+				GetReposIssuesByOwnerByRepo: func(w http.ResponseWriter, r *http.Request) {
+					q := r.URL.Query()
+					assert.Equal(t, "octocat", q.Get("creator"))
+					assert.Equal(t, "open", q.Get("state"))
+					assert.Equal(t, "created", q.Get("sort"))
+					assert.Equal(t, "desc", q.Get("direction"))
+					assert.NotEmpty(t, q.Get("since"))
+					mockResponse(t, http.StatusOK, tc.listedIssues)(w, r)
+				},
+				PostReposIssuesByOwnerByRepo: func(w http.ResponseWriter, r *http.Request) {
+					created = true
+					expectRequestBody(t, map[string]any{
+						"title":     "Flaky test",
+						"body":      "Seen twice\n\n<!-- mcp-dedupe:run%2D1 -->",
+						"labels":    []any{},
+						"assignees": []any{},
+					}).andThen(mockResponse(t, http.StatusCreated, &github.Issue{
+						ID:      github.Ptr(int64(44)),
+						Number:  github.Ptr(9),
+						HTMLURL: github.Ptr("https://github.com/owner/repo/issues/9"),
+					}))(w, r)
+				},
+			})
+
+			serverTool := IssueWrite(translations.NullTranslationHelper)
+			deps := BaseDeps{
+				Client:    mustNewGHClient(t, mockedClient),
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient()),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"method":     "create",
+				"owner":      "owner",
+				"repo":       "repo",
+				"title":      "Flaky test",
+				"body":       "Seen twice",
+				"dedupe_key": "run-1",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response MinimalResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedURL, response.URL)
+			assert.Equal(t, tc.expectedDeduped, response.Deduplicated)
+			assert.Equal(t, tc.expectCreate, created)
+		})
+	}
+}
+
+func Test_DedupeMarker(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{key: "release:v1.2", expected: "<!-- mcp-dedupe:release:v1.2 -->"},
+		{key: "a b", expected: "<!-- mcp-dedupe:a%20b -->"},
+		{key: "x --> <script>", expected: "<!-- mcp-dedupe:x%20%2D%2D%3E%20%3Cscript%3E -->"},
+		{key: "é", expected: "<!-- mcp-dedupe:%C3%A9 -->"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.key, func(t *testing.T) {
+			assert.Equal(t, tc.expected, dedupeMarker(tc.key))
+		})
+	}
+
+	assert.Equal(t, "<!-- mcp-dedupe:k -->", appendDedupeMarker("", "k"))
+	assert.Equal(t, "body\n\n<!-- mcp-dedupe:k -->", appendDedupeMarker("body", "k"))
+}
+
 // Test_IssueWrite_MCPAppsFeature_UIGate verifies the MCP Apps feature UI gate
 // behavior: UI clients get a form message, non-UI clients execute directly.
 func Test_IssueWrite_MCPAppsFeature_UIGate(t *testing.T) {
@@ -1854,11 +2361,15 @@ func Test_issueWriteSchemaClassification(t *testing.T) {
 	t.Parallel()
 
 	// Schema properties the MCP App form cannot represent — their presence
-	// must trigger the safety-net bypass via hasNonFormParams. The
-	// form currently collects every schema property, so this allowlist is
-	// empty; add a property here only if it is added to the schema without
+	// must trigger the safety-net bypass via hasNonFormParams. Add a
+	// property here only if it is added to the schema without
 	// corresponding form support.
-	knownNonForm := map[string]struct{}{}
+	knownNonForm := map[string]struct{}{
+		"auto_truncate":  {},
+		"comment":        {},
+		"dedupe_key":     {},
+		"return_changes": {},
+	}
 
 	cases := []struct {
 		name string
@@ -1921,7 +2432,7 @@ func Test_ListIssues(t *testing.T) {
 			"author":     map[string]any{"login": "user1"},
 			"labels": map[string]any{
 				"nodes": []map[string]any{
-					{"name": "bug", "id": "label1", "description": "Bug label"},
+					{"name": "bug", "id": "label1", "description": "Bug label", "color": "d73a4a"},
 				},
 			},
 			"comments": map[string]any{
@@ -1948,7 +2459,7 @@ func Test_ListIssues(t *testing.T) {
 			"author":     map[string]any{"login": "user2"},
 			"labels": map[string]any{
 				"nodes": []map[string]any{
-					{"name": "enhancement", "id": "label2", "description": "Enhancement label"},
+					{"name": "enhancement", "id": "label2", "description": "Enhancement label", "color": "a2eeef"},
 				},
 			},
 			"comments": map[string]any{
@@ -1986,6 +2497,7 @@ func Test_ListIssues(t *testing.T) {
 			"databaseId": 1003,
 			"createdAt":  "2023-03-01T00:00:00Z",
 			"updatedAt":  "2023-03-01T00:00:00Z",
+			"closedAt":   "2023-03-05T12:00:00Z",
 			"author":     map[string]any{"login": "user3"},
 			"labels": map[string]any{
 				"nodes": []map[string]any{},
@@ -2098,26 +2610,67 @@ func Test_ListIssues(t *testing.T) {
 		"issueFieldValues": []any{},
 	}
 
-	varsRepoNotFound := map[string]any{
+	varsConfiguredPageSize := map[string]any{
 		"owner":            "owner",
-		"repo":             "nonexistent-repo",
+		"repo":             "repo",
 		"states":           []any{"OPEN", "CLOSED"},
 		"orderBy":          "CREATED_AT",
 		"direction":        "DESC",
-		"first":            float64(30),
+		"first":            float64(10),
 		"after":            (*string)(nil),
 		"issueFieldValues": []any{},
 	}
 
-	tests := []struct {
-		name          string
-		reqParams     map[string]any
-		expectError   bool
-		errContains   string
-		expectedCount int
-	}{
-		{
-			name: "list all issues",
+	varsCountOnly := map[string]any{
+		"owner":            "owner",
+		"repo":             "repo",
+		"states":           []any{"OPEN"},
+		"orderBy":          "CREATED_AT",
+		"direction":        "DESC",
+		"first":            float64(0),
+		"after":            (*string)(nil),
+		"issueFieldValues": []any{},
+	}
+
+	mockResponseCountOnly := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"issues": map[string]any{
+				"nodes": []map[string]any{},
+				"pageInfo": map[string]any{
+					"hasNextPage":     true,
+					"hasPreviousPage": false,
+					"startCursor":     "",
+					"endCursor":       "",
+				},
+				"totalCount": 42,
+			},
+			"isPrivate": false,
+		},
+	})
+
+	varsRepoNotFound := map[string]any{
+		"owner":            "owner",
+		"repo":             "nonexistent-repo",
+		"states":           []any{"OPEN", "CLOSED"},
+		"orderBy":          "CREATED_AT",
+		"direction":        "DESC",
+		"first":            float64(30),
+		"after":            (*string)(nil),
+		"issueFieldValues": []any{},
+	}
+
+	tests := []struct {
+		name            string
+		reqParams       map[string]any
+		defaultPageSize int
+		expectError     bool
+		errContains     string
+		expectedCount   int
+		countOnly       bool
+		expectedGroups  map[string][]int
+	}{
+		{
+			name: "list all issues",
 			reqParams: map[string]any{
 				"owner": "owner",
 				"repo":  "repo",
@@ -2165,6 +2718,60 @@ func Test_ListIssues(t *testing.T) {
 			expectError:   false,
 			expectedCount: 2,
 		},
+		{
+			name: "configured default page size is used when perPage is omitted",
+			reqParams: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			defaultPageSize: 10,
+			expectError:     false,
+			expectedCount:   2,
+		},
+		{
+			name: "count only returns just the total count",
+			reqParams: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"state":      "OPEN",
+				"count_only": true,
+				"perPage":    float64(50),
+			},
+			expectError:   false,
+			expectedCount: 42,
+			countOnly:     true,
+		},
+		{
+			name: "group by label buckets issues by first label",
+			reqParams: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"group_by_label": true,
+			},
+			expectError:    false,
+			expectedCount:  2,
+			expectedGroups: map[string][]int{"bug": {123}, "enhancement": {456}},
+		},
+		{
+			name: "orderBy without direction is rejected",
+			reqParams: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"orderBy": "UPDATED_AT",
+			},
+			expectError: true,
+			errContains: "orderBy requires direction",
+		},
+		{
+			name: "direction without orderBy is rejected",
+			reqParams: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"direction": "ASC",
+			},
+			expectError: true,
+			errContains: "direction requires orderBy",
+		},
 		{
 			name: "repository not found error",
 			reqParams: map[string]any{
@@ -2178,8 +2785,8 @@ func Test_ListIssues(t *testing.T) {
 
 	// Define the actual query strings that match the implementation
 	issueFieldValuesSelection := "issueFieldValues(first: 25){nodes{__typename,... on IssueFieldDateValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldNumberValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},valueNumber: value},... on IssueFieldSingleSelectValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldTextValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value}}}"
-	qBasicNoLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}," + issueFieldValuesSelection + "},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
-	qWithLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$labels:[String!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, labels: $labels, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}," + issueFieldValuesSelection + "},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
+	qBasicNoLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,id,url,author{login},createdAt,updatedAt,closedAt,labels(first: 100){nodes{name,id,description,color}},comments{totalCount}," + issueFieldValuesSelection + "},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
+	qWithLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$labels:[String!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, labels: $labels, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,id,url,author{login},createdAt,updatedAt,closedAt,labels(first: 100){nodes{name,id,description,color}},comments{totalCount}," + issueFieldValuesSelection + "},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2201,6 +2808,15 @@ func Test_ListIssues(t *testing.T) {
 			case "filter by labels":
 				matcher := githubv4mock.NewQueryMatcher(qWithLabels, varsWithLabels, mockResponseListAll)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "configured default page size is used when perPage is omitted":
+				matcher := githubv4mock.NewQueryMatcher(qBasicNoLabels, varsConfiguredPageSize, mockResponseListAll)
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "group by label buckets issues by first label":
+				matcher := githubv4mock.NewQueryMatcher(qBasicNoLabels, varsListAll, mockResponseListAll)
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "count only returns just the total count":
+				matcher := githubv4mock.NewQueryMatcher(qBasicNoLabels, varsCountOnly, mockResponseCountOnly)
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
 			case "repository not found error":
 				matcher := githubv4mock.NewQueryMatcher(qBasicNoLabels, varsRepoNotFound, mockErrorRepoNotFound)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
@@ -2208,7 +2824,8 @@ func Test_ListIssues(t *testing.T) {
 
 			gqlClient := githubv4.NewClient(httpClient)
 			deps := BaseDeps{
-				GQLClient: gqlClient,
+				GQLClient:       gqlClient,
+				DefaultPageSize: tc.defaultPageSize,
 			}
 			handler := serverTool.Handler(deps)
 
@@ -2222,6 +2839,14 @@ func Test_ListIssues(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			requireStructuredContentMatchesSchema(t, serverTool.Tool, res)
+
+			if tc.countOnly {
+				var countResponse map[string]any
+				require.NoError(t, json.Unmarshal([]byte(text), &countResponse))
+				assert.Equal(t, map[string]any{"totalCount": float64(tc.expectedCount)}, countResponse)
+				return
+			}
 
 			// Parse the structured response with pagination info
 			var response MinimalIssuesResponse
@@ -2229,6 +2854,7 @@ func Test_ListIssues(t *testing.T) {
 			require.NoError(t, err)
 
 			assert.Len(t, response.Issues, tc.expectedCount, "Expected %d issues, got %d", tc.expectedCount, len(response.Issues))
+			assert.Equal(t, tc.expectedGroups, response.Groups)
 
 			// Verify pagination metadata
 			assert.Equal(t, tc.expectedCount, response.TotalCount)
@@ -2245,10 +2871,18 @@ func Test_ListIssues(t *testing.T) {
 				assert.NotNil(t, issue.User, "Issue should have user")
 				assert.NotEmpty(t, issue.User.Login, "Issue user should have login")
 				assert.Empty(t, issue.HTMLURL, "html_url should be empty (not populated by GraphQL fragment)")
+				if issue.State == "CLOSED" {
+					assert.Equal(t, "2023-03-05T12:00:00Z", issue.ClosedAt)
+				} else {
+					assert.Empty(t, issue.ClosedAt, "open issues have no closed_at")
+				}
 
-				// Labels should be flattened to name strings
-				for _, label := range issue.Labels {
-					assert.NotEmpty(t, label, "Label should be a non-empty string")
+				// Labels should be trimmed to name and color
+				switch issue.Number {
+				case 123:
+					assert.Equal(t, []MinimalLabel{{Name: "bug", Color: "d73a4a"}}, issue.Labels)
+				case 456:
+					assert.Equal(t, []MinimalLabel{{Name: "enhancement", Color: "a2eeef"}}, issue.Labels)
 				}
 
 				// Field values should be flattened to {field, value} pairs. Issue #123 has a
@@ -2271,6 +2905,450 @@ func Test_ListIssues(t *testing.T) {
 	}
 }
 
+func Test_AddLabelsToIssuesBulk(t *testing.T) {
+	serverTool := AddLabelsToIssuesBulk(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_labels_to_issues", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_numbers", "labels"})
+
+	tooMany := make([]any, MaxBulkLabelIssues+1)
+	for i := range tooMany {
+		tooMany[i] = float64(i + 1)
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]any
+		expectedErrMsg  string
+		expectedResults []BulkLabelResult
+		expectedFailed  int
+	}{
+		{
+			name: "labels every issue and reports partial failures",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposIssuesLabelsByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+					if strings.Contains(r.URL.Path, "/issues/2/") {
+						mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+						return
+					}
+					expectRequestBody(t, []any{"needs-triage"}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Label{
+							{Name: github.Ptr("bug")},
+							{Name: github.Ptr("needs-triage")},
+						}),
+					)(w, r)
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []any{float64(1), float64(2), float64(3)},
+				"labels":        []any{"needs-triage"},
+			},
+			expectedResults: []BulkLabelResult{
+				{IssueNumber: 1, Labels: []string{"bug", "needs-triage"}},
+				{IssueNumber: 2, Error: "404 Not Found"},
+				{IssueNumber: 3, Labels: []string{"bug", "needs-triage"}},
+			},
+			expectedFailed: 1,
+		},
+		{
+			name:         "batch over the cap is rejected",
+			mockedClient: MockHTTPClientWithHandlers(nil),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": tooMany,
+				"labels":        []any{"needs-triage"},
+			},
+			expectedErrMsg: fmt.Sprintf("issue_numbers may contain at most %d issues", MaxBulkLabelIssues),
+		},
+		{
+			name:         "non-positive issue number is rejected before any request",
+			mockedClient: MockHTTPClientWithHandlers(nil),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []any{float64(1), float64(0)},
+				"labels":        []any{"needs-triage"},
+			},
+			expectedErrMsg: "parameter issue_numbers[1] must be a positive integer, got 0",
+		},
+		{
+			name:         "missing labels",
+			mockedClient: MockHTTPClientWithHandlers(nil),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []any{float64(1)},
+			},
+			expectedErrMsg: "missing required parameter: labels",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: mustNewGHClient(t, tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response struct {
+				Results   []BulkLabelResult `json:"results"`
+				Succeeded int               `json:"succeeded"`
+				Failed    int               `json:"failed"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			require.Len(t, response.Results, len(tc.expectedResults))
+			for i, expected := range tc.expectedResults {
+				actual := response.Results[i]
+				assert.Equal(t, expected.IssueNumber, actual.IssueNumber)
+				assert.Equal(t, expected.Labels, actual.Labels)
+				if expected.Error == "" {
+					assert.Empty(t, actual.Error)
+				} else {
+					assert.Contains(t, actual.Error, expected.Error)
+				}
+			}
+			assert.Equal(t, tc.expectedFailed, response.Failed)
+			assert.Equal(t, len(tc.expectedResults)-tc.expectedFailed, response.Succeeded)
+		})
+	}
+}
+
+func Test_AddLabelsToIssuesBulk_SummaryMatchesResults(t *testing.T) {
+	serverTool := AddLabelsToIssuesBulk(translations.NullTranslationHelper)
+
+	var labeled []string
+	deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		PostReposIssuesLabelsByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+			labeled = append(labeled, r.URL.Path)
+			if strings.Contains(r.URL.Path, "/issues/2/") {
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, []*github.Label{{Name: github.Ptr("needs-triage")}})(w, r)
+		},
+	}))}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":         "owner",
+		"repo":          "repo",
+		"issue_numbers": []any{float64(1), float64(2), float64(1), float64(3), float64(2)},
+		"labels":        []any{"needs-triage"},
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	// Repeated issue numbers are skipped rather than labeled twice.
+	assert.Len(t, labeled, 3)
+
+	var response utils.BatchResult[BulkLabelResult]
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Len(t, response.Results, 5)
+	assert.Equal(t, []utils.BatchStatus{
+		utils.BatchSucceeded, utils.BatchFailed, utils.BatchSkipped, utils.BatchSucceeded, utils.BatchSkipped,
+	}, []utils.BatchStatus{
+		response.Results[0].Status, response.Results[1].Status, response.Results[2].Status, response.Results[3].Status, response.Results[4].Status,
+	})
+	assert.Equal(t, "duplicate of an earlier entry", response.Results[2].Reason)
+
+	counts := map[utils.BatchStatus]int{}
+	for _, entry := range response.Results {
+		counts[entry.Status]++
+	}
+	assert.Equal(t, 5, response.Total)
+	assert.Equal(t, counts[utils.BatchSucceeded], response.Succeeded)
+	assert.Equal(t, counts[utils.BatchFailed], response.Failed)
+	assert.Equal(t, counts[utils.BatchSkipped], response.Skipped)
+	assert.Equal(t, 2, response.Succeeded)
+	assert.Equal(t, 1, response.Failed)
+	assert.Equal(t, 2, response.Skipped)
+}
+
+func Test_AddLabelsToIssuesBulk_Canceled(t *testing.T) {
+	serverTool := AddLabelsToIssuesBulk(translations.NullTranslationHelper)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		PostReposIssuesLabelsByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			// The client stops waiting for the rest of the batch once the
+			// first issue has been labeled.
+			cancel()
+			mockResponse(t, http.StatusOK, []*github.Label{{Name: github.Ptr("needs-triage")}})(w, r)
+		},
+	}))}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":         "owner",
+		"repo":          "repo",
+		"issue_numbers": []any{float64(1), float64(2), float64(3)},
+		"labels":        []any{"needs-triage"},
+	})
+	result, err := handler(ContextWithDeps(ctx, deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, 1, calls)
+
+	var response struct {
+		Results      []BulkLabelResult `json:"results"`
+		Succeeded    int               `json:"succeeded"`
+		Failed       int               `json:"failed"`
+		Canceled     bool              `json:"canceled"`
+		NotAttempted []int             `json:"not_attempted"`
+		Summary      string            `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Len(t, response.Results, 1)
+	assert.Equal(t, 1, response.Results[0].IssueNumber)
+	assert.Equal(t, 1, response.Succeeded)
+	assert.Equal(t, 0, response.Failed)
+	assert.True(t, response.Canceled)
+	assert.Equal(t, []int{2, 3}, response.NotAttempted)
+	assert.Equal(t, "labeled 1 of 3 issues before cancellation", response.Summary)
+}
+
+func Test_ListIssues_SyncToken(t *testing.T) {
+	serverTool := ListIssues(translations.NullTranslationHelper)
+
+	issueFieldValuesSelection := "issueFieldValues(first: 25){nodes{__typename,... on IssueFieldDateValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldNumberValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},valueNumber: value},... on IssueFieldSingleSelectValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldTextValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value}}}"
+	issueNodesSelection := "{nodes{number,title,body,state,databaseId,id,url,author{login},createdAt,updatedAt,closedAt,labels(first: 100){nodes{name,id,description,color}},comments{totalCount}," + issueFieldValuesSelection + "},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
+	qNoSince := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues})" + issueNodesSelection
+	qWithSince := "query($after:String!$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$since:DateTime!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {since: $since, issueFieldValues: $issueFieldValues})" + issueNodesSelection
+
+	issueNode := func(number int, updatedAt string) map[string]any {
+		return map[string]any{
+			"number":           number,
+			"title":            fmt.Sprintf("Issue %d", number),
+			"state":            "OPEN",
+			"databaseId":       number,
+			"createdAt":        "2024-01-01T00:00:00Z",
+			"updatedAt":        updatedAt,
+			"author":           map[string]any{"login": "user"},
+			"labels":           map[string]any{"nodes": []any{}},
+			"comments":         map[string]any{"totalCount": 0},
+			"issueFieldValues": map[string]any{"nodes": []any{}},
+		}
+	}
+	issuesResponse := func(hasNextPage bool, endCursor string, nodes ...map[string]any) githubv4mock.GQLResponse {
+		return githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"issues": map[string]any{
+					"nodes": nodes,
+					"pageInfo": map[string]any{
+						"hasNextPage":     hasNextPage,
+						"hasPreviousPage": false,
+						"startCursor":     "",
+						"endCursor":       endCursor,
+					},
+					"totalCount": len(nodes),
+				},
+				"isPrivate": false,
+			},
+		})
+	}
+	decode := func(t *testing.T, token string) issueSyncToken {
+		t.Helper()
+		decoded, err := decodeIssueSyncToken(token)
+		require.NoError(t, err)
+		return decoded
+	}
+
+	t.Run("empty token starts a sync ordered by updated time", func(t *testing.T) {
+		matcher := githubv4mock.NewQueryMatcher(
+			qNoSince,
+			map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"states":           []any{"OPEN", "CLOSED"},
+				"orderBy":          "UPDATED_AT",
+				"direction":        "ASC",
+				"first":            float64(30),
+				"after":            (*string)(nil),
+				"issueFieldValues": []any{},
+			},
+			issuesResponse(true, "cursor-1", issueNode(1, "2024-02-01T00:00:00Z")),
+		)
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))}
+		handler := serverTool.Handler(deps)
+
+		req := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "sync_token": ""})
+		res, err := handler(ContextWithDeps(context.Background(), deps), &req)
+		require.NoError(t, err)
+		require.False(t, res.IsError, getTextResult(t, res).Text)
+
+		var response MinimalIssuesResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, res).Text), &response))
+		assert.Equal(t, issueSyncToken{After: "cursor-1"}, decode(t, response.SyncToken))
+	})
+
+	t.Run("token resumes since and cursor and advances since at the end", func(t *testing.T) {
+		matcher := githubv4mock.NewQueryMatcher(
+			qWithSince,
+			map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"states":           []any{"OPEN", "CLOSED"},
+				"orderBy":          "UPDATED_AT",
+				"direction":        "ASC",
+				"first":            float64(30),
+				"after":            "cursor-2",
+				"since":            "2024-02-01T00:00:00Z",
+				"issueFieldValues": []any{},
+			},
+			issuesResponse(false, "cursor-3",
+				issueNode(2, "2024-02-02T00:00:00Z"),
+				issueNode(3, "2024-02-03T00:00:00Z"),
+			),
+		)
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))}
+		handler := serverTool.Handler(deps)
+
+		token := encodeIssueSyncToken(issueSyncToken{Since: "2024-02-01T00:00:00Z", After: "cursor-2"})
+		req := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "sync_token": token})
+		res, err := handler(ContextWithDeps(context.Background(), deps), &req)
+		require.NoError(t, err)
+		require.False(t, res.IsError, getTextResult(t, res).Text)
+
+		var response MinimalIssuesResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, res).Text), &response))
+		require.Len(t, response.Issues, 2)
+		assert.Equal(t, issueSyncToken{Since: "2024-02-03T00:00:00Z"}, decode(t, response.SyncToken))
+	})
+
+	t.Run("token cannot be combined with since", func(t *testing.T) {
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient())}
+		handler := serverTool.Handler(deps)
+
+		req := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "sync_token": "", "since": "2024-01-01"})
+		res, err := handler(ContextWithDeps(context.Background(), deps), &req)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, res).Text, "sync_token cannot be combined with since")
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient())}
+		handler := serverTool.Handler(deps)
+
+		req := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "sync_token": "not a token!"})
+		res, err := handler(ContextWithDeps(context.Background(), deps), &req)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, res).Text, "invalid sync_token")
+	})
+}
+
+func Test_ListIssues_PartialData(t *testing.T) {
+	serverTool := ListIssues(translations.NullTranslationHelper)
+
+	issueFieldValuesSelection := "issueFieldValues(first: 25){nodes{__typename,... on IssueFieldDateValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldNumberValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},valueNumber: value},... on IssueFieldSingleSelectValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldTextValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value}}}"
+	qBasicNoLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,id,url,author{login},createdAt,updatedAt,closedAt,labels(first: 100){nodes{name,id,description,color}},comments{totalCount}," + issueFieldValuesSelection + "},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
+	vars := map[string]any{
+		"owner":            "owner",
+		"repo":             "repo",
+		"states":           []any{"OPEN", "CLOSED"},
+		"orderBy":          "CREATED_AT",
+		"direction":        "DESC",
+		"first":            float64(30),
+		"after":            (*string)(nil),
+		"issueFieldValues": []any{},
+	}
+	fieldValuesError := "Something went wrong while executing your query. Please include `ABCD:1234` when reporting this issue."
+
+	t.Run("issues returned alongside errors are marked partial", func(t *testing.T) {
+		matcher := githubv4mock.NewQueryMatcher(qBasicNoLabels, vars, githubv4mock.PartialResponse(map[string]any{
+			"repository": map[string]any{
+				"issues": map[string]any{
+					"nodes": []any{
+						map[string]any{
+							"number":           1,
+							"title":            "Issue 1",
+							"state":            "OPEN",
+							"databaseId":       1,
+							"createdAt":        "2024-01-01T00:00:00Z",
+							"updatedAt":        "2024-01-01T00:00:00Z",
+							"author":           map[string]any{"login": "user"},
+							"labels":           map[string]any{"nodes": []any{}},
+							"comments":         map[string]any{"totalCount": 0},
+							"issueFieldValues": nil,
+						},
+					},
+					"pageInfo":   map[string]any{"hasNextPage": false, "hasPreviousPage": false, "startCursor": "", "endCursor": ""},
+					"totalCount": 1,
+				},
+				"isPrivate": false,
+			},
+		}, fieldValuesError))
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))}
+		handler := serverTool.Handler(deps)
+
+		req := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"})
+		res, err := handler(ContextWithDeps(context.Background(), deps), &req)
+		require.NoError(t, err)
+		require.False(t, res.IsError, getTextResult(t, res).Text)
+
+		var response MinimalIssuesResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, res).Text), &response))
+		require.Len(t, response.Issues, 1)
+		assert.Equal(t, 1, response.Issues[0].Number)
+		assert.True(t, response.Partial)
+		assert.Equal(t, []string{"failed to list issues: " + fieldValuesError}, response.PartialErrors)
+	})
+
+	t.Run("errors without issues still fail", func(t *testing.T) {
+		matcher := githubv4mock.NewQueryMatcher(qBasicNoLabels, vars, githubv4mock.PartialResponse(map[string]any{
+			"repository": nil,
+		}, "Could not resolve to a Repository with the name 'owner/repo'."))
+		deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))}
+		handler := serverTool.Handler(deps)
+
+		req := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"})
+		res, err := handler(ContextWithDeps(context.Background(), deps), &req)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, res).Text, "failed to list issues")
+	})
+}
+
+func Test_GroupIssuesByLabel(t *testing.T) {
+	issues := []MinimalIssue{
+		{Number: 1, Labels: []MinimalLabel{{Name: "bug"}, {Name: "ui"}}},
+		{Number: 2, Labels: []MinimalLabel{{Name: "ui"}, {Name: "bug"}}},
+		{Number: 3},
+		{Number: 4, Labels: []MinimalLabel{{Name: "docs"}}},
+	}
+
+	assert.Equal(t, map[string][]int{
+		"bug":          {1},
+		"ui":           {2},
+		"docs":         {4},
+		unlabeledGroup: {3},
+	}, groupIssuesByLabel(issues, nil))
+
+	// With a label filter only the filtered labels are eligible as groups.
+	assert.Equal(t, map[string][]int{
+		"bug":          {1, 2},
+		unlabeledGroup: {3, 4},
+	}, groupIssuesByLabel(issues, []string{"BUG"}))
+}
+
 func Test_ListIssues_FieldFilters(t *testing.T) {
 	t.Parallel()
 
@@ -2362,8 +3440,8 @@ func Test_ListIssues_FieldFilters(t *testing.T) {
 		)
 	}
 
-	qNoLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount},issueFieldValues(first: 25){nodes{__typename,... on IssueFieldDateValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldNumberValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},valueNumber: value},... on IssueFieldSingleSelectValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldTextValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value}}}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
-	qWithLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$labels:[String!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, labels: $labels, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount},issueFieldValues(first: 25){nodes{__typename,... on IssueFieldDateValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldNumberValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},valueNumber: value},... on IssueFieldSingleSelectValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldTextValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value}}}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
+	qNoLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,id,url,author{login},createdAt,updatedAt,closedAt,labels(first: 100){nodes{name,id,description,color}},comments{totalCount},issueFieldValues(first: 25){nodes{__typename,... on IssueFieldDateValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldNumberValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},valueNumber: value},... on IssueFieldSingleSelectValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldTextValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value}}}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
+	qWithLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$labels:[String!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, labels: $labels, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,id,url,author{login},createdAt,updatedAt,closedAt,labels(first: 100){nodes{name,id,description,color}},comments{totalCount},issueFieldValues(first: 25){nodes{__typename,... on IssueFieldDateValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldNumberValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},valueNumber: value},... on IssueFieldSingleSelectValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldTextValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value}}}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
 
 	baseVars := func() map[string]any {
 		return map[string]any{
@@ -2724,7 +3802,7 @@ func Test_ListIssues_IFC_InsidersMode(t *testing.T) {
 		})
 	}
 
-	query := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount},issueFieldValues(first: 25){nodes{__typename,... on IssueFieldDateValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldNumberValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},valueNumber: value},... on IssueFieldSingleSelectValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldTextValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value}}}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
+	query := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,id,url,author{login},createdAt,updatedAt,closedAt,labels(first: 100){nodes{name,id,description,color}},comments{totalCount},issueFieldValues(first: 25){nodes{__typename,... on IssueFieldDateValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldNumberValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},valueNumber: value},... on IssueFieldSingleSelectValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value},... on IssueFieldTextValue{field{... on IssueFieldDate{name,fullDatabaseId},... on IssueFieldNumber{name,fullDatabaseId},... on IssueFieldSingleSelect{name,fullDatabaseId},... on IssueFieldText{name,fullDatabaseId}},value}}}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
 
 	vars := map[string]any{
 		"owner":            "octocat",
@@ -2888,6 +3966,14 @@ func Test_UpdateIssue(t *testing.T) {
 		},
 	})
 
+	crossRepoIssueIDQueryResponse := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"duplicateIssue": map[string]any{
+				"id": "I_kwDOB1yeZN60CQcQ",
+			},
+		},
+	})
+
 	closeSuccessResponse := githubv4mock.DataResponse(map[string]any{
 		"closeIssue": map[string]any{
 			"issue": map[string]any{
@@ -2970,6 +4056,29 @@ func Test_UpdateIssue(t *testing.T) {
 				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
 			},
 		},
+		{
+			name: "null optional fields are treated as absent on update",
+			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposIssuesByOwnerByRepoByIssueNumber: expectRequestBody(t, map[string]any{
+					"title": "Updated Title",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockUpdatedIssue),
+				),
+			}),
+			mockedGQLClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"method":       "update",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"title":        "Updated Title",
+				"body":         nil,
+				"labels":       nil,
+				"milestone":    nil,
+			},
+			expectError:   false,
+			expectedIssue: mockUpdatedIssue,
+		},
 		{
 			name: "partial update with issue fields reconciled by names",
 			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -3121,6 +4230,158 @@ func Test_UpdateIssue(t *testing.T) {
 			expectError:   false,
 			expectedIssue: mockUpdatedIssue,
 		},
+		{
+			name: "close issue as duplicate of an issue in another repository",
+			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockBaseIssue),
+			}),
+			mockedGQLClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							Issue struct {
+								ID githubv4.ID
+							} `graphql:"issue(number: $issueNumber)"`
+						} `graphql:"repository(owner: $owner, name: $repo)"`
+					}{},
+					map[string]any{
+						"owner":       githubv4.String("owner"),
+						"repo":        githubv4.String("repo"),
+						"issueNumber": githubv4.Int(123),
+					},
+					issueIDQueryResponse,
+				),
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							DuplicateIssue struct {
+								ID githubv4.ID
+							} `graphql:"duplicateIssue: issue(number: $duplicateOf)"`
+						} `graphql:"repository(owner: $owner, name: $repo)"`
+					}{},
+					map[string]any{
+						"owner":       githubv4.String("other"),
+						"repo":        githubv4.String("tracker"),
+						"duplicateOf": githubv4.Int(456),
+					},
+					crossRepoIssueIDQueryResponse,
+				),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						CloseIssue struct {
+							Issue struct {
+								ID     githubv4.ID
+								Number githubv4.Int
+								URL    githubv4.String
+								State  githubv4.String
+							}
+						} `graphql:"closeIssue(input: $input)"`
+					}{},
+					CloseIssueInput{
+						IssueID:          "I_kwDOA0xdyM50BPaO",
+						StateReason:      &duplicateStateReason,
+						DuplicateIssueID: githubv4.NewID("I_kwDOB1yeZN60CQcQ"),
+					},
+					nil,
+					closeSuccessResponse,
+				),
+			),
+			requestArgs: map[string]any{
+				"method":       "update",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "closed",
+				"state_reason": "duplicate",
+				"duplicate_of": "other/tracker#456",
+			},
+			expectError:   false,
+			expectedIssue: mockUpdatedIssue,
+		},
+		{
+			name: "cross-repository duplicate refused by the API",
+			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockBaseIssue),
+			}),
+			mockedGQLClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							Issue struct {
+								ID githubv4.ID
+							} `graphql:"issue(number: $issueNumber)"`
+						} `graphql:"repository(owner: $owner, name: $repo)"`
+					}{},
+					map[string]any{
+						"owner":       githubv4.String("owner"),
+						"repo":        githubv4.String("repo"),
+						"issueNumber": githubv4.Int(123),
+					},
+					issueIDQueryResponse,
+				),
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							DuplicateIssue struct {
+								ID githubv4.ID
+							} `graphql:"duplicateIssue: issue(number: $duplicateOf)"`
+						} `graphql:"repository(owner: $owner, name: $repo)"`
+					}{},
+					map[string]any{
+						"owner":       githubv4.String("other"),
+						"repo":        githubv4.String("tracker"),
+						"duplicateOf": githubv4.Int(456),
+					},
+					crossRepoIssueIDQueryResponse,
+				),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						CloseIssue struct {
+							Issue struct {
+								ID     githubv4.ID
+								Number githubv4.Int
+								URL    githubv4.String
+								State  githubv4.String
+							}
+						} `graphql:"closeIssue(input: $input)"`
+					}{},
+					CloseIssueInput{
+						IssueID:          "I_kwDOA0xdyM50BPaO",
+						StateReason:      &duplicateStateReason,
+						DuplicateIssueID: githubv4.NewID("I_kwDOB1yeZN60CQcQ"),
+					},
+					nil,
+					githubv4mock.ErrorResponse("Duplicate issue must be in the same repository"),
+				),
+			),
+			requestArgs: map[string]any{
+				"method":       "update",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "closed",
+				"state_reason": "duplicate",
+				"duplicate_of": "other/tracker#456",
+			},
+			expectError:    true,
+			expectedErrMsg: "Failed to close issue as a duplicate of other/tracker#456 in another repository: Duplicate issue must be in the same repository",
+		},
+		{
+			name:             "malformed duplicate_of reference should fail",
+			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			mockedGQLClient:  githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"method":       "update",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "closed",
+				"state_reason": "duplicate",
+				"duplicate_of": "other/tracker/456",
+			},
+			expectError:    true,
+			expectedErrMsg: `duplicate_of must be an issue number like 123 or a reference like "owner/repo#123", got "other/tracker/456"`,
+		},
 		{
 			name: "reopen issue",
 			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -3201,7 +4462,7 @@ func Test_UpdateIssue(t *testing.T) {
 				"state_reason": "not_planned",
 			},
 			expectError:    true,
-			expectedErrMsg: "Failed to find issues",
+			expectedErrMsg: "issue #999 not found",
 		},
 		{
 			name: "duplicate issue not found when closing as duplicate",
@@ -3239,7 +4500,7 @@ func Test_UpdateIssue(t *testing.T) {
 				"duplicate_of": float64(999),
 			},
 			expectError:    true,
-			expectedErrMsg: "Failed to find issues",
+			expectedErrMsg: "issue #999 not found",
 		},
 		{
 			name: "close as duplicate with combined non-state updates",
@@ -3320,11 +4581,71 @@ func Test_UpdateIssue(t *testing.T) {
 				"state_reason": "duplicate",
 				"duplicate_of": float64(456),
 			},
-			expectError:   false,
-			expectedIssue: mockUpdatedIssue,
+			expectError:   false,
+			expectedIssue: mockUpdatedIssue,
+		},
+		{
+			name:             "duplicate_of without duplicate state_reason should fail",
+			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			mockedGQLClient:  githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"method":       "update",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "closed",
+				"state_reason": "completed",
+				"duplicate_of": float64(456),
+			},
+			expectError:    true,
+			expectedErrMsg: "duplicate_of requires state_reason 'duplicate', not 'completed'",
+		},
+		{
+			name:             "state_reason without state should fail",
+			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			mockedGQLClient:  githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"method":       "update",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state_reason": "not_planned",
+			},
+			expectError:    true,
+			expectedErrMsg: "state_reason 'not_planned' requires state; pass state 'closed' to close the issue with this reason",
+		},
+		{
+			name:             "duplicate state_reason with open state should fail",
+			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			mockedGQLClient:  githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"method":       "update",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "open",
+				"state_reason": "duplicate",
+			},
+			expectError:    true,
+			expectedErrMsg: "state_reason 'duplicate' is only valid with state 'closed', not 'open'",
+		},
+		{
+			name:             "reopened state_reason should fail",
+			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			mockedGQLClient:  githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"method":       "update",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "open",
+				"state_reason": "reopened",
+			},
+			expectError:    true,
+			expectedErrMsg: "state_reason 'reopened' cannot be set; pass state 'open' to reopen an issue",
 		},
 		{
-			name:             "duplicate_of without duplicate state_reason should fail",
+			name:             "duplicate_of with open state should fail",
 			mockedRESTClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
 			mockedGQLClient:  githubv4mock.NewMockedHTTPClient(),
 			requestArgs: map[string]any{
@@ -3332,12 +4653,11 @@ func Test_UpdateIssue(t *testing.T) {
 				"owner":        "owner",
 				"repo":         "repo",
 				"issue_number": float64(123),
-				"state":        "closed",
-				"state_reason": "completed",
+				"state":        "open",
 				"duplicate_of": float64(456),
 			},
 			expectError:    true,
-			expectedErrMsg: "duplicate_of can only be used when state_reason is 'duplicate'",
+			expectedErrMsg: "duplicate_of requires state 'closed' with state_reason 'duplicate', not state 'open'",
 		},
 	}
 
@@ -3389,6 +4709,320 @@ func Test_UpdateIssue(t *testing.T) {
 	}
 }
 
+func Test_UpdateIssueReopenWithComment(t *testing.T) {
+	serverTool := IssueWrite(translations.NullTranslationHelper)
+	reopenedIssue := &github.Issue{
+		ID:      github.Ptr(int64(1001)),
+		Number:  github.Ptr(123),
+		State:   github.Ptr("open"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+	}
+	postedComment := &github.IssueComment{
+		ID:      github.Ptr(int64(555)),
+		Body:    github.Ptr("Regression is back in v2.3"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123#issuecomment-555"),
+	}
+
+	newGQLClient := func() *http.Client {
+		return githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				struct {
+					Repository struct {
+						Issue struct {
+							ID githubv4.ID
+						} `graphql:"issue(number: $issueNumber)"`
+					} `graphql:"repository(owner: $owner, name: $repo)"`
+				}{},
+				map[string]any{
+					"owner":       githubv4.String("owner"),
+					"repo":        githubv4.String("repo"),
+					"issueNumber": githubv4.Int(123),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"repository": map[string]any{
+						"issue": map[string]any{"id": "I_kwDOA0xdyM50BPaO"},
+					},
+				}),
+			),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					ReopenIssue struct {
+						Issue struct {
+							ID     githubv4.ID
+							Number githubv4.Int
+							URL    githubv4.String
+							State  githubv4.String
+						}
+					} `graphql:"reopenIssue(input: $input)"`
+				}{},
+				githubv4.ReopenIssueInput{
+					IssueID: "I_kwDOA0xdyM50BPaO",
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"reopenIssue": map[string]any{
+						"issue": map[string]any{
+							"id":     "I_kwDOA0xdyM50BPaO",
+							"number": 123,
+							"url":    "https://github.com/owner/repo/issues/123",
+							"state":  "OPEN",
+						},
+					},
+				}),
+			),
+		)
+	}
+
+	args := map[string]any{
+		"method":       "update",
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(123),
+		"state":        "open",
+		"comment":      "Regression is back in v2.3",
+	}
+
+	t.Run("posts comment after reopening", func(t *testing.T) {
+		commentPosted := false
+		restClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PatchReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, reopenedIssue),
+			PostReposIssuesCommentsByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+				commentPosted = true
+				expectRequestBody(t, map[string]any{
+					"body": "Regression is back in v2.3",
+				}).andThen(mockResponse(t, http.StatusCreated, postedComment))(w, r)
+			},
+		})
+		deps := BaseDeps{
+			Client:    mustNewGHClient(t, restClient),
+			GQLClient: githubv4.NewClient(newGQLClient()),
+		}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(args)
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		assert.True(t, commentPosted, "expected comment to be posted after reopen")
+
+		var resp updateIssueResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &resp))
+		assert.Equal(t, "https://github.com/owner/repo/issues/123", resp.URL)
+		require.NotNil(t, resp.Comment)
+		assert.Equal(t, "555", resp.Comment.ID)
+		assert.Empty(t, resp.CommentError)
+	})
+
+	t.Run("reports comment failure without failing the reopen", func(t *testing.T) {
+		restClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PatchReposIssuesByOwnerByRepoByIssueNumber:        mockResponse(t, http.StatusOK, reopenedIssue),
+			PostReposIssuesCommentsByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusForbidden, map[string]string{"message": "Issue is locked"}),
+		})
+		deps := BaseDeps{
+			Client:    mustNewGHClient(t, restClient),
+			GQLClient: githubv4.NewClient(newGQLClient()),
+		}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(args)
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var resp updateIssueResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &resp))
+		assert.Equal(t, "https://github.com/owner/repo/issues/123", resp.URL)
+		assert.Nil(t, resp.Comment)
+		assert.Contains(t, resp.CommentError, "failed to post comment")
+	})
+
+	t.Run("rejects comment without a state change", func(t *testing.T) {
+		deps := BaseDeps{
+			Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+			GQLClient: githubv4.NewClient(newGQLClient()),
+		}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":       "update",
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(123),
+			"title":        "New title",
+			"comment":      "Renamed",
+		})
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "comment can only be used when changing issue state")
+	})
+}
+
+// callOrderTransport records a label for every request before delegating, so
+// tests can assert ordering across the REST and GraphQL clients.
+type callOrderTransport struct {
+	next  http.RoundTripper
+	label string
+	calls *[]string
+}
+
+func (t *callOrderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	*t.calls = append(*t.calls, t.label)
+	return t.next.RoundTrip(req)
+}
+
+func Test_UpdateIssueCloseWithComment(t *testing.T) {
+	serverTool := IssueWrite(translations.NullTranslationHelper)
+	closedIssue := &github.Issue{
+		ID:      github.Ptr(int64(1001)),
+		Number:  github.Ptr(123),
+		State:   github.Ptr("closed"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+	}
+	postedComment := &github.IssueComment{
+		ID:      github.Ptr(int64(777)),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123#issuecomment-777"),
+	}
+
+	var calls []string
+	gqlMock := githubv4mock.NewMockedHTTPClient(
+		githubv4mock.NewQueryMatcher(
+			struct {
+				Repository struct {
+					Issue struct {
+						ID githubv4.ID
+					} `graphql:"issue(number: $issueNumber)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}{},
+			map[string]any{
+				"owner":       githubv4.String("owner"),
+				"repo":        githubv4.String("repo"),
+				"issueNumber": githubv4.Int(123),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{"id": "I_kwDOA0xdyM50BPaO"},
+				},
+			}),
+		),
+		githubv4mock.NewMutationMatcher(
+			struct {
+				CloseIssue struct {
+					Issue struct {
+						ID     githubv4.ID
+						Number githubv4.Int
+						URL    githubv4.String
+						State  githubv4.String
+					}
+				} `graphql:"closeIssue(input: $input)"`
+			}{},
+			CloseIssueInput{
+				IssueID:     "I_kwDOA0xdyM50BPaO",
+				StateReason: &[]IssueClosedStateReason{IssueClosedStateReasonNotPlanned}[0],
+			},
+			nil,
+			githubv4mock.DataResponse(map[string]any{
+				"closeIssue": map[string]any{
+					"issue": map[string]any{
+						"id":     "I_kwDOA0xdyM50BPaO",
+						"number": 123,
+						"url":    "https://github.com/owner/repo/issues/123",
+						"state":  "CLOSED",
+					},
+				},
+			}),
+		),
+	)
+	gqlMock.Transport = &callOrderTransport{next: gqlMock.Transport, label: "graphql", calls: &calls}
+
+	restClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		PatchReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, closedIssue),
+		PostReposIssuesCommentsByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, "comment")
+			expectRequestBody(t, map[string]any{
+				"body": "Out of scope for this project",
+			}).andThen(mockResponse(t, http.StatusCreated, postedComment))(w, r)
+		},
+	})
+	deps := BaseDeps{
+		Client:    mustNewGHClient(t, restClient),
+		GQLClient: githubv4.NewClient(gqlMock),
+	}
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"method":       "update",
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(123),
+		"state":        "closed",
+		"state_reason": "not_planned",
+		"comment":      "Out of scope for this project",
+	})
+
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	// The issue ID lookup and closeIssue mutation must both precede the comment.
+	assert.Equal(t, []string{"graphql", "graphql", "comment"}, calls)
+
+	var resp updateIssueResponse
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &resp))
+	assert.Equal(t, "https://github.com/owner/repo/issues/123", resp.URL)
+	require.NotNil(t, resp.Comment)
+	assert.Equal(t, "777", resp.Comment.ID)
+	assert.Empty(t, resp.CommentError)
+}
+
+func Test_UpdateIssueReturnChanges(t *testing.T) {
+	serverTool := IssueWrite(translations.NullTranslationHelper)
+	before := &github.Issue{
+		ID:        github.Ptr(int64(42)),
+		Number:    github.Ptr(8),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/8"),
+		Assignees: []*github.User{{Login: github.Ptr("alice")}, {Login: github.Ptr("bob")}},
+		Labels:    []*github.Label{{Name: github.Ptr("bug")}},
+	}
+	after := &github.Issue{
+		ID:        github.Ptr(int64(42)),
+		Number:    github.Ptr(8),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/8"),
+		Assignees: []*github.User{{Login: github.Ptr("bob")}, {Login: github.Ptr("carol")}},
+		Labels:    []*github.Label{{Name: github.Ptr("bug")}},
+	}
+
+	client := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, before),
+		PatchReposIssuesByOwnerByRepoByIssueNumber: expectRequestBody(t, map[string]any{
+			"assignees": []any{"bob", "carol"},
+		}).andThen(mockResponse(t, http.StatusOK, after)),
+	})
+	deps := BaseDeps{
+		Client:    mustNewGHClient(t, client),
+		GQLClient: githubv4.NewClient(nil),
+	}
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"method":         "update",
+		"owner":          "owner",
+		"repo":           "repo",
+		"issue_number":   float64(8),
+		"assignees":      []any{"bob", "carol"},
+		"return_changes": true,
+	})
+
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var resp updateIssueResponse
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &resp))
+	assert.Equal(t, "https://github.com/owner/repo/issues/8", resp.URL)
+	require.NotNil(t, resp.Changes)
+	assert.Equal(t, setChanges{Added: []string{"carol"}, Removed: []string{"alice"}}, resp.Changes.Assignees)
+	assert.Equal(t, setChanges{Added: []string{}, Removed: []string{}}, resp.Changes.Labels)
+}
+
 func Test_UpdateIssueClearsLabelsAndAssignees(t *testing.T) {
 	serverTool := IssueWrite(translations.NullTranslationHelper)
 	updatedIssue := &github.Issue{
@@ -3480,6 +5114,82 @@ func Test_ParseISOTimestamp(t *testing.T) {
 	}
 }
 
+func Test_ParseFlexibleTimestamp(t *testing.T) {
+	now := time.Date(2024, 3, 10, 15, 45, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		input        string
+		expectedErr  bool
+		expectedTime time.Time
+	}{
+		{
+			name:         "RFC3339 unchanged",
+			input:        "2023-01-15T14:30:00Z",
+			expectedTime: time.Date(2023, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "date only unchanged",
+			input:        "2023-01-15",
+			expectedTime: time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "days ago",
+			input:        "7 days ago",
+			expectedTime: time.Date(2024, 3, 3, 15, 45, 0, 0, time.UTC),
+		},
+		{
+			name:         "singular hour ago",
+			input:        "1 hour ago",
+			expectedTime: time.Date(2024, 3, 10, 14, 45, 0, 0, time.UTC),
+		},
+		{
+			name:         "weeks ago with mixed case and padding",
+			input:        "  2 Weeks Ago ",
+			expectedTime: time.Date(2024, 2, 25, 15, 45, 0, 0, time.UTC),
+		},
+		{
+			name:         "today",
+			input:        "today",
+			expectedTime: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "yesterday",
+			input:        "Yesterday",
+			expectedTime: time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "ambiguous relative expression",
+			input:       "last week",
+			expectedErr: true,
+		},
+		{
+			name:        "unsupported unit",
+			input:       "3 months ago",
+			expectedErr: true,
+		},
+		{
+			name:        "empty timestamp",
+			input:       "",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parsedTime, err := parseFlexibleTimestampAt(tc.input, now)
+
+			if tc.expectedErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "'N hours/days/weeks ago'")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTime, parsedTime)
+		})
+	}
+}
+
 func Test_GetIssueComments(t *testing.T) {
 	// Verify tool definition once
 	serverTool := IssueRead(translations.NullTranslationHelper)
@@ -3560,6 +5270,19 @@ func Test_GetIssueComments(t *testing.T) {
 			expectError:      false,
 			expectedComments: mockComments,
 		},
+		{
+			name:         "perPage above 100 is rejected",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":       "get_comments",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"perPage":      float64(200),
+			},
+			expectError:    true,
+			expectedErrMsg: "perPage must be at most 100 for method 'get_comments'",
+		},
 		{
 			name: "issue not found",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -3574,6 +5297,53 @@ func Test_GetIssueComments(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to get issue comments",
 		},
+		{
+			name: "exclude_bots and author_association filter comments",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentsByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, []*github.IssueComment{
+					{
+						ID:                github.Ptr(int64(801)),
+						Body:              github.Ptr("Maintainer reply"),
+						User:              &github.User{Login: github.Ptr("maintainer")},
+						AuthorAssociation: github.Ptr("MEMBER"),
+					},
+					{
+						ID:                github.Ptr(int64(802)),
+						Body:              github.Ptr("Automated notice"),
+						User:              &github.User{Login: github.Ptr("github-actions[bot]")},
+						AuthorAssociation: github.Ptr("MEMBER"),
+					},
+					{
+						ID:                github.Ptr(int64(803)),
+						Body:              github.Ptr("Bot by account type"),
+						User:              &github.User{Login: github.Ptr("renovate"), Type: github.Ptr("Bot")},
+						AuthorAssociation: github.Ptr("OWNER"),
+					},
+					{
+						ID:                github.Ptr(int64(804)),
+						Body:              github.Ptr("Drive-by comment"),
+						User:              &github.User{Login: github.Ptr("someone")},
+						AuthorAssociation: github.Ptr("NONE"),
+					},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"method":             "get_comments",
+				"owner":              "owner",
+				"repo":               "repo",
+				"issue_number":       float64(42),
+				"exclude_bots":       true,
+				"author_association": []any{"OWNER", "member"},
+			},
+			expectError: false,
+			expectedComments: []*github.IssueComment{
+				{
+					ID:   github.Ptr(int64(801)),
+					Body: github.Ptr("Maintainer reply"),
+					User: &github.User{Login: github.Ptr("maintainer")},
+				},
+			},
+		},
 		{
 			name: "lockdown enabled filters comments without push access",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -3636,26 +5406,129 @@ func Test_GetIssueComments(t *testing.T) {
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
 			// Verify results
+			require.NoError(t, err)
 			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedComments []MinimalIssueComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComments)
+			require.NoError(t, err)
+			assert.Equal(t, len(tc.expectedComments), len(returnedComments))
+			for i := range tc.expectedComments {
+				require.NotNil(t, tc.expectedComments[i].User)
+				require.NotNil(t, returnedComments[i].User)
+				assert.Equal(t, tc.expectedComments[i].GetID(), returnedComments[i].ID)
+				assert.Equal(t, tc.expectedComments[i].GetBody(), returnedComments[i].Body)
+				assert.Equal(t, tc.expectedComments[i].GetUser().GetLogin(), returnedComments[i].User.Login)
+			}
+		})
+	}
+}
+
+func Test_GetIssueComments_IncludeReactions(t *testing.T) {
+	serverTool := IssueRead(translations.NullTranslationHelper)
+	mockComments := []*github.IssueComment{
+		{
+			ID:        github.Ptr(int64(1)),
+			Body:      github.Ptr("Ship it"),
+			User:      &github.User{Login: github.Ptr("user1")},
+			Reactions: &github.Reactions{TotalCount: github.Ptr(3), PlusOne: github.Ptr(2), Heart: github.Ptr(1)},
+		},
+		{
+			ID:        github.Ptr(int64(2)),
+			Body:      github.Ptr("No reactions here"),
+			User:      &github.User{Login: github.Ptr("user2")},
+			Reactions: &github.Reactions{TotalCount: github.Ptr(0)},
+		},
+	}
+	reactions := []*github.Reaction{
+		{Content: github.Ptr("heart"), User: &github.User{Login: github.Ptr("carol")}},
+		{Content: github.Ptr("+1"), User: &github.User{Login: github.Ptr("alice")}},
+		{Content: github.Ptr("+1"), User: &github.User{Login: github.Ptr("bob")}},
+	}
+
+	tests := []struct {
+		name             string
+		includeReactions bool
+		expectedFetches  int
+	}{
+		{name: "off by default", expectedFetches: 0},
+		{name: "included when requested", includeReactions: true, expectedFetches: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var fetched []string
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentsByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockComments),
+				GetReposIssuesCommentsReactionsByOwnerByRepoByCommentID: func(w http.ResponseWriter, r *http.Request) {
+					fetched = append(fetched, r.URL.Path)
+					assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+					mockResponse(t, http.StatusOK, reactions)(w, r)
+				},
+			}))
+			deps := BaseDeps{
+				Client:          client,
+				GQLClient:       defaultGQLClient,
+				RepoAccessCache: stubRepoAccessCache(nil, 15*time.Minute),
+				Flags:           stubFeatureFlags(map[string]bool{}),
+			}
+			handler := serverTool.Handler(deps)
+
+			args := map[string]any{"method": "get_comments", "owner": "owner", "repo": "repo", "issue_number": float64(42)}
+			if tc.includeReactions {
+				args["include_reactions"] = true
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 			require.NoError(t, err)
 			textContent := getTextResult(t, result)
 
-			// Unmarshal and verify the result
-			var returnedComments []MinimalIssueComment
-			err = json.Unmarshal([]byte(textContent.Text), &returnedComments)
-			require.NoError(t, err)
-			assert.Equal(t, len(tc.expectedComments), len(returnedComments))
-			for i := range tc.expectedComments {
-				require.NotNil(t, tc.expectedComments[i].User)
-				require.NotNil(t, returnedComments[i].User)
-				assert.Equal(t, tc.expectedComments[i].GetID(), returnedComments[i].ID)
-				assert.Equal(t, tc.expectedComments[i].GetBody(), returnedComments[i].Body)
-				assert.Equal(t, tc.expectedComments[i].GetUser().GetLogin(), returnedComments[i].User.Login)
+			var returnedComments []map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returnedComments))
+			require.Len(t, returnedComments, 2)
+			assert.Len(t, fetched, tc.expectedFetches)
+			assert.NotContains(t, returnedComments[1], "reaction_groups")
+			if !tc.includeReactions {
+				assert.NotContains(t, returnedComments[0], "reaction_groups")
+				return
+			}
+
+			var comments []MinimalIssueComment
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &comments))
+			assert.Equal(t, []CommentReactionGroup{
+				{Content: "+1", Count: 2, Users: []string{"alice", "bob"}},
+				{Content: "heart", Count: 1, Users: []string{"carol"}},
+			}, comments[0].ReactionGroups)
+			assert.False(t, comments[0].ReactionsTruncated)
+		})
+	}
+}
+
+func Test_IsBotUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     *github.User
+		expected bool
+	}{
+		{name: "nil user", user: nil, expected: false},
+		{name: "regular user", user: &github.User{Login: github.Ptr("octocat"), Type: github.Ptr("User")}, expected: false},
+		{name: "bot account type", user: &github.User{Login: github.Ptr("renovate"), Type: github.Ptr("Bot")}, expected: true},
+		{name: "app login suffix", user: &github.User{Login: github.Ptr("dependabot[bot]")}, expected: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isBotUser(tc.user))
+			if tc.user != nil {
+				comment := convertToMinimalIssueComment(&github.IssueComment{User: tc.user})
+				assert.Equal(t, tc.expected, comment.IsBot)
 			}
 		})
 	}
@@ -3768,6 +5641,130 @@ func Test_GetIssueLabels(t *testing.T) {
 	}
 }
 
+func Test_ListIssueLinkedPullRequests(t *testing.T) {
+	t.Parallel()
+
+	serverTool := IssueRead(translations.NullTranslationHelper)
+
+	linkedPRsQuery := "query($includeClosed:Boolean!$issueNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){issue(number: $issueNumber){closedByPullRequestsReferences(first: 100, includeClosedPrs: $includeClosed){nodes{number,title,state,isDraft,url,headRefName,author{login},repository{nameWithOwner}}},timelineItems(itemTypes: [CONNECTED_EVENT], first: 100){nodes{... on ConnectedEvent{subject{... on PullRequest{number,title,state,isDraft,url,headRefName,author{login},repository{nameWithOwner}}}}}}}}}"
+	// issue_read always includes closed and merged pull requests.
+	vars := map[string]any{
+		"owner":         "owner",
+		"repo":          "repo",
+		"issueNumber":   float64(42),
+		"includeClosed": true,
+	}
+	linkedPRsResponse := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"issue": map[string]any{
+				"closedByPullRequestsReferences": map[string]any{
+					"nodes": []any{
+						map[string]any{
+							"number":     7,
+							"title":      "Fix the bug",
+							"state":      "OPEN",
+							"isDraft":    true,
+							"url":        "https://github.com/owner/repo/pull/7",
+							"author":     map[string]any{"login": "maintainer"},
+							"repository": map[string]any{"nameWithOwner": "owner/repo"},
+						},
+						map[string]any{
+							"number":     9,
+							"title":      "Alternative fix",
+							"state":      "CLOSED",
+							"isDraft":    false,
+							"url":        "https://github.com/owner/repo/pull/9",
+							"author":     map[string]any{"login": "testuser"},
+							"repository": map[string]any{"nameWithOwner": "owner/repo"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	tests := []struct {
+		name            string
+		response        githubv4mock.GQLResponse
+		lockdownEnabled bool
+		expectToolError bool
+		expectedErrMsg  string
+		expectedNumbers []int
+	}{
+		{
+			name:            "successful linked pull requests listing",
+			response:        linkedPRsResponse,
+			expectedNumbers: []int{7, 9},
+		},
+		{
+			name:            "lockdown omits pull requests from unverified authors",
+			response:        linkedPRsResponse,
+			lockdownEnabled: true,
+			expectedNumbers: []int{7},
+		},
+		{
+			name:            "issue not found",
+			response:        githubv4mock.ErrorResponse("Could not resolve to an Issue with the number of 42."),
+			expectToolError: true,
+			expectedErrMsg:  "failed to get linked pull requests",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(linkedPRsQuery, vars, tc.response),
+			))
+			var restClient *github.Client
+			if tc.lockdownEnabled {
+				restClient = mockRESTPermissionServer(t, "read", map[string]string{
+					"maintainer": "write",
+					"testuser":   "read",
+				})
+			}
+			deps := BaseDeps{
+				Client:          mustNewGHClient(t, nil),
+				GQLClient:       gqlClient,
+				RepoAccessCache: stubRepoAccessCache(restClient, 15*time.Minute),
+				Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": tc.lockdownEnabled}),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"method":       "get_linked_pull_requests",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				textContent := getErrorResult(t, result)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response struct {
+				PullRequests []MinimalLinkedPullRequest `json:"pull_requests"`
+				TotalCount   int                        `json:"totalCount"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, len(tc.expectedNumbers), response.TotalCount)
+			numbers := make([]int, 0, len(response.PullRequests))
+			for _, pr := range response.PullRequests {
+				numbers = append(numbers, pr.Number)
+				assert.Equal(t, "owner/repo", pr.Repository)
+				assert.Equal(t, "closing", pr.Source)
+			}
+			assert.Equal(t, tc.expectedNumbers, numbers)
+			assert.True(t, response.PullRequests[0].Draft)
+		})
+	}
+}
+
 func Test_GetIssueParent(t *testing.T) {
 	t.Parallel()
 
@@ -3933,6 +5930,108 @@ func Test_GetIssueParent(t *testing.T) {
 	}
 }
 
+func Test_GetSubIssueProgress(t *testing.T) {
+	t.Parallel()
+
+	serverTool := IssueRead(translations.NullTranslationHelper)
+
+	progressQuery := struct {
+		Repository struct {
+			Issue struct {
+				SubIssuesSummary struct {
+					Total            githubv4.Int
+					Completed        githubv4.Int
+					PercentCompleted githubv4.Int
+				}
+			} `graphql:"issue(number: $issueNumber)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}{}
+
+	vars := map[string]any{
+		"owner":       githubv4.String("owner"),
+		"repo":        githubv4.String("repo"),
+		"issueNumber": githubv4.Int(123),
+	}
+
+	tests := []struct {
+		name            string
+		response        githubv4mock.GQLResponse
+		expectToolError bool
+		expected        MinimalSubIssuesSummary
+		expectedErrMsg  string
+	}{
+		{
+			name: "issue with sub-issues",
+			response: githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"subIssuesSummary": map[string]any{
+							"total":            githubv4.Int(8),
+							"completed":        githubv4.Int(6),
+							"percentCompleted": githubv4.Int(75),
+						},
+					},
+				},
+			}),
+			expected: MinimalSubIssuesSummary{Total: 8, Completed: 6, PercentCompleted: 75},
+		},
+		{
+			name: "issue without sub-issues",
+			response: githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"subIssuesSummary": map[string]any{
+							"total":            githubv4.Int(0),
+							"completed":        githubv4.Int(0),
+							"percentCompleted": githubv4.Int(0),
+						},
+					},
+				},
+			}),
+			expected: MinimalSubIssuesSummary{},
+		},
+		{
+			name:            "issue not found",
+			response:        githubv4mock.ErrorResponse("Could not resolve to an Issue with the number of 123."),
+			expectToolError: true,
+			expectedErrMsg:  "failed to get sub-issue progress",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deps := BaseDeps{
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+					githubv4mock.NewQueryMatcher(progressQuery, vars, tc.response),
+				)),
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"method":       "get_sub_issue_progress",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+			})
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var summary MinimalSubIssuesSummary
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &summary))
+			assert.Equal(t, tc.expected, summary)
+		})
+	}
+}
+
 func Test_AddSubIssue(t *testing.T) {
 	// Verify tool definition once
 	serverTool := SubIssueWrite(translations.NullTranslationHelper)
@@ -4392,6 +6491,54 @@ func Test_GetSubIssues(t *testing.T) {
 	}
 }
 
+func Test_QuoteIssueComment(t *testing.T) {
+	url := "https://github.com/owner/repo/issues/1#issuecomment-7"
+
+	long := make([]string, 12)
+	for i := range long {
+		long[i] = fmt.Sprintf("line %d", i+1)
+	}
+
+	tests := []struct {
+		name     string
+		comment  *github.IssueComment
+		expected string
+	}{
+		{
+			name: "short comment is quoted in full",
+			comment: &github.IssueComment{
+				Body:    github.Ptr("Looks good"),
+				HTMLURL: github.Ptr(url),
+				User:    &github.User{Login: github.Ptr("alice")},
+			},
+			expected: "> [@alice wrote:](" + url + ")\n> Looks good",
+		},
+		{
+			name: "long comment is cut to the line limit",
+			comment: &github.IssueComment{
+				Body:    github.Ptr(strings.Join(long, "\n")),
+				HTMLURL: github.Ptr(url),
+				User:    &github.User{Login: github.Ptr("alice")},
+			},
+			expected: "> [@alice wrote:](" + url + ")\n> " + strings.Join(long[:maxReplyQuoteLines], "\n> ") + "…",
+		},
+		{
+			name: "wide comment is cut to the character limit",
+			comment: &github.IssueComment{
+				Body:    github.Ptr(strings.Repeat("x", maxReplyQuoteChars+50)),
+				HTMLURL: github.Ptr(url),
+			},
+			expected: "> [@ghost wrote:](" + url + ")\n> " + strings.Repeat("x", maxReplyQuoteChars) + "…",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, quoteIssueComment(tc.comment))
+		})
+	}
+}
+
 func TestAddIssueComment(t *testing.T) {
 	t.Parallel()
 
@@ -4424,6 +6571,14 @@ func TestAddIssueComment(t *testing.T) {
 		IssueURL: github.Ptr("https://api.github.com/repos/owner/repo/issues/42"),
 	}
 	commentCreatedAfterReactionFailure := &atomic.Bool{}
+	commentCreatedForMissingReply := &atomic.Bool{}
+	mockRepliedComment := &github.IssueComment{
+		ID:       github.Ptr(int64(321)),
+		Body:     github.Ptr("Can we ship this?\r\n\r\nIt looks ready."),
+		HTMLURL:  github.Ptr("https://github.com/owner/repo/issues/42#issuecomment-321"),
+		IssueURL: github.Ptr("https://api.github.com/repos/owner/repo/issues/42"),
+		User:     &github.User{Login: github.Ptr("octocat")},
+	}
 
 	tests := []struct {
 		name               string
@@ -4521,6 +6676,57 @@ func TestAddIssueComment(t *testing.T) {
 				"reaction":     "heart",
 			},
 		},
+		{
+			name: "successful reply quotes the referenced comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentByOwnerByRepoByCommentID: mockResponse(t, http.StatusOK, mockRepliedComment),
+				PostReposIssuesCommentsByOwnerByRepoByIssueNumber: expectRequestBody(t, map[string]any{
+					"body": "> [@octocat wrote:](https://github.com/owner/repo/issues/42#issuecomment-321)\n> Can we ship this?\n>\n> It looks ready.\n\nYes, merging now.",
+				}).andThen(mockResponse(t, http.StatusCreated, mockComment)),
+			}),
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"issue_number":        float64(42),
+				"body":                "Yes, merging now.",
+				"reply_to_comment_id": float64(321),
+			},
+		},
+		{
+			name: "reply to missing comment posts nothing",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentByOwnerByRepoByCommentID: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				},
+				PostReposIssuesCommentsByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, _ *http.Request) {
+					commentCreatedForMissingReply.Store(true)
+					w.WriteHeader(http.StatusCreated)
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"issue_number":        float64(42),
+				"body":                "Yes, merging now.",
+				"reply_to_comment_id": float64(321),
+			},
+			expectToolError:    true,
+			expectedToolErrMsg: "reply_to_comment_id 321 not found",
+			unexpectedCall:     commentCreatedForMissingReply,
+		},
+		{
+			name: "reply_to_comment_id without body",
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"issue_number":        float64(42),
+				"reaction":            "heart",
+				"reply_to_comment_id": float64(321),
+			},
+			expectToolError:    true,
+			expectedToolErrMsg: "reply_to_comment_id can only be provided when body is provided",
+		},
 		{
 			name: "missing body and reaction",
 			requestArgs: map[string]any{
@@ -4736,10 +6942,8 @@ func Test_RemoveSubIssue(t *testing.T) {
 			expectedErrMsg: "failed to remove sub-issue",
 		},
 		{
-			name: "bad request - invalid sub_issue_id",
-			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				DeleteReposIssuesSubIssueByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusBadRequest, `{"message": "Invalid sub_issue_id"}`),
-			}),
+			name:         "invalid sub_issue_id is rejected locally",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
 			requestArgs: map[string]any{
 				"method":       "remove",
 				"owner":        "owner",
@@ -4748,7 +6952,7 @@ func Test_RemoveSubIssue(t *testing.T) {
 				"sub_issue_id": float64(-1),
 			},
 			expectError:    false,
-			expectedErrMsg: "failed to remove sub-issue",
+			expectedErrMsg: "sub_issue_id must be a positive integer",
 		},
 		{
 			name: "repository not found",
@@ -4888,20 +7092,72 @@ func Test_ReprioritizeSubIssue(t *testing.T) {
 				Description: github.Ptr("New feature or request"),
 			},
 		},
-	}
-
-	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]any
-		expectError    bool
-		expectedIssue  *github.Issue
-		expectedErrMsg string
-	}{
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedIssue  *github.Issue
+		expectedErrMsg string
+	}{
+		{
+			name: "successful reprioritization with after_id",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposIssuesSubIssuesPriorityByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockIssue),
+			}),
+			requestArgs: map[string]any{
+				"method":       "reprioritize",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(123),
+				"after_id":     float64(456),
+			},
+			expectError:   false,
+			expectedIssue: mockIssue,
+		},
+		{
+			name: "position top resolves to the first other sub-issue",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, []*github.SubIssue{
+					{ID: github.Ptr(int64(10))},
+					{ID: github.Ptr(int64(20))},
+					{ID: github.Ptr(int64(123))},
+				}),
+				PatchReposIssuesSubIssuesPriorityByOwnerByRepoByIssueNumber: expectRequestBody(t, map[string]any{
+					"sub_issue_id": float64(123),
+					"before_id":    float64(10),
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockIssue),
+				),
+			}),
+			requestArgs: map[string]any{
+				"method":       "reprioritize",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(123),
+				"position":     "top",
+			},
+			expectError:   false,
+			expectedIssue: mockIssue,
+		},
 		{
-			name: "successful reprioritization with after_id",
+			name: "position bottom resolves to the last other sub-issue",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				PatchReposIssuesSubIssuesPriorityByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockIssue),
+				GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, []*github.SubIssue{
+					{ID: github.Ptr(int64(10))},
+					{ID: github.Ptr(int64(123))},
+					{ID: github.Ptr(int64(30))},
+				}),
+				PatchReposIssuesSubIssuesPriorityByOwnerByRepoByIssueNumber: expectRequestBody(t, map[string]any{
+					"sub_issue_id": float64(123),
+					"after_id":     float64(30),
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockIssue),
+				),
 			}),
 			requestArgs: map[string]any{
 				"method":       "reprioritize",
@@ -4909,11 +7165,44 @@ func Test_ReprioritizeSubIssue(t *testing.T) {
 				"repo":         "repo",
 				"issue_number": float64(42),
 				"sub_issue_id": float64(123),
-				"after_id":     float64(456),
+				"position":     "bottom",
 			},
 			expectError:   false,
 			expectedIssue: mockIssue,
 		},
+		{
+			name:         "position cannot be combined with after_id",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":       "reprioritize",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(123),
+				"after_id":     float64(456),
+				"position":     "top",
+			},
+			expectError:    false,
+			expectedErrMsg: "position cannot be combined with after_id or before_id",
+		},
+		{
+			name: "position with no other sub-issues",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, []*github.SubIssue{
+					{ID: github.Ptr(int64(123))},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"method":       "reprioritize",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(123),
+				"position":     "bottom",
+			},
+			expectError:    false,
+			expectedErrMsg: "has no other sub-issues to position against",
+		},
 		{
 			name: "successful reprioritization with before_id",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -4958,6 +7247,34 @@ func Test_ReprioritizeSubIssue(t *testing.T) {
 			expectError:    false,
 			expectedErrMsg: "only one of after_id or before_id should be specified, not both",
 		},
+		{
+			name:         "validation error - negative sub_issue_id",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":       "reprioritize",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(-5),
+				"after_id":     float64(456),
+			},
+			expectError:    false,
+			expectedErrMsg: "sub_issue_id must be a positive integer",
+		},
+		{
+			name:         "validation error - negative before_id",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":       "reprioritize",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(123),
+				"before_id":    float64(-7),
+			},
+			expectError:    false,
+			expectedErrMsg: "before_id must be a positive integer",
+		},
 		{
 			name: "parent issue not found",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -5114,6 +7431,117 @@ func Test_ReprioritizeSubIssue(t *testing.T) {
 	}
 }
 
+func Test_MoveSubIssue(t *testing.T) {
+	serverTool := SubIssueWrite(translations.NullTranslationHelper)
+	assert.Contains(t, serverTool.Tool.InputSchema.(*jsonschema.Schema).Properties, "new_parent")
+
+	mockNewParent := &github.Issue{
+		Number:  github.Ptr(43),
+		Title:   github.Ptr("New Parent Issue"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/43"),
+	}
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		requestArgs    map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "successful move",
+			handlers: map[string]http.HandlerFunc{
+				DeleteReposIssuesSubIssueByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, _ *http.Request) {
+					t.Error("move should not remove the sub-issue from its parent")
+					w.WriteHeader(http.StatusInternalServerError)
+				},
+				PostReposIssuesSubIssuesByOwnerByRepoByIssueNumber: expectPath(t, "/repos/owner/repo/issues/43/sub_issues").andThen(
+					expectRequestBody(t, map[string]any{
+						"sub_issue_id":   float64(123),
+						"replace_parent": true,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockNewParent),
+					),
+				),
+			},
+			requestArgs: map[string]any{
+				"method":       "move",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"new_parent":   float64(43),
+				"sub_issue_id": float64(123),
+			},
+		},
+		{
+			name: "add fails leaves hierarchy unchanged",
+			handlers: map[string]http.HandlerFunc{
+				PostReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusForbidden, `{"message": "Must have write access to repository"}`),
+			},
+			requestArgs: map[string]any{
+				"method":       "move",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"new_parent":   float64(43),
+				"sub_issue_id": float64(123),
+			},
+			expectedErrMsg: "failed to move sub-issue to #43; nothing was changed",
+		},
+		{
+			name:     "same parent is rejected locally",
+			handlers: map[string]http.HandlerFunc{},
+			requestArgs: map[string]any{
+				"method":       "move",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"new_parent":   float64(42),
+				"sub_issue_id": float64(123),
+			},
+			expectedErrMsg: "new_parent must differ from the current parent",
+		},
+		{
+			name:     "missing new_parent",
+			handlers: map[string]http.HandlerFunc{},
+			requestArgs: map[string]any{
+				"method":       "move",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(123),
+			},
+			expectedErrMsg: "missing required parameter: new_parent",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var moved SubIssueMoveResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &moved))
+			assert.Equal(t, int64(123), moved.SubIssueID)
+			assert.Equal(t, SubIssueParentRef{Number: 43, URL: "https://github.com/owner/repo/issues/43"}, moved.NewParent)
+		})
+	}
+}
+
 func Test_ListIssueTypes(t *testing.T) {
 	// Verify tool definition once
 	serverTool := ListIssueTypes(translations.NullTranslationHelper)
@@ -5171,6 +7599,18 @@ func Test_ListIssueTypes(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to list issue types",
 		},
+		{
+			name: "owner is a user account",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /orgs/octocat/issue-types": mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				GetUsersByUsername:              mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("octocat"), Type: github.Ptr("User")}),
+			}),
+			requestArgs: map[string]any{
+				"owner": "octocat",
+			},
+			expectError:    true,
+			expectedErrMsg: "issue types are only available for organizations; 'octocat' is a user account",
+		},
 		{
 			name: "missing owner parameter",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -5265,3 +7705,301 @@ func Test_ListIssueTypes(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListStaleIssues(t *testing.T) {
+	serverTool := ListStaleIssues(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_stale_issues", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "days"})
+
+	updatedAt := time.Now().UTC().AddDate(0, 0, -45)
+	searchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(1),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{
+				Number:    github.Ptr(7),
+				Title:     github.Ptr("Old bug"),
+				Body:      github.Ptr("long body that should be trimmed"),
+				State:     github.Ptr("open"),
+				HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/7"),
+				UpdatedAt: &github.Timestamp{Time: updatedAt},
+				Labels:    []*github.Label{{Name: github.Ptr("bug")}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		expectedQuery  string
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "open issues by default",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"days":  float64(30),
+			},
+			expectedQuery: "repo:owner/repo is:issue is:open updated:<=",
+		},
+		{
+			name: "closed issues",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"days":  float64(30),
+				"state": "closed",
+			},
+			expectedQuery: "repo:owner/repo is:issue is:closed updated:<=",
+		},
+		{
+			name: "days must be positive",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"days":  float64(-3),
+			},
+			expectError:    true,
+			expectedErrMsg: "days must be at least 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotQuery string
+			client := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchIssues: func(w http.ResponseWriter, r *http.Request) {
+					gotQuery = r.URL.Query().Get("q")
+					assert.Equal(t, "updated", r.URL.Query().Get("sort"))
+					assert.Equal(t, "asc", r.URL.Query().Get("order"))
+					mockResponse(t, http.StatusOK, searchResult)(w, r)
+				},
+			})
+			deps := BaseDeps{Client: mustNewGHClient(t, client)}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var resp MinimalStaleIssuesResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &resp))
+
+			// The cutoff is derived from the current date, so check it via
+			// the same parser the tool's date format targets.
+			cutoff, err := parseISOTimestamp(resp.Cutoff)
+			require.NoError(t, err)
+			assert.WithinDuration(t, time.Now().UTC().AddDate(0, 0, -30), cutoff, 48*time.Hour)
+			assert.Equal(t, tc.expectedQuery+resp.Cutoff, gotQuery)
+
+			require.Len(t, resp.Issues, 1)
+			assert.Equal(t, 7, resp.Issues[0].Number)
+			assert.Equal(t, []string{"bug"}, resp.Issues[0].Labels)
+			assert.Equal(t, 45, resp.Issues[0].DaysSinceUpdate)
+			assert.Equal(t, 1, resp.TotalCount)
+			assert.NotContains(t, getTextResult(t, result).Text, "long body")
+		})
+	}
+}
+
+func Test_IssueRef(t *testing.T) {
+	t.Run("REST issue prefers the repository it names", func(t *testing.T) {
+		issue := &github.Issue{
+			ID:            github.Ptr(int64(1001)),
+			NodeID:        github.Ptr("I_1001"),
+			Number:        github.Ptr(7),
+			Title:         github.Ptr("Crash on save"),
+			State:         github.Ptr("open"),
+			HTMLURL:       github.Ptr("https://github.com/octo-org/app/issues/7"),
+			RepositoryURL: github.Ptr("https://api.github.com/repos/octo-org/app"),
+		}
+		assert.Equal(t, IssueRef{
+			Owner:  "octo-org",
+			Repo:   "app",
+			Number: 7,
+			ID:     1001,
+			NodeID: "I_1001",
+			URL:    "https://github.com/octo-org/app/issues/7",
+			Title:  "Crash on save",
+			State:  "open",
+		}, issueRefFromIssue(issue, "owner", "repo"))
+
+		issue.RepositoryURL = nil
+		ref := issueRefFromIssue(issue, "owner", "repo")
+		assert.Equal(t, "owner", ref.Owner)
+		assert.Equal(t, "repo", ref.Repo)
+	})
+
+	t.Run("GraphQL issues use the same shape", func(t *testing.T) {
+		fragment := IssueFragment{
+			Number:     githubv4.Int(7),
+			Title:      githubv4.String("Crash on save"),
+			State:      githubv4.String("CLOSED"),
+			DatabaseID: 1001,
+			ID:         "I_1001",
+			URL:        githubv4.String("https://github.com/owner/repo/issues/7"),
+		}
+		response := convertToMinimalIssuesResponse(IssueQueryFragment{Nodes: []IssueFragment{fragment}}, "owner", "repo")
+		require.Len(t, response.Issues, 1)
+		assert.Equal(t, &IssueRef{
+			Owner:  "owner",
+			Repo:   "repo",
+			Number: 7,
+			ID:     1001,
+			NodeID: "I_1001",
+			URL:    "https://github.com/owner/repo/issues/7",
+			Title:  "Crash on save",
+			State:  "closed",
+		}, response.Issues[0].IssueRef)
+	})
+
+	issue := &github.Issue{
+		ID:            github.Ptr(int64(1001)),
+		Number:        github.Ptr(7),
+		Title:         github.Ptr("Crash on save"),
+		State:         github.Ptr("open"),
+		HTMLURL:       github.Ptr("https://github.com/owner/repo/issues/7"),
+		RepositoryURL: github.Ptr("https://api.github.com/repos/owner/repo"),
+		User:          &github.User{Login: github.Ptr("octocat")},
+	}
+	wantRef := map[string]any{
+		"owner":  "owner",
+		"repo":   "repo",
+		"number": float64(7),
+		"id":     float64(1001),
+		"url":    "https://github.com/owner/repo/issues/7",
+		"title":  "Crash on save",
+		"state":  "open",
+	}
+
+	tests := []struct {
+		name     string
+		tool     inventory.ServerTool
+		handlers map[string]http.HandlerFunc
+		args     map[string]any
+		// refs extracts the issue_ref objects from the decoded output.
+		refs func(t *testing.T, output any) []any
+	}{
+		{
+			name: "issue_read get",
+			tool: IssueRead(translations.NullTranslationHelper),
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, issue),
+			},
+			args: map[string]any{"method": "get", "owner": "owner", "repo": "repo", "issue_number": float64(7)},
+			refs: func(_ *testing.T, output any) []any {
+				return []any{output.(map[string]any)["issue_ref"]}
+			},
+		},
+		{
+			name: "issue_read get_sub_issues",
+			tool: IssueRead(translations.NullTranslationHelper),
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, []*github.Issue{issue}),
+			},
+			args: map[string]any{"method": "get_sub_issues", "owner": "owner", "repo": "repo", "issue_number": float64(1)},
+			refs: func(_ *testing.T, output any) []any {
+				var refs []any
+				for _, item := range output.([]any) {
+					refs = append(refs, item.(map[string]any)["issue_ref"])
+				}
+				return refs
+			},
+		},
+		{
+			name: "search_issues",
+			tool: SearchIssues(translations.NullTranslationHelper),
+			handlers: map[string]http.HandlerFunc{
+				GetSearchIssues: mockResponse(t, http.StatusOK, &github.IssuesSearchResult{Total: github.Ptr(1), Issues: []*github.Issue{issue}}),
+			},
+			args: map[string]any{"query": "crash"},
+			refs: func(_ *testing.T, output any) []any {
+				var refs []any
+				for _, item := range output.(map[string]any)["items"].([]any) {
+					refs = append(refs, item.(map[string]any)["issue_ref"])
+				}
+				return refs
+			},
+		},
+		{
+			name: "sub_issue_write add",
+			tool: SubIssueWrite(translations.NullTranslationHelper),
+			handlers: map[string]http.HandlerFunc{
+				PostReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusCreated, issue),
+			},
+			args: map[string]any{"method": "add", "owner": "owner", "repo": "repo", "issue_number": float64(7), "sub_issue_id": float64(2002)},
+			refs: func(_ *testing.T, output any) []any {
+				return []any{output.(map[string]any)["issue_ref"]}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))}
+			handler := tc.tool.Handler(deps)
+
+			request := createMCPRequest(tc.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var output any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &output))
+			refs := tc.refs(t, output)
+			require.Len(t, refs, 1)
+			assert.Equal(t, wantRef, refs[0])
+		})
+	}
+}
+
+func Test_DuplicateOfParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		expected    issueReference
+		expectedErr string
+	}{
+		{name: "absent", value: nil, expected: issueReference{}},
+		{name: "number", value: float64(456), expected: issueReference{Owner: "owner", Repo: "repo", Number: 456}},
+		{name: "numeric string", value: "456", expected: issueReference{Owner: "owner", Repo: "repo", Number: 456}},
+		{name: "hash reference", value: "#456", expected: issueReference{Owner: "owner", Repo: "repo", Number: 456}},
+		{name: "cross-repository reference", value: " other/tracker.js#456 ", expected: issueReference{Owner: "other", Repo: "tracker.js", Number: 456}},
+		{name: "fractional number", value: 4.5, expectedErr: "duplicate_of must be a positive issue number, got 4.5"},
+		{name: "zero", value: "#0", expectedErr: `duplicate_of must be a positive issue number, got "#0"`},
+		{name: "missing number", value: "other/tracker#", expectedErr: `reference like "owner/repo#123", got "other/tracker#"`},
+		{name: "repository without hash", value: "other/tracker456", expectedErr: `got "other/tracker456"`},
+		{name: "issue URL", value: "https://github.com/other/tracker/issues/456", expectedErr: `got "https://github.com/other/tracker/issues/456"`},
+		{name: "wrong type", value: true, expectedErr: "got bool"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			args := map[string]any{}
+			if tc.value != nil {
+				args["duplicate_of"] = tc.value
+			}
+			ref, err := duplicateOfParam(args, "owner", "repo")
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ref)
+		})
+	}
+}
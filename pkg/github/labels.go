@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"unicode"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/ifc"
@@ -12,6 +14,7 @@ import (
 	"github.com/github/github-mcp-server/pkg/scopes"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/shurcooL/githubv4"
@@ -218,6 +221,139 @@ func ListLabels(t translations.TranslationHelperFunc) inventory.ServerTool {
 	)
 }
 
+// IssueLabel is a label in a list_labels_on_issue response.
+type IssueLabel struct {
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ListLabelsOnIssue lists the labels applied to an issue, or, given has_label,
+// answers only whether one particular label is applied.
+func ListLabelsOnIssue(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner (username or organization name)",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"issue_number": {
+				Type:        "number",
+				Description: "Issue or pull request number",
+			},
+			"has_label": {
+				Type:        "string",
+				Description: "Label name to check for, case-insensitively. When set, only {\"has_label\": true|false} is returned and pagination is ignored.",
+			},
+		},
+		Required: []string{"owner", "repo", "issue_number"},
+	}
+	WithPagination(schema)
+
+	return NewTool(
+		ToolsetLabels,
+		mcp.Tool{
+			Name:        "list_labels_on_issue",
+			Description: t("TOOL_LIST_LABELS_ON_ISSUE_DESCRIPTION", "List the labels applied to an issue or pull request with their colors and descriptions. To check whether a single label is applied, pass has_label instead of fetching the whole issue."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_LABELS_ON_ISSUE_USER_TITLE", "List labels on an issue"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			hasLabel, err := OptionalParam[string](args, "has_label")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			var result *mcp.CallToolResult
+			if hasLabel != "" {
+				result, err = issueHasLabel(ctx, client, owner, repo, issueNumber, hasLabel)
+			} else {
+				result, err = listLabelsOnIssue(ctx, client, owner, repo, issueNumber, &github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				})
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			// Labels are structural repo metadata defined by collaborators
+			// (trusted); confidentiality follows repo visibility.
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
+			return result, nil, nil
+		},
+	)
+}
+
+func listLabelsOnIssue(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, opts *github.ListOptions) (*mcp.CallToolResult, error) {
+	labels, resp, err := client.Issues.ListLabelsByIssue(ctx, owner, repo, issueNumber, opts)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list labels on issue", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	issueLabels := make([]IssueLabel, 0, len(labels))
+	for _, label := range labels {
+		issueLabels = append(issueLabels, IssueLabel{
+			Name:        label.GetName(),
+			Color:       label.GetColor(),
+			Description: label.GetDescription(),
+		})
+	}
+	return MarshalledTextResult(issueLabels), nil
+}
+
+// issueHasLabel pages through an issue's labels until it finds name.
+func issueHasLabel(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, name string) (*mcp.CallToolResult, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		labels, resp, err := client.Issues.ListLabelsByIssue(ctx, owner, repo, issueNumber, opts)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list labels on issue", resp, err), nil
+		}
+		_ = resp.Body.Close()
+
+		for _, label := range labels {
+			if strings.EqualFold(label.GetName(), name) {
+				return MarshalledTextResult(map[string]bool{"has_label": true}), nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return MarshalledTextResult(map[string]bool{"has_label": false}), nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // LabelWrite handles create, update, and delete operations for GitHub labels
 func LabelWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
@@ -410,6 +546,288 @@ func LabelWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 	)
 }
 
+const (
+	// maxLabelSuggestions caps the number of candidates returned by suggest_labels.
+	maxLabelSuggestions = 5
+	// labelSuggestionSimilarIssues is the number of similar issues inspected for label usage.
+	labelSuggestionSimilarIssues = 10
+	// labelSuggestionSearchKeywords is the number of title keywords used to find similar issues.
+	labelSuggestionSearchKeywords = 5
+)
+
+// labelSuggestionStopWords are common words ignored when matching issue text against labels.
+var labelSuggestionStopWords = map[string]struct{}{
+	"the": {}, "and": {}, "for": {}, "with": {}, "this": {}, "that": {}, "from": {}, "are": {},
+	"was": {}, "not": {}, "but": {}, "when": {}, "what": {}, "have": {}, "has": {}, "can": {},
+	"should": {}, "would": {}, "could": {}, "into": {}, "there": {}, "their": {}, "about": {},
+	"will": {}, "does": {}, "did": {}, "issue": {}, "issues": {}, "please": {}, "using": {},
+}
+
+// LabelSuggestion is a candidate label produced by suggest_labels.
+type LabelSuggestion struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Score       int      `json:"score"`
+	Reasons     []string `json:"reasons"`
+}
+
+// labelCandidate is a repository label considered for suggestion.
+type labelCandidate struct {
+	Name        string
+	Description string
+}
+
+// SuggestLabels creates a tool that suggests existing repository labels for an issue.
+func SuggestLabels(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetLabels,
+		mcp.Tool{
+			Name: "suggest_labels",
+			Description: t("TOOL_SUGGEST_LABELS_DESCRIPTION", "Suggest up to 5 existing repository labels for an issue, based on keyword matches against label names and descriptions and on the labels applied to similar issues. "+
+				"Provide either issue_number or title/body text. Scoring is a deterministic heuristic; use the returned reasons to decide which labels to apply."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_SUGGEST_LABELS_USER_TITLE", "Suggest labels for an issue"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization name)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "Existing issue to suggest labels for. Labels already applied to it are not suggested.",
+					},
+					"title": {
+						Type:        "string",
+						Description: "Issue title. Used when issue_number is not provided.",
+					},
+					"body": {
+						Type:        "string",
+						Description: "Issue body. Used when issue_number is not provided.",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := OptionalIntParam(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			title, err := OptionalParam[string](args, "title")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			body, err := OptionalParam[string](args, "body")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if issueNumber == 0 && title == "" && body == "" {
+				return utils.NewToolResultError("either issue_number or title/body must be provided"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			applied := map[string]struct{}{}
+			if issueNumber != 0 {
+				issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+				title, body = issue.GetTitle(), issue.GetBody()
+				for _, label := range issue.Labels {
+					applied[strings.ToLower(label.GetName())] = struct{}{}
+				}
+			}
+
+			var query struct {
+				Repository struct {
+					Labels struct {
+						Nodes []struct {
+							Name        githubv4.String
+							Description githubv4.String
+						}
+					} `graphql:"labels(first: 100)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}
+			vars := map[string]any{
+				"owner": githubv4.String(owner),
+				"repo":  githubv4.String(repo),
+			}
+			if err := gqlClient.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to list labels", err), nil, nil
+			}
+			candidates := make([]labelCandidate, 0, len(query.Repository.Labels.Nodes))
+			for _, node := range query.Repository.Labels.Nodes {
+				if _, ok := applied[strings.ToLower(string(node.Name))]; ok {
+					continue
+				}
+				candidates = append(candidates, labelCandidate{Name: string(node.Name), Description: string(node.Description)})
+			}
+
+			// Similar issues only sharpen the ranking, so a failed search degrades to
+			// keyword matching rather than failing the whole call.
+			var similarIssueLabels [][]string
+			if keywords := labelSuggestionKeywords(title, labelSuggestionSearchKeywords); len(keywords) > 0 {
+				searchQuery := fmt.Sprintf("repo:%s/%s is:issue %s", owner, repo, strings.Join(keywords, " OR "))
+				opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: labelSuggestionSimilarIssues}}
+				result, resp, err := client.Search.Issues(ctx, searchQuery, opts)
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+				if err == nil {
+					for _, issue := range result.Issues {
+						if issue.GetNumber() == issueNumber {
+							continue
+						}
+						names := make([]string, 0, len(issue.Labels))
+						for _, label := range issue.Labels {
+							names = append(names, label.GetName())
+						}
+						similarIssueLabels = append(similarIssueLabels, names)
+					}
+				}
+			}
+
+			response := map[string]any{
+				"suggestions":               scoreLabelSuggestions(title+"\n"+body, candidates, similarIssueLabels),
+				"similar_issues_considered": len(similarIssueLabels),
+			}
+			out, err := json.Marshal(response)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal label suggestions: %w", err)
+			}
+
+			result := utils.NewToolResultText(string(out))
+			result = attachRepoVisibilityIFCLabelLazy(ctx, deps, owner, repo, result, ifc.LabelRepoMetadata)
+			return result, nil, nil
+		},
+	)
+}
+
+// tokenizeLabelText lowercases text and splits it into distinct words of at least three
+// characters, skipping stop words. Order of first appearance is preserved.
+func tokenizeLabelText(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	seen := make(map[string]struct{}, len(fields))
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if len(field) < 3 {
+			continue
+		}
+		if _, stop := labelSuggestionStopWords[field]; stop {
+			continue
+		}
+		if _, dup := seen[field]; dup {
+			continue
+		}
+		seen[field] = struct{}{}
+		tokens = append(tokens, field)
+	}
+	return tokens
+}
+
+// labelSuggestionKeywords returns up to limit keywords from an issue title for the
+// similar-issue search.
+func labelSuggestionKeywords(title string, limit int) []string {
+	keywords := tokenizeLabelText(title)
+	if len(keywords) > limit {
+		keywords = keywords[:limit]
+	}
+	return keywords
+}
+
+// scoreLabelSuggestions ranks candidate labels against issue text. Each label name word
+// found in the text scores 3, each distinct description word found scores 1, and each
+// similar issue carrying the label scores 2. Ties are broken by label name so the output
+// is deterministic. At most maxLabelSuggestions labels with a positive score are returned.
+func scoreLabelSuggestions(text string, candidates []labelCandidate, similarIssueLabels [][]string) []LabelSuggestion {
+	textTokens := make(map[string]struct{})
+	for _, token := range tokenizeLabelText(text) {
+		textTokens[token] = struct{}{}
+	}
+
+	similarCounts := make(map[string]int)
+	for _, labels := range similarIssueLabels {
+		for _, name := range labels {
+			similarCounts[strings.ToLower(name)]++
+		}
+	}
+
+	suggestions := make([]LabelSuggestion, 0, len(candidates))
+	for _, candidate := range candidates {
+		suggestion := LabelSuggestion{Name: candidate.Name, Description: candidate.Description, Reasons: []string{}}
+
+		var nameMatches []string
+		for _, token := range tokenizeLabelText(candidate.Name) {
+			if _, ok := textTokens[token]; ok {
+				nameMatches = append(nameMatches, token)
+			}
+		}
+		if len(nameMatches) > 0 {
+			suggestion.Score += 3 * len(nameMatches)
+			suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("label name matches: %s", strings.Join(nameMatches, ", ")))
+		}
+
+		var descriptionMatches []string
+		for _, token := range tokenizeLabelText(candidate.Description) {
+			if _, ok := textTokens[token]; ok {
+				descriptionMatches = append(descriptionMatches, token)
+			}
+		}
+		if len(descriptionMatches) > 0 {
+			suggestion.Score += len(descriptionMatches)
+			suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("description matches: %s", strings.Join(descriptionMatches, ", ")))
+		}
+
+		if count := similarCounts[strings.ToLower(candidate.Name)]; count > 0 {
+			suggestion.Score += 2 * count
+			suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("applied to %d of %d similar issues", count, len(similarIssueLabels)))
+		}
+
+		if suggestion.Score > 0 {
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Name < suggestions[j].Name
+	})
+	if len(suggestions) > maxLabelSuggestions {
+		suggestions = suggestions[:maxLabelSuggestions]
+	}
+	return suggestions
+}
+
 // Helper function to get repository ID
 func getRepositoryID(ctx context.Context, client *githubv4.Client, owner, repo string) (githubv4.ID, error) {
 	var repoQuery struct {
@@ -2,12 +2,15 @@ package github
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -236,6 +239,111 @@ func TestListLabels(t *testing.T) {
 	}
 }
 
+func TestListLabelsOnIssue(t *testing.T) {
+	t.Parallel()
+
+	serverTool := ListLabelsOnIssue(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_labels_on_issue", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	// Two pages of labels: page 2 is only reachable through the Link header.
+	pages := map[string][]*github.Label{
+		"":  {{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a"), Description: github.Ptr("Something isn't working")}},
+		"2": {{Name: github.Ptr("Regression"), Color: github.Ptr("b60205")}},
+	}
+	labelsHandler := func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		if page == "" || page == "1" {
+			page = ""
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/issues/42/labels?page=2>; rel="next"`)
+		}
+		_ = json.NewEncoder(w).Encode(pages[page])
+	}
+
+	tests := []struct {
+		name           string
+		args           map[string]any
+		expectedLabels []IssueLabel
+		expectedHas    *bool
+		expectedQuery  string
+	}{
+		{
+			name:           "lists labels with colors and descriptions",
+			args:           map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42)},
+			expectedLabels: []IssueLabel{{Name: "bug", Color: "d73a4a", Description: "Something isn't working"}},
+			expectedQuery:  "page=1&per_page=30",
+		},
+		{
+			name:           "passes pagination through",
+			args:           map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42), "page": float64(2), "perPage": float64(5)},
+			expectedLabels: []IssueLabel{{Name: "Regression", Color: "b60205"}},
+			expectedQuery:  "page=2&per_page=5",
+		},
+		{
+			name:          "has_label matches case-insensitively across pages",
+			args:          map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42), "has_label": "regression"},
+			expectedHas:   github.Ptr(true),
+			expectedQuery: "page=2&per_page=100",
+		},
+		{
+			name:          "has_label reports a missing label",
+			args:          map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42), "has_label": "wontfix"},
+			expectedHas:   github.Ptr(false),
+			expectedQuery: "page=2&per_page=100",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var lastQuery string
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/issues/42/labels": func(w http.ResponseWriter, r *http.Request) {
+					lastQuery = r.URL.RawQuery
+					labelsHandler(w, r)
+				},
+			}))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Equal(t, tc.expectedQuery, lastQuery)
+
+			text := getTextResult(t, result).Text
+			if tc.expectedHas != nil {
+				assert.JSONEq(t, fmt.Sprintf(`{"has_label": %t}`, *tc.expectedHas), text)
+				return
+			}
+			var labels []IssueLabel
+			require.NoError(t, json.Unmarshal([]byte(text), &labels))
+			assert.Equal(t, tc.expectedLabels, labels)
+		})
+	}
+
+	t.Run("API error", func(t *testing.T) {
+		t.Parallel()
+
+		client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			"GET /repos/owner/repo/issues/42/labels": mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+		}))
+		deps := BaseDeps{Client: client}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42), "has_label": "bug"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to list labels on issue")
+	})
+}
+
 func TestWriteLabel(t *testing.T) {
 	t.Parallel()
 
@@ -486,3 +594,214 @@ func TestWriteLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestScoreLabelSuggestions(t *testing.T) {
+	t.Parallel()
+
+	candidates := []labelCandidate{
+		{Name: "bug", Description: "Something isn't working"},
+		{Name: "documentation", Description: "Improvements or additions to documentation"},
+		{Name: "performance", Description: "Slow queries, memory usage or latency"},
+		{Name: "good first issue", Description: "Good for newcomers"},
+		{Name: "area: api", Description: "REST and GraphQL API surface"},
+		{Name: "wontfix", Description: "This will not be worked on"},
+	}
+
+	tests := []struct {
+		name          string
+		text          string
+		similar       [][]string
+		expectedNames []string
+		expectedFirst LabelSuggestion
+	}{
+		{
+			name:          "name match outranks description match",
+			text:          "Bug: API returns 500 with high latency",
+			expectedNames: []string{"area: api", "bug", "performance"},
+			expectedFirst: LabelSuggestion{
+				Name:        "area: api",
+				Description: "REST and GraphQL API surface",
+				Score:       4,
+				Reasons:     []string{"label name matches: api", "description matches: api"},
+			},
+		},
+		{
+			name:          "similar issues add weight",
+			text:          "Memory usage grows over time",
+			similar:       [][]string{{"performance", "bug"}, {"performance"}, {"Bug"}},
+			expectedNames: []string{"performance", "bug"},
+			expectedFirst: LabelSuggestion{
+				Name:        "performance",
+				Description: "Slow queries, memory usage or latency",
+				Score:       6,
+				Reasons:     []string{"description matches: memory, usage", "applied to 2 of 3 similar issues"},
+			},
+		},
+		{
+			name:          "ties are broken by name",
+			text:          "newcomers working",
+			expectedNames: []string{"bug", "good first issue"},
+		},
+		{
+			name:          "no matches returns no suggestions",
+			text:          "Unrelated text",
+			expectedNames: []string{},
+		},
+		{
+			name:          "results are capped",
+			text:          "bug documentation performance good first api wontfix",
+			expectedNames: []string{"good first issue", "area: api", "documentation", "bug", "performance"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			suggestions := scoreLabelSuggestions(tc.text, candidates, tc.similar)
+			names := make([]string, 0, len(suggestions))
+			for _, s := range suggestions {
+				names = append(names, s.Name)
+			}
+			assert.Equal(t, tc.expectedNames, names)
+			if tc.expectedFirst.Name != "" {
+				require.NotEmpty(t, suggestions)
+				assert.Equal(t, tc.expectedFirst, suggestions[0])
+			}
+		})
+	}
+}
+
+func TestSuggestLabels(t *testing.T) {
+	t.Parallel()
+
+	serverTool := SuggestLabels(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "suggest_labels", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "suggest_labels tool should be read-only")
+
+	labelsQuery := struct {
+		Repository struct {
+			Labels struct {
+				Nodes []struct {
+					Name        githubv4.String
+					Description githubv4.String
+				}
+			} `graphql:"labels(first: 100)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}{}
+	labelsResponse := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"labels": map[string]any{
+				"nodes": []any{
+					map[string]any{"name": "bug", "description": "Something isn't working"},
+					map[string]any{"name": "crash", "description": "Application crashes"},
+					map[string]any{"name": "enhancement", "description": "New feature or request"},
+				},
+			},
+		},
+	})
+	newGQLClient := func() *githubv4.Client {
+		return githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(labelsQuery, map[string]any{
+				"owner": githubv4.String("owner"),
+				"repo":  githubv4.String("repo"),
+			}, labelsResponse),
+		))
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]any
+		restHandlers    map[string]http.HandlerFunc
+		expectToolError bool
+		expectedErrMsg  string
+		expectedNames   []string
+	}{
+		{
+			name: "suggests labels for raw text using similar issues",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Crash when saving settings",
+			},
+			restHandlers: map[string]http.HandlerFunc{
+				GetSearchIssues: expectQueryParams(t, map[string]string{
+					"q":        "repo:owner/repo is:issue crash OR saving OR settings",
+					"per_page": "10",
+				}).andThen(mockResponse(t, http.StatusOK, &github.IssuesSearchResult{
+					Total: github.Ptr(1),
+					Issues: []*github.Issue{
+						{Number: github.Ptr(3), Labels: []*github.Label{{Name: github.Ptr("bug")}}},
+					},
+				})),
+			},
+			expectedNames: []string{"crash", "bug"},
+		},
+		{
+			name: "skips labels already applied to the issue",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			restHandlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, &github.Issue{
+					Number: github.Ptr(42),
+					Title:  github.Ptr("Crash on startup"),
+					Body:   github.Ptr("It's a bug"),
+					Labels: []*github.Label{{Name: github.Ptr("crash")}},
+				}),
+				GetSearchIssues: mockResponse(t, http.StatusOK, &github.IssuesSearchResult{
+					Total:  github.Ptr(1),
+					Issues: []*github.Issue{{Number: github.Ptr(42), Labels: []*github.Label{{Name: github.Ptr("crash")}}}},
+				}),
+			},
+			expectedNames: []string{"bug"},
+		},
+		{
+			name: "missing issue text",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "either issue_number or title/body must be provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deps := BaseDeps{
+				Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(tc.restHandlers)),
+				GQLClient: newGQLClient(),
+				Flags:     stubFeatureFlags(map[string]bool{}),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				textContent := getErrorResult(t, result)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response struct {
+				Suggestions []LabelSuggestion `json:"suggestions"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			names := make([]string, 0, len(response.Suggestions))
+			for _, s := range response.Suggestions {
+				names = append(names, s.Name)
+			}
+			assert.Equal(t, tc.expectedNames, names)
+		})
+	}
+}
@@ -48,6 +48,19 @@ type MinimalRepository struct {
 	DefaultBranch string   `json:"default_branch,omitempty"`
 }
 
+// MinimalRepositoryMetadata is the trimmed output type for get_repository.
+type MinimalRepositoryMetadata struct {
+	FullName        string   `json:"full_name"`
+	Description     string   `json:"description,omitempty"`
+	DefaultBranch   string   `json:"default_branch"`
+	Visibility      string   `json:"visibility"`
+	Archived        bool     `json:"archived"`
+	HasIssues       bool     `json:"has_issues"`
+	HasProjects     bool     `json:"has_projects"`
+	OpenIssuesCount int      `json:"open_issues_count"`
+	Topics          []string `json:"topics,omitempty"`
+}
+
 // MinimalSearchRepositoriesResult is the trimmed output type for repository search results.
 type MinimalSearchRepositoriesResult struct {
 	TotalCount        int                 `json:"total_count"`
@@ -191,6 +204,15 @@ type MinimalTag struct {
 type MinimalResponse struct {
 	ID  string `json:"id"`
 	URL string `json:"url"`
+	// Deduplicated is set when an existing resource was returned in place of
+	// creating a new one.
+	Deduplicated bool `json:"deduplicated,omitempty"`
+	// ProjectItemID is the project item created when a new issue was also
+	// added to a project.
+	ProjectItemID int64 `json:"project_item_id,omitempty"`
+	// Warning reports a follow-up step that failed after the resource was
+	// created.
+	Warning string `json:"warning,omitempty"`
 }
 
 // MinimalCollaborator is the trimmed output type for repository collaborators.
@@ -219,15 +241,22 @@ type MinimalProject struct {
 }
 
 type MinimalProjectItem struct {
-	ID          int64                          `json:"id"`
-	NodeID      string                         `json:"node_id,omitempty"`
-	ContentType string                         `json:"content_type,omitempty"`
-	Content     *MinimalProjectItemContent     `json:"content,omitempty"`
-	Fields      []MinimalProjectItemFieldValue `json:"fields,omitempty"`
-	ArchivedAt  string                         `json:"archived_at,omitempty"`
-	CreatedAt   string                         `json:"created_at,omitempty"`
-	UpdatedAt   string                         `json:"updated_at,omitempty"`
-	Creator     string                         `json:"creator,omitempty"`
+	ID     int64  `json:"id"`
+	NodeID string `json:"node_id,omitempty"`
+	// ProjectNodeID is the GraphQL node ID of the project the item is in.
+	ProjectNodeID string                         `json:"project_node_id,omitempty"`
+	ContentType   string                         `json:"content_type,omitempty"`
+	Content       *MinimalProjectItemContent     `json:"content,omitempty"`
+	Fields        []MinimalProjectItemFieldValue `json:"fields,omitempty"`
+	ArchivedAt    string                         `json:"archived_at,omitempty"`
+	CreatedAt     string                         `json:"created_at,omitempty"`
+	UpdatedAt     string                         `json:"updated_at,omitempty"`
+	Creator       string                         `json:"creator,omitempty"`
+	ItemURL       string                         `json:"item_url,omitempty"`
+	ProjectURL    string                         `json:"project_url,omitempty"`
+	// Position is the 1-based position of the item in the project's order.
+	// It is only known on the first page of an unfiltered listing.
+	Position int `json:"position,omitempty"`
 }
 
 type MinimalProjectItemContent struct {
@@ -318,11 +347,30 @@ type MinimalFieldValue struct {
 	Values []string `json:"values,omitempty"`
 }
 
+// MinimalLabel is the trimmed output type for a label attached to an issue.
+type MinimalLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+// labelNames returns the names of labels, in order.
+func labelNames(labels []MinimalLabel) []string {
+	if len(labels) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
+
 // MinimalIssue is the trimmed output type for issue objects to reduce verbosity.
 type MinimalIssue struct {
 	Number            int                      `json:"number"`
 	Title             string                   `json:"title"`
 	Body              string                   `json:"body,omitempty"`
+	BodyTruncated     bool                     `json:"body_truncated,omitempty"`
 	State             string                   `json:"state"`
 	StateReason       string                   `json:"state_reason,omitempty"`
 	Draft             bool                     `json:"draft,omitempty"`
@@ -330,7 +378,7 @@ type MinimalIssue struct {
 	HTMLURL           string                   `json:"html_url,omitempty"`
 	User              *MinimalUser             `json:"user,omitempty"`
 	AuthorAssociation string                   `json:"author_association,omitempty"`
-	Labels            []string                 `json:"labels,omitempty"`
+	Labels            []MinimalLabel           `json:"labels,omitempty"`
 	Assignees         []string                 `json:"assignees,omitempty"`
 	Milestone         string                   `json:"milestone,omitempty"`
 	Comments          int                      `json:"comments,omitempty"`
@@ -351,6 +399,28 @@ type MinimalIssue struct {
 	HasChildren      *bool                    `json:"has_children,omitempty"`
 	Parent           *MinimalIssueRef         `json:"parent,omitempty"`
 	SubIssuesSummary *MinimalSubIssuesSummary `json:"sub_issues_summary,omitempty"`
+
+	// LinkedPullRequests lists the pull requests that will close the issue when merged.
+	// It is only populated by the GraphQL-backed (rich) issue_read `get` path.
+	LinkedPullRequests []MinimalLinkedPullRequest `json:"linked_pull_requests,omitempty"`
+
+	// IssueRef identifies the issue in the shape shared by all issue tools.
+	IssueRef *IssueRef `json:"issue_ref,omitempty"`
+}
+
+// MinimalLinkedPullRequest is a compact reference to a pull request linked to an issue.
+// HeadRef and Source are only set by ListIssueLinkedPullRequests, where Source is
+// "closing" for pull requests that will close the issue when merged and "connected"
+// for ones only linked from the issue's timeline.
+type MinimalLinkedPullRequest struct {
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	Draft      bool   `json:"draft,omitempty"`
+	URL        string `json:"url"`
+	HeadRef    string `json:"head_ref,omitempty"`
+	Repository string `json:"repository,omitempty"`
+	Source     string `json:"source,omitempty"`
 }
 
 // MinimalIssueRef is a compact reference to a related issue (e.g. a parent issue).
@@ -363,6 +433,69 @@ type MinimalIssueRef struct {
 	Repository string `json:"repository,omitempty"`
 }
 
+// IssueRef identifies an issue in one shape across the issue tools, so that an
+// issue returned by one tool can be passed to the next without reshaping. ID is
+// the REST ID and NodeID the GraphQL ID; State is "open" or "closed" whichever
+// API the issue came from.
+type IssueRef struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Number int    `json:"number"`
+	ID     int64  `json:"id,omitempty"`
+	NodeID string `json:"node_id,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+}
+
+// IssueWithRef is a REST issue object with its IssueRef added, for tools that
+// return GitHub's issue objects as they are.
+type IssueWithRef struct {
+	*github.Issue
+	IssueRef IssueRef `json:"issue_ref"`
+}
+
+// newIssueWithRef pairs a REST issue of owner/repo with its IssueRef.
+func newIssueWithRef(issue *github.Issue, owner, repo string) IssueWithRef {
+	return IssueWithRef{Issue: issue, IssueRef: issueRefFromIssue(issue, owner, repo)}
+}
+
+// issueRefFromIssue builds an IssueRef from a REST issue. The repository is
+// read from the issue when it names one, so that search hits spanning several
+// repositories are attributed correctly, and is owner/repo otherwise.
+func issueRefFromIssue(issue *github.Issue, owner, repo string) IssueRef {
+	if r := issue.GetRepository(); r.GetName() != "" && r.GetOwner().GetLogin() != "" {
+		owner, repo = r.GetOwner().GetLogin(), r.GetName()
+	} else if o, n, ok := parseRepositoryURL(issue.GetRepositoryURL()); ok {
+		owner, repo = o, n
+	}
+	return IssueRef{
+		Owner:  owner,
+		Repo:   repo,
+		Number: issue.GetNumber(),
+		ID:     issue.GetID(),
+		NodeID: issue.GetNodeID(),
+		URL:    issue.GetHTMLURL(),
+		Title:  issue.GetTitle(),
+		State:  strings.ToLower(issue.GetState()),
+	}
+}
+
+// issueRefFromFragment builds an IssueRef from a GraphQL issue of owner/repo.
+func issueRefFromFragment(fragment IssueFragment, owner, repo string) IssueRef {
+	nodeID, _ := fragment.ID.(string)
+	return IssueRef{
+		Owner:  owner,
+		Repo:   repo,
+		Number: int(fragment.Number),
+		ID:     fragment.DatabaseID,
+		NodeID: nodeID,
+		URL:    string(fragment.URL),
+		Title:  sanitize.Sanitize(string(fragment.Title)),
+		State:  strings.ToLower(string(fragment.State)),
+	}
+}
+
 // MinimalSubIssuesSummary holds the native GraphQL subIssuesSummary counts for an issue.
 type MinimalSubIssuesSummary struct {
 	Total            int `json:"total"`
@@ -375,6 +508,40 @@ type MinimalIssuesResponse struct {
 	Issues     []MinimalIssue  `json:"issues"`
 	TotalCount int             `json:"totalCount"`
 	PageInfo   MinimalPageInfo `json:"pageInfo"`
+	// Groups maps label names to issue numbers when group_by_label is set.
+	Groups map[string][]int `json:"groups,omitempty"`
+	// SyncToken resumes an incremental sync when passed back as sync_token.
+	SyncToken string `json:"sync_token,omitempty"`
+	// Partial is set when GraphQL returned errors alongside the issues, which
+	// may then lack the fields that failed. PartialErrors holds the errors.
+	Partial       bool     `json:"partial,omitempty"`
+	PartialErrors []string `json:"partial_errors,omitempty"`
+}
+
+// MinimalIssuesCountResponse is the output of list_issues with count_only set.
+type MinimalIssuesCountResponse struct {
+	TotalCount int `json:"totalCount"`
+}
+
+// MinimalStaleIssue is the trimmed output type for list_stale_issues entries.
+type MinimalStaleIssue struct {
+	Number          int      `json:"number"`
+	Title           string   `json:"title"`
+	State           string   `json:"state"`
+	HTMLURL         string   `json:"html_url"`
+	UpdatedAt       string   `json:"updated_at"`
+	DaysSinceUpdate int      `json:"days_since_update"`
+	Labels          []string `json:"labels,omitempty"`
+	Assignees       []string `json:"assignees,omitempty"`
+}
+
+// MinimalStaleIssuesResponse is the output type for list_stale_issues.
+type MinimalStaleIssuesResponse struct {
+	// Cutoff is the YYYY-MM-DD date issues must not have been updated after.
+	Cutoff            string              `json:"cutoff"`
+	TotalCount        int                 `json:"total_count"`
+	IncompleteResults bool                `json:"incomplete_results,omitempty"`
+	Issues            []MinimalStaleIssue `json:"issues"`
 }
 
 // MinimalIssueComment is the trimmed output type for issue comment objects to reduce verbosity.
@@ -384,9 +551,21 @@ type MinimalIssueComment struct {
 	HTMLURL           string            `json:"html_url"`
 	User              *MinimalUser      `json:"user,omitempty"`
 	AuthorAssociation string            `json:"author_association,omitempty"`
+	IsBot             bool              `json:"is_bot"`
 	Reactions         *MinimalReactions `json:"reactions,omitempty"`
-	CreatedAt         string            `json:"created_at,omitempty"`
-	UpdatedAt         string            `json:"updated_at,omitempty"`
+	// ReactionGroups is only set when reactions were requested explicitly.
+	ReactionGroups     []CommentReactionGroup `json:"reaction_groups,omitempty"`
+	ReactionsTruncated bool                   `json:"reactions_truncated,omitempty"`
+	CreatedAt          string                 `json:"created_at,omitempty"`
+	UpdatedAt          string                 `json:"updated_at,omitempty"`
+}
+
+// CommentReactionGroup is the reactions of one type on a comment, with the
+// logins of the users who reacted.
+type CommentReactionGroup struct {
+	Content string   `json:"content"`
+	Count   int      `json:"count"`
+	Users   []string `json:"users"`
 }
 
 // MinimalSearchCommitsResult is the trimmed output type for commit search results.
@@ -461,6 +640,13 @@ type MinimalPRBranchRepo struct {
 	Description string `json:"description,omitempty"`
 }
 
+// MinimalProjectWorkflow is the trimmed output type for a built-in project workflow.
+type MinimalProjectWorkflow struct {
+	Number  int    `json:"number"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
 type MinimalProjectStatusUpdate struct {
 	ID         string       `json:"id"`
 	Body       string       `json:"body,omitempty"`
@@ -530,7 +716,7 @@ func convertToMinimalIssue(issue *github.Issue) MinimalIssue {
 
 	for _, label := range issue.Labels {
 		if label != nil {
-			m.Labels = append(m.Labels, label.GetName())
+			m.Labels = append(m.Labels, MinimalLabel{Name: label.GetName(), Color: label.GetColor()})
 		}
 	}
 
@@ -603,8 +789,12 @@ func fragmentToMinimalIssue(fragment IssueFragment) MinimalIssue {
 		},
 	}
 
+	if fragment.ClosedAt != nil {
+		m.ClosedAt = fragment.ClosedAt.Format(time.RFC3339)
+	}
+
 	for _, label := range fragment.Labels.Nodes {
-		m.Labels = append(m.Labels, string(label.Name))
+		m.Labels = append(m.Labels, MinimalLabel{Name: string(label.Name), Color: string(label.Color)})
 	}
 
 	for _, fv := range fragment.IssueFieldValues.Nodes {
@@ -644,10 +834,13 @@ func fragmentToMinimalFieldValue(fv IssueFieldValueFragment) (MinimalFieldValue,
 	return MinimalFieldValue{}, false
 }
 
-func convertToMinimalIssuesResponse(fragment IssueQueryFragment) MinimalIssuesResponse {
+func convertToMinimalIssuesResponse(fragment IssueQueryFragment, owner, repo string) MinimalIssuesResponse {
 	minimalIssues := make([]MinimalIssue, 0, len(fragment.Nodes))
 	for _, issue := range fragment.Nodes {
-		minimalIssues = append(minimalIssues, fragmentToMinimalIssue(issue))
+		m := fragmentToMinimalIssue(issue)
+		ref := issueRefFromFragment(issue, owner, repo)
+		m.IssueRef = &ref
+		minimalIssues = append(minimalIssues, m)
 	}
 
 	return MinimalIssuesResponse{
@@ -669,6 +862,7 @@ func convertToMinimalIssueComment(comment *github.IssueComment) MinimalIssueComm
 		HTMLURL:           comment.GetHTMLURL(),
 		User:              convertToMinimalUser(comment.GetUser()),
 		AuthorAssociation: comment.GetAuthorAssociation(),
+		IsBot:             isBotUser(comment.GetUser()),
 	}
 
 	if comment.CreatedAt != nil {
@@ -858,16 +1052,120 @@ func convertToMinimalProjectItem(item *github.ProjectV2Item) MinimalProjectItem
 	}
 
 	return MinimalProjectItem{
-		ID:          item.GetID(),
-		NodeID:      item.GetNodeID(),
-		ContentType: contentType,
-		Content:     convertToMinimalProjectItemContent(item.GetContent()),
-		Fields:      convertToMinimalProjectItemFields(item.GetFields()),
-		ArchivedAt:  formatProjectTimestamp(item.ArchivedAt),
-		CreatedAt:   formatProjectTimestamp(item.CreatedAt),
-		UpdatedAt:   formatProjectTimestamp(item.UpdatedAt),
-		Creator:     creator,
+		ID:            item.GetID(),
+		NodeID:        item.GetNodeID(),
+		ProjectNodeID: item.GetProjectNodeID(),
+		ContentType:   contentType,
+		Content:       convertToMinimalProjectItemContent(item.GetContent()),
+		Fields:        convertToMinimalProjectItemFields(item.GetFields()),
+		ArchivedAt:    formatProjectTimestamp(item.ArchivedAt),
+		CreatedAt:     formatProjectTimestamp(item.CreatedAt),
+		UpdatedAt:     formatProjectTimestamp(item.UpdatedAt),
+		Creator:       creator,
+		ItemURL:       item.GetItemURL(),
+		ProjectURL:    item.GetProjectURL(),
+	}
+}
+
+// projectItemContentSelectors are the selectors that pick a property of a
+// project item's issue, pull request or draft issue.
+var projectItemContentSelectors = []string{
+	"node_id", "number", "title", "state", "state_reason", "html_url", "repository", "author",
+	"assignees", "labels", "milestone", "comments", "draft", "merged", "created_at", "updated_at",
+	"closed_at", "merged_at",
+}
+
+// selectProjectItemFields keeps only the selected parts of a project item.
+// A selector names an item property (e.g. "created_at"), a content property
+// (e.g. "title", "assignees"), or a project field by name (e.g. "Status"),
+// case-insensitively. The item ID is always kept, and an empty selection
+// keeps everything.
+func selectProjectItemFields(item MinimalProjectItem, selection []string) MinimalProjectItem {
+	if len(selection) == 0 {
+		return item
+	}
+	keep := make(map[string]bool, len(selection))
+	for _, s := range selection {
+		keep[strings.ToLower(strings.TrimSpace(s))] = true
+	}
+	pick := func(name, value string) string {
+		if keep[name] {
+			return value
+		}
+		return ""
+	}
+
+	selected := MinimalProjectItem{
+		ID:            item.ID,
+		NodeID:        pick("node_id", item.NodeID),
+		ProjectNodeID: pick("project_node_id", item.ProjectNodeID),
+		ContentType:   pick("content_type", item.ContentType),
+		ArchivedAt:    pick("archived_at", item.ArchivedAt),
+		CreatedAt:     pick("created_at", item.CreatedAt),
+		UpdatedAt:     pick("updated_at", item.UpdatedAt),
+		Creator:       pick("creator", item.Creator),
+		ItemURL:       pick("item_url", item.ItemURL),
+		ProjectURL:    pick("project_url", item.ProjectURL),
+	}
+	if keep["position"] {
+		selected.Position = item.Position
+	}
+
+	if keep["fields"] {
+		selected.Fields = item.Fields
+	} else {
+		for _, field := range item.Fields {
+			if keep[strings.ToLower(field.Name)] {
+				selected.Fields = append(selected.Fields, field)
+			}
+		}
+	}
+
+	if c := item.Content; c != nil {
+		if keep["content"] {
+			selected.Content = c
+		} else {
+			content := MinimalProjectItemContent{
+				NodeID:      pick("node_id", c.NodeID),
+				Title:       pick("title", c.Title),
+				State:       pick("state", c.State),
+				StateReason: pick("state_reason", c.StateReason),
+				HTMLURL:     pick("html_url", c.HTMLURL),
+				Repository:  pick("repository", c.Repository),
+				Author:      pick("author", c.Author),
+				Milestone:   pick("milestone", c.Milestone),
+				CreatedAt:   pick("created_at", c.CreatedAt),
+				UpdatedAt:   pick("updated_at", c.UpdatedAt),
+				ClosedAt:    pick("closed_at", c.ClosedAt),
+				MergedAt:    pick("merged_at", c.MergedAt),
+			}
+			if keep["number"] {
+				content.Number = c.Number
+			}
+			if keep["assignees"] {
+				content.Assignees = c.Assignees
+			}
+			if keep["labels"] {
+				content.Labels = c.Labels
+			}
+			if keep["comments"] {
+				content.Comments = c.Comments
+			}
+			if keep["draft"] {
+				content.Draft = c.Draft
+			}
+			if keep["merged"] {
+				content.Merged = c.Merged
+			}
+			for _, name := range projectItemContentSelectors {
+				if keep[name] {
+					selected.Content = &content
+					break
+				}
+			}
+		}
 	}
+	return selected
 }
 
 func convertToMinimalProjectItemContent(content *github.ProjectV2ItemContent) *MinimalProjectItemContent {
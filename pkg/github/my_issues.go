@@ -0,0 +1,163 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MyIssue is an issue in a list_my_issues response, with the full name of
+// the repository it belongs to.
+type MyIssue struct {
+	Repository string `json:"repository"`
+	MinimalIssue
+}
+
+// MyIssuesResult is the output of list_my_issues. User is the login of the
+// authenticated user the issues were listed for.
+type MyIssuesResult struct {
+	User   string    `json:"user"`
+	Filter string    `json:"filter"`
+	Issues []MyIssue `json:"issues"`
+}
+
+// ListMyIssues creates a tool that lists the issues the authenticated user is
+// involved in across every repository they can access.
+func ListMyIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"filter": {
+				Type: "string",
+				Description: "Which issues to list: 'assigned' to the user (default), 'created' by them, 'mentioned' them, " +
+					"'subscribed' to by them, or all issues in repositories they own or belong to ('repos', 'all')",
+				Enum: []any{"assigned", "created", "mentioned", "subscribed", "repos", "all"},
+			},
+			"state": {
+				Type:        "string",
+				Description: "Filter by state, by default only open issues are returned",
+				Enum:        []any{"open", "closed", "all"},
+			},
+			"labels": {
+				Type:        "array",
+				Description: "Only return issues that have all of these labels",
+				Items: &jsonschema.Schema{
+					Type: "string",
+				},
+			},
+			"since": {
+				Type:        "string",
+				Description: "Only return issues updated at or after this time (ISO 8601 timestamp, or relative such as '7 days ago', 'yesterday' or 'today')",
+			},
+		},
+	}
+	WithPagination(schema)
+
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "list_my_issues",
+			Description: t("TOOL_LIST_MY_ISSUES_DESCRIPTION", "List issues involving the authenticated user across all repositories they can access: assigned to them, created by them, mentioning them, or subscribed to. "+
+				"Use this for questions like 'what needs my attention'. Pull requests are left out, so a page may hold fewer than perPage issues. The response names the user the issues were listed for."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_MY_ISSUES_USER_TITLE", "List my issues"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			filter, err := OptionalParam[string](args, "filter")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if filter == "" {
+				filter = "assigned"
+			}
+			state, err := OptionalParam[string](args, "state")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			labels, err := OptionalStringArrayParam(args, "labels")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			since, err := OptionalParam[string](args, "since")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			var sinceTime time.Time
+			if since != "" {
+				sinceTime, err = parseFlexibleTimestamp(since)
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("failed to list issues: %s", err.Error())), nil, nil
+				}
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			var session *mcp.ServerSession
+			if req != nil {
+				session = req.Session
+			}
+			login, resp, err := authenticatedLogin(ctx, client, session)
+			if errors.Is(err, errAppTokenHasNoUser) {
+				return utils.NewToolResultError("the server is authenticated with a GitHub App installation token, which has no user identity to list issues for; use list_issues or search_issues instead"), nil, nil
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get authenticated user", resp, err), nil, nil
+			}
+
+			issues, resp, err := client.Issues.ListAllIssues(ctx, &github.ListAllIssuesOptions{
+				Filter: filter,
+				State:  state,
+				Labels: labels,
+				Since:  sinceTime,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issues", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := MyIssuesResult{
+				User:   login,
+				Filter: filter,
+				Issues: make([]MyIssue, 0, len(issues)),
+			}
+			for _, issue := range issues {
+				// The endpoint lists pull requests as issues too.
+				if issue.IsPullRequest() {
+					continue
+				}
+				minimalIssue := convertToMinimalIssue(issue)
+				ref := issueRefFromIssue(issue, "", "")
+				minimalIssue.IssueRef = &ref
+				result.Issues = append(result.Issues, MyIssue{
+					Repository:   ref.Owner + "/" + ref.Repo,
+					MinimalIssue: minimalIssue,
+				})
+			}
+			return MarshalledTextResult(result), nil, nil
+		})
+}
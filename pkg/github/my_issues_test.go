@@ -0,0 +1,119 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListMyIssues(t *testing.T) {
+	serverTool := ListMyIssues(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_my_issues", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	repo := func(owner, name string) *github.Repository {
+		return &github.Repository{Name: github.Ptr(name), FullName: github.Ptr(owner + "/" + name), Owner: &github.User{Login: github.Ptr(owner)}}
+	}
+	listed := []*github.Issue{
+		{Number: github.Ptr(7), Title: github.Ptr("Crash on start"), State: github.Ptr("open"), Repository: repo("acme", "app")},
+		{
+			Number:           github.Ptr(8),
+			Title:            github.Ptr("Fix crash"),
+			State:            github.Ptr("open"),
+			Repository:       repo("acme", "app"),
+			PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/acme/app/pulls/8")},
+		},
+		{Number: github.Ptr(3), Title: github.Ptr("Docs typo"), State: github.Ptr("open"), Repository: repo("octo", "docs")},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		expectedQuery url.Values
+	}{
+		{
+			name: "defaults to assigned issues",
+			args: map[string]any{},
+			expectedQuery: url.Values{
+				"filter":   {"assigned"},
+				"page":     {"1"},
+				"per_page": {"30"},
+			},
+		},
+		{
+			name: "passes filters through",
+			args: map[string]any{
+				"filter":  "mentioned",
+				"state":   "all",
+				"labels":  []any{"bug", "p1"},
+				"since":   "2026-01-02T03:04:05Z",
+				"page":    float64(2),
+				"perPage": float64(50),
+			},
+			expectedQuery: url.Values{
+				"filter":   {"mentioned"},
+				"state":    {"all"},
+				"labels":   {"bug,p1"},
+				"since":    {"2026-01-02T03:04:05Z"},
+				"page":     {"2"},
+				"per_page": {"50"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotQuery url.Values
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUser: mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("octocat"), Type: github.Ptr("User")}),
+				GetIssues: func(w http.ResponseWriter, r *http.Request) {
+					gotQuery = r.URL.Query()
+					mockResponse(t, http.StatusOK, listed)(w, r)
+				},
+			}))}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			assert.Equal(t, tc.expectedQuery, gotQuery)
+
+			var response MyIssuesResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "octocat", response.User)
+			assert.Equal(t, tc.expectedQuery.Get("filter"), response.Filter)
+
+			// The pull request is left out.
+			require.Len(t, response.Issues, 2)
+			assert.Equal(t, "acme/app", response.Issues[0].Repository)
+			assert.Equal(t, 7, response.Issues[0].Number)
+			assert.Equal(t, "octo/docs", response.Issues[1].Repository)
+			assert.Equal(t, 3, response.Issues[1].Number)
+			require.NotNil(t, response.Issues[1].IssueRef)
+			assert.Equal(t, "docs", response.Issues[1].IssueRef.Repo)
+		})
+	}
+
+	t.Run("invalid since", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}))}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"since": "last tuesday"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to list issues")
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -17,6 +18,7 @@ import (
 	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
 )
 
 const (
@@ -46,7 +48,7 @@ func ListNotifications(t translations.TranslationHelperFunc) inventory.ServerToo
 					},
 					"since": {
 						Type:        "string",
-						Description: "Only show notifications updated after the given time (ISO 8601 format)",
+						Description: "Only show notifications updated after the given time (ISO 8601 format, or relative such as '7 days ago', 'yesterday' or 'today')",
 					},
 					"before": {
 						Type:        "string",
@@ -111,9 +113,9 @@ func ListNotifications(t translations.TranslationHelperFunc) inventory.ServerToo
 
 			// Parse time parameters if provided
 			if since != "" {
-				sinceTime, err := time.Parse(time.RFC3339, since)
+				sinceTime, err := parseFlexibleTimestamp(since)
 				if err != nil {
-					return utils.NewToolResultError(fmt.Sprintf("invalid since time format, should be RFC3339/ISO8601: %v", err)), nil, nil
+					return utils.NewToolResultError(fmt.Sprintf("invalid since time format: %v", err)), nil, nil
 				}
 				opts.Since = sinceTime
 			}
@@ -601,3 +603,124 @@ func ManageRepositoryNotificationSubscription(t translations.TranslationHelperFu
 		},
 	)
 }
+
+// IssueSubscriptionResult is the output of set_issue_subscription.
+type IssueSubscriptionResult struct {
+	IssueNumber int    `json:"issue_number"`
+	Subscribed  bool   `json:"subscribed"`
+	Ignored     bool   `json:"ignored"`
+	State       string `json:"state"`
+}
+
+// SetIssueSubscription creates a tool to subscribe to, unsubscribe from or ignore an issue.
+func SetIssueSubscription(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataNotifications,
+		mcp.Tool{
+			Name:        "set_issue_subscription",
+			Description: t("TOOL_SET_ISSUE_SUBSCRIPTION_DESCRIPTION", "Set the authenticated user's notification subscription for an issue or pull request: subscribed=true to watch it, subscribed=false to only be notified when participating or mentioned, or ignored=true to never be notified. Returns the resulting subscription state."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_SET_ISSUE_SUBSCRIPTION_USER_TITLE", "Set issue subscription"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "Issue or pull request number",
+					},
+					"subscribed": {
+						Type:        "boolean",
+						Description: "Whether to receive notifications for all activity on the issue",
+					},
+					"ignored": {
+						Type:        "boolean",
+						Description: "Whether to block all notifications for the issue. Cannot be combined with subscribed=true.",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			subscribed, hasSubscribed, err := OptionalParamOK[bool](args, "subscribed")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ignored, hasIgnored, err := OptionalParamOK[bool](args, "ignored")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			var state githubv4.SubscriptionState
+			switch {
+			case ignored && subscribed:
+				return utils.NewToolResultError("subscribed and ignored cannot both be true"), nil, nil
+			case ignored:
+				state = githubv4.SubscriptionStateIgnored
+			case subscribed:
+				state = githubv4.SubscriptionStateSubscribed
+			case hasSubscribed || hasIgnored:
+				state = githubv4.SubscriptionStateUnsubscribed
+			default:
+				return utils.NewToolResultError("at least one of subscribed or ignored is required"), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
+			}
+
+			issueID, _, err := fetchIssueIDs(ctx, gqlClient, owner, repo, issueNumber, 0)
+			if err != nil {
+				if result := issueNotFoundResult(ctx, "failed to find issue", err); result != nil {
+					return result, nil, nil
+				}
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find issue", err), nil, nil
+			}
+
+			var mutation struct {
+				UpdateSubscription struct {
+					Subscribable struct {
+						ViewerSubscription githubv4.SubscriptionState
+					}
+				} `graphql:"updateSubscription(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, githubv4.UpdateSubscriptionInput{
+				SubscribableID: issueID,
+				State:          state,
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update issue subscription", err), nil, nil
+			}
+
+			result := mutation.UpdateSubscription.Subscribable.ViewerSubscription
+			return MarshalledTextResult(IssueSubscriptionResult{
+				IssueNumber: issueNumber,
+				Subscribed:  result == githubv4.SubscriptionStateSubscribed,
+				Ignored:     result == githubv4.SubscriptionStateIgnored,
+				State:       strings.ToLower(string(result)),
+			}), nil, nil
+		},
+	)
+}
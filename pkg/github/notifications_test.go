@@ -6,10 +6,12 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -737,3 +739,114 @@ func Test_GetNotificationDetails(t *testing.T) {
 		})
 	}
 }
+
+func Test_SetIssueSubscription(t *testing.T) {
+	serverTool := SetIssueSubscription(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_issue_subscription", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	issueIDQuery := githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				Issue struct {
+					ID githubv4.ID
+				} `graphql:"issue(number: $issueNumber)"`
+			} `graphql:"repository(owner: $owner, name: $repo)"`
+		}{},
+		map[string]any{
+			"owner":       githubv4.String("owner"),
+			"repo":        githubv4.String("repo"),
+			"issueNumber": githubv4.Int(42),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{"issue": map[string]any{"id": "I_42"}},
+		}),
+	)
+	updateMutation := func(state githubv4.SubscriptionState) githubv4mock.Matcher {
+		return githubv4mock.NewMutationMatcher(
+			struct {
+				UpdateSubscription struct {
+					Subscribable struct {
+						ViewerSubscription githubv4.SubscriptionState
+					}
+				} `graphql:"updateSubscription(input: $input)"`
+			}{},
+			githubv4.UpdateSubscriptionInput{SubscribableID: "I_42", State: state},
+			nil,
+			githubv4mock.DataResponse(map[string]any{
+				"updateSubscription": map[string]any{
+					"subscribable": map[string]any{"viewerSubscription": string(state)},
+				},
+			}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		args           map[string]any
+		state          githubv4.SubscriptionState
+		expectedResult IssueSubscriptionResult
+		expectedErrMsg string
+	}{
+		{
+			name:           "subscribe",
+			args:           map[string]any{"subscribed": true},
+			state:          githubv4.SubscriptionStateSubscribed,
+			expectedResult: IssueSubscriptionResult{IssueNumber: 42, Subscribed: true, State: "subscribed"},
+		},
+		{
+			name:           "unsubscribe",
+			args:           map[string]any{"subscribed": false},
+			state:          githubv4.SubscriptionStateUnsubscribed,
+			expectedResult: IssueSubscriptionResult{IssueNumber: 42, State: "unsubscribed"},
+		},
+		{
+			name:           "ignore",
+			args:           map[string]any{"ignored": true, "subscribed": false},
+			state:          githubv4.SubscriptionStateIgnored,
+			expectedResult: IssueSubscriptionResult{IssueNumber: 42, Ignored: true, State: "ignored"},
+		},
+		{
+			name:           "subscribed and ignored conflict",
+			args:           map[string]any{"ignored": true, "subscribed": true},
+			expectedErrMsg: "subscribed and ignored cannot both be true",
+		},
+		{
+			name:           "no state given",
+			args:           map[string]any{},
+			expectedErrMsg: "at least one of subscribed or ignored is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var matchers []githubv4mock.Matcher
+			if tc.state != "" {
+				matchers = append(matchers, issueIDQuery, updateMutation(tc.state))
+			}
+			deps := BaseDeps{GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matchers...))}
+			handler := serverTool.Handler(deps)
+
+			tc.args["owner"] = "owner"
+			tc.args["repo"] = "repo"
+			tc.args["issue_number"] = float64(42)
+			request := createMCPRequest(tc.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, getErrorResult(t, result).Text)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var got IssueSubscriptionResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, tc.expectedResult, got)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ownerAccountTypes holds whether each account is a user or an organization.
+var ownerAccountTypes = newSessionCache[string]("owner-account-type-cache", 30*time.Minute)
+
+// ownerAccountType returns "user" or "org" for the account named owner, or ""
+// when it cannot be determined, e.g. because the account does not exist.
+func ownerAccountType(ctx context.Context, client *github.Client, session *mcp.ServerSession, owner string) string {
+	if client == nil {
+		return ""
+	}
+	if ownerType, ok := ownerAccountTypes.get(session, owner); ok {
+		return ownerType
+	}
+
+	user, resp, err := client.Users.Get(ctx, owner)
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return ""
+	}
+
+	var ownerType string
+	switch user.GetType() {
+	case "User", "Bot":
+		ownerType = "user"
+	case "Organization":
+		ownerType = "org"
+	default:
+		return ""
+	}
+	ownerAccountTypes.set(session, owner, ownerType)
+	return ownerType
+}
+
+// describeOwnerType names an owner type in prose.
+func describeOwnerType(ownerType string) string {
+	if ownerType == "org" {
+		return "an organization"
+	}
+	return "a user account"
+}
+
+// explainOwnerTypeMismatch wraps the handler of a projects tool. When a call
+// that sets owner_type fails because GitHub reports the owner or project as
+// not found, and owner is known to be the other kind of account, the error
+// says so instead. The account is only looked up after such a failure.
+func explainOwnerTypeMismatch(handler func(context.Context, ToolDependencies, *mcp.CallToolRequest, map[string]any) (*mcp.CallToolResult, any, error)) func(context.Context, ToolDependencies, *mcp.CallToolRequest, map[string]any) (*mcp.CallToolResult, any, error) {
+	return func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+		owner, _ := OptionalParam[string](args, "owner")
+		ownerType, _ := OptionalParam[string](args, "owner_type")
+		if owner == "" || ownerType == "" {
+			return handler(ctx, deps, req, args)
+		}
+		if _, err := ghErrors.GetGitHubAPIErrors(ctx); err != nil {
+			ctx = ghErrors.ContextWithGitHubErrors(ctx)
+		}
+
+		result, payload, err := handler(ctx, deps, req, args)
+		if err != nil || result == nil || !result.IsError || !githubNotFound(ctx) {
+			return result, payload, err
+		}
+		client, clientErr := deps.GetClient(ctx)
+		if clientErr != nil {
+			return result, payload, err
+		}
+		var session *mcp.ServerSession
+		if req != nil {
+			session = req.Session
+		}
+		actual := ownerAccountType(ctx, client, session, owner)
+		if actual == "" || actual == ownerType {
+			return result, payload, err
+		}
+		return utils.NewToolResultError(fmt.Sprintf("owner_type is %q, but %q is %s; use owner_type %q or omit it", ownerType, owner, describeOwnerType(actual), actual)), nil, nil
+	}
+}
+
+// githubNotFound reports whether ctx holds a REST 404 or a GraphQL "Could not
+// resolve to" error recorded by the current call.
+func githubNotFound(ctx context.Context) bool {
+	apiErrors, _ := ghErrors.GetGitHubAPIErrors(ctx)
+	for _, apiErr := range apiErrors {
+		if apiErr.Response != nil && apiErr.Response.Response != nil && apiErr.Response.StatusCode == http.StatusNotFound {
+			return true
+		}
+	}
+	graphQLErrors, _ := ghErrors.GetGitHubGraphQLErrors(ctx)
+	for _, graphQLErr := range graphQLErrors {
+		if graphQLErr.Err != nil && strings.Contains(graphQLErr.Err.Error(), "Could not resolve to") {
+			return true
+		}
+	}
+	return false
+}
@@ -12,10 +12,11 @@ import (
 
 // OptionalParamOK is a helper function that can be used to fetch a requested parameter from the request.
 // It returns the value, a boolean indicating if the parameter was present, and an error if the type is wrong.
+// An explicit JSON null is treated as not present.
 func OptionalParamOK[T any, A map[string]any](args A, p string) (value T, ok bool, err error) {
 	// Check if the parameter is present in the request
 	val, exists := args[p]
-	if !exists {
+	if !exists || val == nil {
 		// Not present, return zero value, false, no error
 		return
 	}
@@ -149,6 +150,57 @@ func RequiredInt(args map[string]any, p string) (int, error) {
 	return result, nil
 }
 
+// RequiredPositiveInt is a helper function that can be used to fetch a requested parameter from the request.
+// It does the following checks:
+// 1. Checks if the parameter is present in the request.
+// 2. Checks if the parameter is of the expected type (float64 or numeric string).
+// 3. Checks if the parameter is at least 1, as issue numbers are. GitHub rejects
+// zero or negative values with an error that doesn't name the parameter.
+func RequiredPositiveInt(args map[string]any, p string) (int, error) {
+	v, ok := args[p]
+	if !ok {
+		return 0, fmt.Errorf("missing required parameter: %s", p)
+	}
+
+	result, err := toInt(v)
+	if err != nil {
+		return 0, fmt.Errorf("parameter %s is not a valid number: %w", p, err)
+	}
+
+	if err := checkPositiveInt(p, result); err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// RequiredPositiveIntArray is the array counterpart of RequiredPositiveInt. It
+// fetches a non-empty list of numbers, such as issue numbers, and checks that
+// every element is at least 1.
+func RequiredPositiveIntArray(args map[string]any, p string) ([]int, error) {
+	result, err := OptionalIntArrayParam(args, p)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("missing required parameter: %s", p)
+	}
+	for i, v := range result {
+		if err := checkPositiveInt(fmt.Sprintf("%s[%d]", p, i), v); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// checkPositiveInt reports an error naming parameter p when v is below 1.
+func checkPositiveInt(p string, v int) error {
+	if v < 1 {
+		return fmt.Errorf("parameter %s must be a positive integer, got %d", p, v)
+	}
+	return nil
+}
+
 // RequiredBigInt is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
 // 1. Checks if the parameter is present in the request.
@@ -175,13 +227,14 @@ func RequiredBigInt(args map[string]any, p string) (int64, error) {
 
 // OptionalParam is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
-// 1. Checks if the parameter is present in the request, if not, it returns its zero-value
+// 1. Checks if the parameter is present in the request (and not null), if not, it returns its zero-value
 // 2. If it is present, it checks if the parameter is of the expected type and returns it
 func OptionalParam[T any](args map[string]any, p string) (T, error) {
 	var zero T
 
-	// Check if the parameter is present in the request
-	if _, ok := args[p]; !ok {
+	// Check if the parameter is present in the request. Models often send
+	// null for optional fields they mean to omit.
+	if v, ok := args[p]; !ok || v == nil {
 		return zero, nil
 	}
 
@@ -195,11 +248,11 @@ func OptionalParam[T any](args map[string]any, p string) (T, error) {
 
 // OptionalIntParam is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
-// 1. Checks if the parameter is present in the request, if not, it returns its zero-value
+// 1. Checks if the parameter is present in the request (and not null), if not, it returns its zero-value
 // 2. If it is present, it checks if the parameter is of the expected type (float64 or numeric string) and returns it
 func OptionalIntParam(args map[string]any, p string) (int, error) {
 	val, ok := args[p]
-	if !ok {
+	if !ok || val == nil {
 		return 0, nil
 	}
 
@@ -227,8 +280,7 @@ func OptionalIntParamWithDefault(args map[string]any, p string, d int) (int, err
 // OptionalBoolParamWithDefault is a helper function that can be used to fetch a requested parameter from the request
 // similar to optionalBoolParam, but it also takes a default value.
 func OptionalBoolParamWithDefault(args map[string]any, p string, d bool) (bool, error) {
-	_, ok := args[p]
-	v, err := OptionalParam[bool](args, p)
+	v, ok, err := OptionalParamOK[bool](args, p)
 	if err != nil {
 		return false, err
 	}
@@ -322,6 +374,36 @@ func OptionalBigIntArrayParam(args map[string]any, p string) ([]int64, error) {
 	}
 }
 
+// OptionalIntArrayParam is a helper function that can be used to fetch a requested parameter from the request.
+// It does the following checks:
+// 1. Checks if the parameter is present in the request, if not, it returns an empty slice
+// 2. If it is present, iterates the elements and converts each (float64 or numeric string) to an int
+func OptionalIntArrayParam(args map[string]any, p string) ([]int, error) {
+	// Check if the parameter is present in the request
+	if _, ok := args[p]; !ok {
+		return []int{}, nil
+	}
+
+	switch v := args[p].(type) {
+	case nil:
+		return []int{}, nil
+	case []int:
+		return v, nil
+	case []any:
+		intSlice := make([]int, len(v))
+		for i, elem := range v {
+			val, err := toInt(elem)
+			if err != nil {
+				return []int{}, fmt.Errorf("parameter %s: element %d is not a valid number: %w", p, i, err)
+			}
+			intSlice[i] = val
+		}
+		return intSlice, nil
+	default:
+		return []int{}, fmt.Errorf("parameter %s could not be coerced to []int, is %T", p, args[p])
+	}
+}
+
 // WithPagination adds REST API pagination parameters to a tool.
 // https://docs.github.com/en/rest/using-the-rest-api/using-pagination-in-the-rest-api
 func WithPagination(schema *jsonschema.Schema) *jsonschema.Schema {
@@ -413,6 +495,17 @@ func OptionalPaginationParams(args map[string]any) (PaginationParams, error) {
 	}, nil
 }
 
+// defaultPageSize returns the page size a list tool should use when the caller omits one:
+// the server-wide default configured on deps, or fallback when none is configured. The
+// result is capped at maxPageSize.
+func defaultPageSize(deps ToolDependencies, fallback, maxPageSize int) int {
+	size := deps.GetDefaultPageSize()
+	if size <= 0 {
+		size = fallback
+	}
+	return min(size, maxPageSize)
+}
+
 // OptionalCursorPaginationParams returns the "perPage" and "after" parameters from the request,
 // without the "page" parameter, suitable for cursor-based pagination only.
 func OptionalCursorPaginationParams(args map[string]any) (CursorPaginationParams, error) {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-github/v89/github"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_IsAcceptedError(t *testing.T) {
@@ -119,6 +120,13 @@ func Test_OptionalStringParam(t *testing.T) {
 			expected:    "",
 			expectError: false,
 		},
+		{
+			name:        "null parameter is treated as missing",
+			params:      map[string]any{"name": nil},
+			paramName:   "name",
+			expected:    "",
+			expectError: false,
+		},
 		{
 			name:        "empty string parameter",
 			params:      map[string]any{"name": ""},
@@ -270,6 +278,115 @@ func Test_RequiredInt(t *testing.T) {
 		})
 	}
 }
+
+func Test_RequiredPositiveInt(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]any
+		expected    int
+		expectedErr string
+	}{
+		{
+			name:     "valid number parameter",
+			params:   map[string]any{"issue_number": float64(42)},
+			expected: 42,
+		},
+		{
+			name:     "valid string number parameter",
+			params:   map[string]any{"issue_number": "1"},
+			expected: 1,
+		},
+		{
+			name:        "missing parameter",
+			params:      map[string]any{},
+			expectedErr: "missing required parameter: issue_number",
+		},
+		{
+			name:        "zero",
+			params:      map[string]any{"issue_number": float64(0)},
+			expectedErr: "parameter issue_number must be a positive integer, got 0",
+		},
+		{
+			name:        "negative",
+			params:      map[string]any{"issue_number": float64(-7)},
+			expectedErr: "parameter issue_number must be a positive integer, got -7",
+		},
+		{
+			name:        "negative string",
+			params:      map[string]any{"issue_number": "-1"},
+			expectedErr: "parameter issue_number must be a positive integer, got -1",
+		},
+		{
+			name:        "fractional",
+			params:      map[string]any{"issue_number": float64(1.5)},
+			expectedErr: "parameter issue_number is not a valid number",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := RequiredPositiveInt(tc.params, "issue_number")
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func Test_RequiredPositiveIntArray(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]any
+		expected    []int
+		expectedErr string
+	}{
+		{
+			name:     "valid numbers",
+			params:   map[string]any{"issue_numbers": []any{float64(1), "2"}},
+			expected: []int{1, 2},
+		},
+		{
+			name:        "missing parameter",
+			params:      map[string]any{},
+			expectedErr: "missing required parameter: issue_numbers",
+		},
+		{
+			name:        "empty array",
+			params:      map[string]any{"issue_numbers": []any{}},
+			expectedErr: "missing required parameter: issue_numbers",
+		},
+		{
+			name:        "negative element",
+			params:      map[string]any{"issue_numbers": []any{float64(3), float64(-1)}},
+			expectedErr: "parameter issue_numbers[1] must be a positive integer, got -1",
+		},
+		{
+			name:        "invalid element",
+			params:      map[string]any{"issue_numbers": []any{"abc"}},
+			expectedErr: "element 0 is not a valid number",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := RequiredPositiveIntArray(tc.params, "issue_numbers")
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
 func Test_OptionalIntParam(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -299,6 +416,13 @@ func Test_OptionalIntParam(t *testing.T) {
 			expected:    0,
 			expectError: false,
 		},
+		{
+			name:        "null parameter is treated as missing",
+			params:      map[string]any{"count": nil},
+			paramName:   "count",
+			expected:    0,
+			expectError: false,
+		},
 		{
 			name:        "zero value",
 			params:      map[string]any{"count": float64(0)},
@@ -390,6 +514,14 @@ func Test_OptionalNumberParamWithDefault(t *testing.T) {
 			expected:    10,
 			expectError: false,
 		},
+		{
+			name:        "null parameter uses default",
+			params:      map[string]any{"count": nil},
+			paramName:   "count",
+			defaultVal:  10,
+			expected:    10,
+			expectError: false,
+		},
 		{
 			name:        "zero value",
 			params:      map[string]any{"count": float64(0)},
@@ -459,6 +591,13 @@ func Test_OptionalBooleanParam(t *testing.T) {
 			expected:    false,
 			expectError: false,
 		},
+		{
+			name:        "null parameter is treated as missing",
+			params:      map[string]any{"flag": nil},
+			paramName:   "flag",
+			expected:    false,
+			expectError: false,
+		},
 		{
 			name:        "wrong type parameter",
 			params:      map[string]any{"flag": "not-a-boolean"},
@@ -482,6 +621,24 @@ func Test_OptionalBooleanParam(t *testing.T) {
 	}
 }
 
+func Test_OptionalParamOKNull(t *testing.T) {
+	value, ok, err := OptionalParamOK[string](map[string]any{"cursor": nil}, "cursor")
+	require.NoError(t, err)
+	assert.False(t, ok, "null should be reported as not provided")
+	assert.Empty(t, value)
+
+	value, ok, err = OptionalParamOK[string](map[string]any{"cursor": ""}, "cursor")
+	require.NoError(t, err)
+	assert.True(t, ok, "an explicit empty string is still provided")
+	assert.Empty(t, value)
+}
+
+func Test_OptionalBoolParamWithDefaultNull(t *testing.T) {
+	v, err := OptionalBoolParamWithDefault(map[string]any{"flag": nil}, "flag", true)
+	require.NoError(t, err)
+	assert.True(t, v)
+}
+
 func TestOptionalStringArrayParam(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -549,6 +706,71 @@ func TestOptionalStringArrayParam(t *testing.T) {
 	}
 }
 
+func TestOptionalIntArrayParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]any
+		paramName   string
+		expected    []int
+		expectError bool
+	}{
+		{
+			name:        "parameter not in request",
+			params:      map[string]any{},
+			paramName:   "numbers",
+			expected:    []int{},
+			expectError: false,
+		},
+		{
+			name: "valid number array parameter",
+			params: map[string]any{
+				"numbers": []any{float64(1), float64(42)},
+			},
+			paramName:   "numbers",
+			expected:    []int{1, 42},
+			expectError: false,
+		},
+		{
+			name: "numeric strings are accepted",
+			params: map[string]any{
+				"numbers": []any{"7", float64(8)},
+			},
+			paramName:   "numbers",
+			expected:    []int{7, 8},
+			expectError: false,
+		},
+		{
+			name: "fractional number",
+			params: map[string]any{
+				"numbers": []any{float64(1.5)},
+			},
+			paramName:   "numbers",
+			expectError: true,
+		},
+		{
+			name: "wrong type parameter",
+			params: map[string]any{
+				"numbers": "1,2",
+			},
+			paramName:   "numbers",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := OptionalIntArrayParam(tc.params, tc.paramName)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
 func TestOptionalPaginationParams(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -642,3 +864,25 @@ func TestOptionalPaginationParams(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultPageSize(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured int
+		fallback   int
+		max        int
+		expected   int
+	}{
+		{name: "unconfigured uses fallback", configured: 0, fallback: 30, max: 100, expected: 30},
+		{name: "configured overrides fallback", configured: 10, fallback: 30, max: 100, expected: 10},
+		{name: "configured is capped at max", configured: 80, fallback: 50, max: 50, expected: 50},
+		{name: "negative configured uses fallback", configured: -5, fallback: 30, max: 100, expected: 30},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{DefaultPageSize: tc.configured}
+			assert.Equal(t, tc.expected, defaultPageSize(deps, tc.fallback, tc.max))
+		})
+	}
+}
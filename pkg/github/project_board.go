@@ -0,0 +1,203 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// DefaultProjectBoardField is the single-select field get_project_board
+	// groups by when none is given.
+	DefaultProjectBoardField = "Status"
+	// DefaultProjectBoardItemsPerColumn and MaxProjectBoardItemsPerColumn bound
+	// how many items get_project_board lists in each column.
+	DefaultProjectBoardItemsPerColumn = 20
+	MaxProjectBoardItemsPerColumn     = 100
+	// MaxProjectBoardItems caps how many project items get_project_board reads
+	// before reporting the board as truncated.
+	MaxProjectBoardItems = 500
+)
+
+// ProjectBoardItem is the minimal view of an item on a get_project_board column.
+// Draft issues have no number or URL.
+type ProjectBoardItem struct {
+	Number int    `json:"number,omitempty"`
+	Title  string `json:"title"`
+	URL    string `json:"url,omitempty"`
+}
+
+// ProjectBoardColumn holds the items with one value of the grouping field.
+// Truncated is set when the column has more than the per-column cap of items.
+type ProjectBoardColumn struct {
+	TotalCount int                `json:"total_count"`
+	Items      []ProjectBoardItem `json:"items"`
+	Truncated  bool               `json:"truncated,omitempty"`
+}
+
+// ProjectBoard is the output type of the get_project_board method.
+type ProjectBoard struct {
+	Field       string                        `json:"field"`
+	Columns     map[string]ProjectBoardColumn `json:"columns"`
+	ColumnOrder []string                      `json:"column_order"`
+	TotalItems  int                           `json:"total_items"`
+	// Truncated is set when the project has more than MaxProjectBoardItems
+	// items; column counts then cover only the first ones.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+type projectBoardItemNode struct {
+	FieldValueByName struct {
+		SingleSelect struct {
+			Name githubv4.String
+		} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	} `graphql:"fieldValueByName(name: $fieldName)"`
+	Content struct {
+		Issue struct {
+			Number githubv4.Int
+			Title  githubv4.String
+			URL    githubv4.String
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			Number githubv4.Int
+			Title  githubv4.String
+			URL    githubv4.String
+		} `graphql:"... on PullRequest"`
+		DraftIssue struct {
+			Title githubv4.String
+		} `graphql:"... on DraftIssue"`
+	}
+}
+
+type projectBoardProject struct {
+	Public githubv4.Boolean
+	Field  struct {
+		TypeName     githubv4.String `graphql:"__typename"`
+		SingleSelect struct {
+			Options []struct {
+				Name githubv4.String
+			}
+		} `graphql:"... on ProjectV2SingleSelectField"`
+	} `graphql:"field(name: $fieldName)"`
+	Items struct {
+		TotalCount githubv4.Int
+		Nodes      []projectBoardItemNode
+		PageInfo   PageInfoFragment
+	} `graphql:"items(first: 100, after: $after)"`
+}
+
+// projectBoardUserQuery is the GraphQL query for reading the board of a user-owned project.
+type projectBoardUserQuery struct {
+	User struct {
+		ProjectV2 projectBoardProject `graphql:"projectV2(number: $projectNumber)"`
+	} `graphql:"user(login: $owner)"`
+}
+
+// projectBoardOrgQuery is the GraphQL query for reading the board of an org-owned project.
+type projectBoardOrgQuery struct {
+	Organization struct {
+		ProjectV2 projectBoardProject `graphql:"projectV2(number: $projectNumber)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+// getProjectBoard groups the items of a project by the value of a
+// single-select field, listing up to itemsPerColumn items per value. Items
+// without a value go in a "No <field>" column, as on the project board.
+func getProjectBoard(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, fieldName string, itemsPerColumn int) (*mcp.CallToolResult, bool, any, error) {
+	vars := map[string]any{
+		"owner":         githubv4.String(owner),
+		"projectNumber": githubv4.Int(int32(projectNumber)), //nolint:gosec // Project numbers are small integers
+		"fieldName":     githubv4.String(fieldName),
+		"after":         (*githubv4.String)(nil),
+	}
+
+	board := ProjectBoard{
+		Field:   fieldName,
+		Columns: make(map[string]ProjectBoardColumn),
+	}
+	noValue := "No " + fieldName
+	var isPrivate bool
+	scanned := 0
+	for {
+		var project projectBoardProject
+		if ownerType == "org" {
+			var q projectBoardOrgQuery
+			if err := gqlClient.Query(ctx, &q, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project board", err), false, nil, nil
+			}
+			project = q.Organization.ProjectV2
+		} else {
+			var q projectBoardUserQuery
+			if err := gqlClient.Query(ctx, &q, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project board", err), false, nil, nil
+			}
+			project = q.User.ProjectV2
+		}
+
+		if scanned == 0 {
+			switch project.Field.TypeName {
+			case "":
+				return utils.NewToolResultError(fmt.Sprintf("project %d has no field named %q", projectNumber, fieldName)), false, nil, nil
+			case "ProjectV2SingleSelectField":
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("field %q is not a single-select field", fieldName)), false, nil, nil
+			}
+			isPrivate = !bool(project.Public)
+			board.TotalItems = int(project.Items.TotalCount)
+			for _, option := range project.Field.SingleSelect.Options {
+				board.ColumnOrder = append(board.ColumnOrder, string(option.Name))
+			}
+			board.ColumnOrder = append(board.ColumnOrder, noValue)
+			for _, name := range board.ColumnOrder {
+				board.Columns[name] = ProjectBoardColumn{Items: []ProjectBoardItem{}}
+			}
+		}
+
+		for _, node := range project.Items.Nodes {
+			name := string(node.FieldValueByName.SingleSelect.Name)
+			if name == "" {
+				name = noValue
+			}
+			column, ok := board.Columns[name]
+			if !ok {
+				// An option added while the board was being read.
+				board.ColumnOrder = append(board.ColumnOrder, name)
+			}
+			column.TotalCount++
+			if len(column.Items) < itemsPerColumn {
+				column.Items = append(column.Items, projectBoardItem(node))
+			} else {
+				column.Truncated = true
+			}
+			board.Columns[name] = column
+		}
+		scanned += len(project.Items.Nodes)
+
+		if !project.Items.PageInfo.HasNextPage {
+			break
+		}
+		if scanned >= MaxProjectBoardItems {
+			board.Truncated = true
+			break
+		}
+		vars["after"] = githubv4.String(project.Items.PageInfo.EndCursor)
+	}
+
+	return MarshalledTextResult(board), isPrivate, nil, nil
+}
+
+func projectBoardItem(node projectBoardItemNode) ProjectBoardItem {
+	switch content := node.Content; {
+	case content.Issue.URL != "":
+		return ProjectBoardItem{Number: int(content.Issue.Number), Title: sanitize.Sanitize(string(content.Issue.Title)), URL: string(content.Issue.URL)}
+	case content.PullRequest.URL != "":
+		return ProjectBoardItem{Number: int(content.PullRequest.Number), Title: sanitize.Sanitize(string(content.PullRequest.Title)), URL: string(content.PullRequest.URL)}
+	default:
+		return ProjectBoardItem{Title: sanitize.Sanitize(string(content.DraftIssue.Title))}
+	}
+}
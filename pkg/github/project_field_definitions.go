@@ -0,0 +1,112 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// projectFieldDefinitions holds the field definitions of each project, so
+// reading several items of a project lists its fields once.
+var projectFieldDefinitions = newSessionCache[[]*github.ProjectV2Field]("project-field-definitions-cache", 5*time.Minute)
+
+// cachedProjectFields returns all the fields of a project, reusing the ones
+// cached for session when there are any.
+func cachedProjectFields(ctx context.Context, client *github.Client, session *mcp.ServerSession, owner, ownerType string, projectNumber int) ([]*github.ProjectV2Field, error) {
+	key := fmt.Sprintf("%s/%s/%d", ownerType, owner, projectNumber)
+	if fields, ok := projectFieldDefinitions.get(session, key); ok {
+		return fields, nil
+	}
+
+	opts := &github.ListProjectsOptions{
+		ListProjectsPaginationOptions: github.ListProjectsPaginationOptions{PerPage: 100},
+	}
+	var all []*github.ProjectV2Field
+	for {
+		var projectFields []*github.ProjectV2Field
+		var resp *github.Response
+		var err error
+		if ownerType == "org" {
+			projectFields, resp, err = client.Projects.ListOrganizationProjectFields(ctx, owner, projectNumber, opts)
+		} else {
+			projectFields, resp, err = client.Projects.ListUserProjectFields(ctx, owner, projectNumber, opts)
+		}
+		if err != nil {
+			return nil, err
+		}
+		_ = resp.Body.Close()
+
+		all = append(all, projectFields...)
+		if resp.After == "" {
+			break
+		}
+		opts.After = resp.After
+	}
+
+	projectFieldDefinitions.set(session, key, all)
+	return all, nil
+}
+
+// hasUnnamedSingleSelectValue reports whether any single-select value of an
+// item is only known by its option ID.
+func hasUnnamedSingleSelectValue(fields []MinimalProjectItemFieldValue) bool {
+	for _, field := range fields {
+		if field.DataType != "single_select" {
+			continue
+		}
+		switch v := field.Value.(type) {
+		case string:
+			return true
+		case minimalProjectOptionValue:
+			if v.Name == "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resolveSingleSelectValues replaces single-select values that only carry an
+// option ID with the option from the project's field definitions.
+func resolveSingleSelectValues(fields []MinimalProjectItemFieldValue, definitions []*github.ProjectV2Field) {
+	options := make(map[int64]map[string]*github.ProjectV2FieldOption)
+	for _, definition := range definitions {
+		for _, option := range definition.Options {
+			if option == nil {
+				continue
+			}
+			if options[definition.GetID()] == nil {
+				options[definition.GetID()] = make(map[string]*github.ProjectV2FieldOption)
+			}
+			options[definition.GetID()][option.GetID()] = option
+		}
+	}
+
+	for i, field := range fields {
+		if field.DataType != "single_select" {
+			continue
+		}
+		var optionID string
+		switch v := field.Value.(type) {
+		case string:
+			optionID = v
+		case minimalProjectOptionValue:
+			if v.Name != "" {
+				continue
+			}
+			optionID = v.ID
+		default:
+			continue
+		}
+		if option, ok := options[field.ID][optionID]; ok {
+			fields[i].Value = minimalProjectOptionValue{
+				ID:    option.GetID(),
+				Name:  projectTextContentString(option.GetName()),
+				Color: option.GetColor(),
+			}
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// MaxProjectItemPositionScan caps how many project items
+// update_project_item_position reads to report a moved item's neighbors.
+const MaxProjectItemPositionScan = 500
+
+// ProjectItemNeighbor is an item next to a moved project item.
+type ProjectItemNeighbor struct {
+	ItemID int64  `json:"item_id,omitempty"`
+	Title  string `json:"title"`
+}
+
+// ProjectItemPositionResult is the output of update_project_item_position.
+// Position is 1-based, and is omitted with the neighbors when the item is
+// beyond the first MaxProjectItemPositionScan items.
+type ProjectItemPositionResult struct {
+	ItemID   int64                `json:"item_id"`
+	Position int                  `json:"position,omitempty"`
+	Previous *ProjectItemNeighbor `json:"previous"`
+	Next     *ProjectItemNeighbor `json:"next"`
+	Note     string               `json:"note,omitempty"`
+}
+
+// updateProjectItemPositionMutation moves a project item after another one,
+// or to the top.
+type updateProjectItemPositionMutation struct {
+	UpdateProjectV2ItemPosition struct {
+		ClientMutationID *githubv4.String
+	} `graphql:"updateProjectV2ItemPosition(input: $input)"`
+}
+
+type projectItemOrderNode struct {
+	FullDatabaseID string `graphql:"fullDatabaseId"`
+	Content        struct {
+		Issue struct {
+			Title githubv4.String
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			Title githubv4.String
+		} `graphql:"... on PullRequest"`
+		DraftIssue struct {
+			Title githubv4.String
+		} `graphql:"... on DraftIssue"`
+	}
+}
+
+// projectItemOrderQuery reads a page of a project's items in board order.
+type projectItemOrderQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Items struct {
+				Nodes    []projectItemOrderNode
+				PageInfo PageInfoFragment
+			} `graphql:"items(first: 100, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
+func (n projectItemOrderNode) neighbor() *ProjectItemNeighbor {
+	title := n.Content.Issue.Title
+	if title == "" {
+		title = n.Content.PullRequest.Title
+	}
+	if title == "" {
+		title = n.Content.DraftIssue.Title
+	}
+	itemID, _ := strconv.ParseInt(n.FullDatabaseID, 10, 64)
+	return &ProjectItemNeighbor{ItemID: itemID, Title: sanitize.Sanitize(string(title))}
+}
+
+// fetchProjectItemForMove reads a project item over REST for its node ID. A
+// 404 means the item is not in the project.
+func fetchProjectItemForMove(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, itemID int64, param string) (*github.ProjectV2Item, *mcp.CallToolResult) {
+	item, resp, err := fetchProjectItem(ctx, client, owner, ownerType, projectNumber, itemID, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, utils.NewToolResultError(fmt.Sprintf("%s %d is not an item of project %d", param, itemID, projectNumber))
+		}
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, ProjectListFailedError, resp, err)
+	}
+	_ = resp.Body.Close()
+	if item.GetNodeID() == "" {
+		return nil, utils.NewToolResultError(fmt.Sprintf("GitHub did not return a node ID for %s %d", param, itemID))
+	}
+	return item, nil
+}
+
+// updateProjectItemPosition moves a project item after afterItemID, or to the
+// top when afterItemID is 0, and reports its new neighbors.
+func updateProjectItemPosition(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, itemID, afterItemID int64) (*mcp.CallToolResult, any, error) {
+	if itemID == afterItemID {
+		return utils.NewToolResultError("after_item_id must differ from item_id"), nil, nil
+	}
+
+	item, errResult := fetchProjectItemForMove(ctx, client, owner, ownerType, projectNumber, itemID, "item_id")
+	if errResult != nil {
+		return errResult, nil, nil
+	}
+	projectID := githubv4.ID(item.GetProjectNodeID())
+	if item.GetProjectNodeID() == "" {
+		var err error
+		if projectID, err = resolveProjectNodeID(ctx, gqlClient, owner, ownerType, projectNumber); err != nil {
+			return utils.NewToolResultError(err.Error()), nil, nil
+		}
+	}
+
+	input := githubv4.UpdateProjectV2ItemPositionInput{
+		ProjectID: projectID,
+		ItemID:    githubv4.ID(item.GetNodeID()),
+	}
+	if afterItemID != 0 {
+		afterItem, errResult := fetchProjectItemForMove(ctx, client, owner, ownerType, projectNumber, afterItemID, "after_item_id")
+		if errResult != nil {
+			return errResult, nil, nil
+		}
+		if afterItem.GetProjectNodeID() != "" && afterItem.GetProjectNodeID() != item.GetProjectNodeID() {
+			return utils.NewToolResultError(fmt.Sprintf("after_item_id %d belongs to a different project than item_id %d", afterItemID, itemID)), nil, nil
+		}
+		afterID := githubv4.ID(afterItem.GetNodeID())
+		input.AfterID = &afterID
+	}
+
+	var mutation updateProjectItemPositionMutation
+	if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update project item position", err), nil, nil
+	}
+
+	result, err := projectItemNeighbors(ctx, gqlClient, projectID, itemID)
+	if err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "moved the item, but failed to read its new position", err), nil, nil
+	}
+	return MarshalledTextResult(result), nil, nil
+}
+
+// projectItemNeighbors reads a project's items in order until it finds
+// itemID, and reports its position and the items on either side.
+func projectItemNeighbors(ctx context.Context, gqlClient *githubv4.Client, projectID githubv4.ID, itemID int64) (ProjectItemPositionResult, error) {
+	result := ProjectItemPositionResult{ItemID: itemID}
+	vars := map[string]any{
+		"projectId": projectID,
+		"after":     (*githubv4.String)(nil),
+	}
+
+	var previous *ProjectItemNeighbor
+	scanned := 0
+	found := false
+scan:
+	for {
+		var q projectItemOrderQuery
+		if err := gqlClient.Query(ctx, &q, vars); err != nil {
+			return result, err
+		}
+		for _, node := range q.Node.ProjectV2.Items.Nodes {
+			current := node.neighbor()
+			scanned++
+			if found {
+				result.Next = current
+				break scan
+			}
+			if current.ItemID == itemID {
+				found = true
+				result.Position = scanned
+				result.Previous = previous
+			}
+			previous = current
+		}
+		page := q.Node.ProjectV2.Items.PageInfo
+		if !page.HasNextPage || (!found && scanned >= MaxProjectItemPositionScan) {
+			break
+		}
+		vars["after"] = githubv4.String(page.EndCursor)
+	}
+
+	if !found {
+		result.Note = fmt.Sprintf("the item was moved, but it is not among the first %d items, so its neighbors were not read", MaxProjectItemPositionScan)
+	}
+	return result, nil
+}
@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -29,18 +32,23 @@ const (
 	ProjectStatusUpdateListFailedError   = "failed to list project status updates"
 	ProjectStatusUpdateGetFailedError    = "failed to get project status update"
 	ProjectStatusUpdateCreateFailedError = "failed to create project status update"
+	ProjectWorkflowListFailedError       = "failed to list project workflows"
 	ProjectResolveIDFailedError          = "failed to resolve project ID"
 	MaxProjectsPerPage                   = 50
+	MaxResolvedProjectItems              = 50
 )
 
 // Method constants for consolidated project tools
 const (
 	projectsMethodListProjects              = "list_projects"
 	projectsMethodListProjectFields         = "list_project_fields"
+	projectsMethodListProjectFieldOptions   = "list_project_field_options"
 	projectsMethodListProjectItems          = "list_project_items"
 	projectsMethodGetProject                = "get_project"
 	projectsMethodGetProjectField           = "get_project_field"
 	projectsMethodGetProjectItem            = "get_project_item"
+	projectsMethodGetProjectItemCount       = "get_project_item_count"
+	projectsMethodGetProjectBoard           = "get_project_board"
 	projectsMethodAddProjectItem            = "add_project_item"
 	projectsMethodUpdateProjectItem         = "update_project_item"
 	projectsMethodDeleteProjectItem         = "delete_project_item"
@@ -49,6 +57,9 @@ const (
 	projectsMethodCreateProjectStatusUpdate = "create_project_status_update"
 	projectsMethodCreateProject             = "create_project"
 	projectsMethodCreateIterationField      = "create_iteration_field"
+	projectsMethodCopyProject               = "copy_project"
+	projectsMethodUpdateProjectItemPosition = "update_project_item_position"
+	projectsMethodListProjectWorkflows      = "list_project_workflows"
 )
 
 // GraphQL types for ProjectV2 status updates
@@ -98,6 +109,55 @@ type statusUpdatesOrgQuery struct {
 	} `graphql:"organization(login: $owner)"`
 }
 
+// GraphQL types for ProjectV2 workflows
+
+type projectWorkflowsProject struct {
+	Public    githubv4.Boolean
+	Workflows struct {
+		Nodes []struct {
+			Number  githubv4.Int
+			Name    githubv4.String
+			Enabled githubv4.Boolean
+		}
+		PageInfo PageInfoFragment
+	} `graphql:"workflows(first: $first, after: $after)"`
+}
+
+// projectWorkflowsUserQuery is the GraphQL query for listing the workflows of a user-owned project.
+type projectWorkflowsUserQuery struct {
+	User struct {
+		ProjectV2 projectWorkflowsProject `graphql:"projectV2(number: $projectNumber)"`
+	} `graphql:"user(login: $owner)"`
+}
+
+// projectWorkflowsOrgQuery is the GraphQL query for listing the workflows of an org-owned project.
+type projectWorkflowsOrgQuery struct {
+	Organization struct {
+		ProjectV2 projectWorkflowsProject `graphql:"projectV2(number: $projectNumber)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+type projectItemCountProject struct {
+	Public githubv4.Boolean
+	Items  struct {
+		TotalCount githubv4.Int
+	}
+}
+
+// projectItemCountUserQuery is the GraphQL query for counting the items on a user-owned project.
+type projectItemCountUserQuery struct {
+	User struct {
+		ProjectV2 projectItemCountProject `graphql:"projectV2(number: $projectNumber)"`
+	} `graphql:"user(login: $owner)"`
+}
+
+// projectItemCountOrgQuery is the GraphQL query for counting the items on an org-owned project.
+type projectItemCountOrgQuery struct {
+	Organization struct {
+		ProjectV2 projectItemCountProject `graphql:"projectV2(number: $projectNumber)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
 // statusUpdateNodeQuery is the GraphQL query for fetching a single status update by node ID.
 type statusUpdateNodeQuery struct {
 	Node struct {
@@ -158,6 +218,8 @@ func ProjectsList(t translations.TranslationHelperFunc) inventory.ServerTool {
 			Description: t("TOOL_PROJECTS_LIST_DESCRIPTION",
 				`Tools for listing GitHub Projects resources.
 Use this tool to list projects for a user or organization, or list project fields and items for a specific project.
+Use 'list_project_field_options' to get the option IDs and names a single-select field such as Status can take, before setting it with projects_write.
+Use 'list_project_workflows' to check which built-in project workflows, such as "Item closed", are enabled.
 `),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_PROJECTS_LIST_USER_TITLE", "List GitHub Projects resources"),
@@ -172,8 +234,10 @@ Use this tool to list projects for a user or organization, or list project field
 						Enum: []any{
 							projectsMethodListProjects,
 							projectsMethodListProjectFields,
+							projectsMethodListProjectFieldOptions,
 							projectsMethodListProjectItems,
 							projectsMethodListProjectStatusUpdates,
+							projectsMethodListProjectWorkflows,
 						},
 					},
 					"owner_type": {
@@ -187,7 +251,11 @@ Use this tool to list projects for a user or organization, or list project field
 					},
 					"project_number": {
 						Type:        "number",
-						Description: "The project's number. Required for 'list_project_fields', 'list_project_items', and 'list_project_status_updates' methods.",
+						Description: "The project's number. Required for 'list_project_fields', 'list_project_field_options', 'list_project_items', 'list_project_status_updates', and 'list_project_workflows' methods.",
+					},
+					"field_id": {
+						Type:        "number",
+						Description: "The ID of a single-select field. Required for 'list_project_field_options' method.",
 					},
 					"query": {
 						Type:        "string",
@@ -200,6 +268,13 @@ Use this tool to list projects for a user or organization, or list project field
 							Type: "string",
 						},
 					},
+					"item_fields": {
+						Type:        "array",
+						Description: "Parts of each item to keep in the response (e.g. [\"title\", \"status\", \"assignees\"]): item or content properties such as created_at, creator, number, state, labels or repository, or project field names (case-insensitive; 'fields' keeps every field value, 'content' the whole content). The item id is always kept. If not provided, the full minimal item is returned. Only used for 'list_project_items' method.",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
 					"per_page": {
 						Type:        "number",
 						Description: fmt.Sprintf("Results per page (max %d)", MaxProjectsPerPage),
@@ -212,12 +287,29 @@ Use this tool to list projects for a user or organization, or list project field
 						Type:        "string",
 						Description: "Backward pagination cursor from previous pageInfo.prevCursor (rare).",
 					},
+					"resolve_content": {
+						Type:        "boolean",
+						Description: fmt.Sprintf("Resolve the issue, pull request or draft issue behind items whose content is not included in the response, using one batched GraphQL lookup. Caps the page at %d items. Only used for 'list_project_items' method.", MaxResolvedProjectItems),
+					},
+					"creator": {
+						Type:        "string",
+						Description: "Only return items added to the project by this user login. Applied after fetching, so it filters within the requested page and a page may contain fewer than per_page items. Only used for 'list_project_items' method.",
+					},
+					"content_type": {
+						Type:        "string",
+						Description: "Only return items of this content type. Applied after fetching, like 'creator'. Only used for 'list_project_items' method.",
+						Enum:        []any{"Issue", "PullRequest", "DraftIssue"},
+					},
+					"updated_since": {
+						Type:        "string",
+						Description: "Only return items updated at or after this time. ISO 8601 timestamp (YYYY-MM-DDThh:mm:ssZ or YYYY-MM-DD). Applied after fetching, like 'creator'. Only used for 'list_project_items' method.",
+					},
 				},
 				Required: []string{"method", "owner"},
 			},
 		},
 		[]scopes.Scope{scopes.ReadProject},
-		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+		explainOwnerTypeMismatch(func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
 			method, err := RequiredParam[string](args, "method")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -238,12 +330,14 @@ Use this tool to list projects for a user or organization, or list project field
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
+			defaultPerPage := defaultPageSize(deps, MaxProjectsPerPage, MaxProjectsPerPage)
+
 			switch method {
 			case projectsMethodListProjects:
-				result, visibilities, payload, err := listProjects(ctx, client, args, owner, ownerType)
+				result, visibilities, payload, err := listProjects(ctx, client, args, owner, ownerType, defaultPerPage)
 				result = attachJoinedIFCLabel(ctx, deps, result, visibilities, ifc.LabelProjectList)
 				return result, payload, err
-			case projectsMethodListProjectFields, projectsMethodListProjectItems, projectsMethodListProjectStatusUpdates:
+			case projectsMethodListProjectFields, projectsMethodListProjectFieldOptions, projectsMethodListProjectItems, projectsMethodListProjectStatusUpdates, projectsMethodListProjectWorkflows:
 				// All other methods require project_number and ownerType detection
 				projectNumber, err := RequiredInt(args, "project_number")
 				if err != nil {
@@ -258,7 +352,20 @@ Use this tool to list projects for a user or organization, or list project field
 
 				switch method {
 				case projectsMethodListProjectFields:
-					result, payload, err := listProjectFields(ctx, client, args, owner, ownerType)
+					result, payload, err := listProjectFields(ctx, client, args, owner, ownerType, defaultPerPage)
+					if shouldAttachIFCLabel(ctx, deps, result) {
+						isPrivate, visibilityErr := FetchProjectIsPrivate(ctx, client, owner, ownerType, projectNumber)
+						if visibilityErr == nil {
+							result = attachProjectVisibilityIFCLabel(ctx, deps, result, isPrivate, ifc.LabelProject)
+						}
+					}
+					return result, payload, err
+				case projectsMethodListProjectFieldOptions:
+					fieldID, err := RequiredBigInt(args, "field_id")
+					if err != nil {
+						return utils.NewToolResultError(err.Error()), nil, nil
+					}
+					result, payload, err := listProjectFieldOptions(ctx, client, owner, ownerType, projectNumber, fieldID)
 					if shouldAttachIFCLabel(ctx, deps, result) {
 						isPrivate, visibilityErr := FetchProjectIsPrivate(ctx, client, owner, ownerType, projectNumber)
 						if visibilityErr == nil {
@@ -267,29 +374,41 @@ Use this tool to list projects for a user or organization, or list project field
 					}
 					return result, payload, err
 				case projectsMethodListProjectItems:
-					result, payload, err := listProjectItems(ctx, client, args, owner, ownerType)
+					gqlClient, err := deps.GetGQLClient(ctx)
+					if err != nil {
+						return utils.NewToolResultError(err.Error()), nil, nil
+					}
+					result, payload, err := listProjectItems(ctx, client, gqlClient, args, owner, ownerType, defaultPerPage)
 					if shouldAttachIFCLabel(ctx, deps, result) {
 						isPrivate, visibilityErr := FetchProjectIsPrivate(ctx, client, owner, ownerType, projectNumber)
 						if visibilityErr == nil {
 							result = attachProjectVisibilityIFCLabel(ctx, deps, result, isPrivate, ifc.LabelProjectContent)
 						}
 					}
-					return result, payload, err
+					return limitResponseSize(deps, result, "Request a smaller page with per_page or fewer fields, and fetch the rest with the after cursor."), payload, err
 				case projectsMethodListProjectStatusUpdates:
 					gqlClient, err := deps.GetGQLClient(ctx)
 					if err != nil {
 						return utils.NewToolResultError(err.Error()), nil, nil
 					}
-					result, isPrivate, payload, err := listProjectStatusUpdates(ctx, gqlClient, args, owner, ownerType)
+					result, isPrivate, payload, err := listProjectStatusUpdates(ctx, gqlClient, args, owner, ownerType, defaultPerPage)
 					result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelProjectContent(isPrivate))
 					return result, payload, err
+				case projectsMethodListProjectWorkflows:
+					gqlClient, err := deps.GetGQLClient(ctx)
+					if err != nil {
+						return utils.NewToolResultError(err.Error()), nil, nil
+					}
+					result, isPrivate, payload, err := listProjectWorkflows(ctx, gqlClient, args, owner, ownerType, defaultPerPage)
+					result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelProject(isPrivate))
+					return result, payload, err
 				default:
 					return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 				}
 			default:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 			}
-		},
+		}),
 	)
 	return tool
 }
@@ -302,6 +421,8 @@ func ProjectsGet(t translations.TranslationHelperFunc) inventory.ServerTool {
 			Name: "projects_get",
 			Description: t("TOOL_PROJECTS_GET_DESCRIPTION", `Get details about specific GitHub Projects resources.
 Use this tool to get details about individual projects, project fields, and project items by their unique IDs.
+Use 'get_project_item_count' to get the total number of items in a project without listing them.
+Use 'get_project_board' to get a project's items grouped by the value of a single-select field such as Status, like the columns of a board.
 `),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_PROJECTS_GET_USER_TITLE", "Get details of GitHub Projects resources"),
@@ -317,6 +438,8 @@ Use this tool to get details about individual projects, project fields, and proj
 							projectsMethodGetProject,
 							projectsMethodGetProjectField,
 							projectsMethodGetProjectItem,
+							projectsMethodGetProjectItemCount,
+							projectsMethodGetProjectBoard,
 							projectsMethodGetProjectStatusUpdate,
 						},
 					},
@@ -335,7 +458,7 @@ Use this tool to get details about individual projects, project fields, and proj
 					},
 					"field_id": {
 						Type:        "number",
-						Description: "The field's ID. Required for 'get_project_field' method.",
+						Description: "The field's ID. Required for 'get_project_field' method unless field_name is given. For 'get_project_item', returns only this field's value instead of the whole item.",
 					},
 					"item_id": {
 						Type:        "number",
@@ -343,7 +466,14 @@ Use this tool to get details about individual projects, project fields, and proj
 					},
 					"fields": {
 						Type:        "array",
-						Description: "Specific list of field IDs to include in the response when getting a project item (e.g. [\"102589\", \"985201\", \"169875\"]). If not provided, only the title field is included. Only used for 'get_project_item' method.",
+						Description: "Specific list of field IDs to include in the response when getting a project item (e.g. [\"102589\", \"985201\", \"169875\"]). If not provided, every field's value is included. Only used for 'get_project_item' method.",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"item_fields": {
+						Type:        "array",
+						Description: "Parts of the item to keep in the response (e.g. [\"title\", \"status\", \"assignees\"]): item or content properties such as created_at, creator, number, state, labels or repository, or project field names (case-insensitive; 'fields' keeps every field value, 'content' the whole content). The item id is always kept. If not provided, the full minimal item is returned. Only used for 'get_project_item' method.",
 						Items: &jsonschema.Schema{
 							Type: "string",
 						},
@@ -352,12 +482,22 @@ Use this tool to get details about individual projects, project fields, and proj
 						Type:        "string",
 						Description: "The node ID of the project status update. Required for 'get_project_status_update' method.",
 					},
+					"field_name": {
+						Type:        "string",
+						Description: fmt.Sprintf("A field's name, not case sensitive. For 'get_project_field', an alternative to field_id, e.g. \"Status\". For 'get_project_board', the single-select field to group items by (default %q).", DefaultProjectBoardField),
+					},
+					"items_per_column": {
+						Type:        "number",
+						Description: fmt.Sprintf("Maximum number of items to list in each column. Only used for 'get_project_board' method (default %d, max %d).", DefaultProjectBoardItemsPerColumn, MaxProjectBoardItemsPerColumn),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(float64(MaxProjectBoardItemsPerColumn)),
+					},
 				},
 				Required: []string{"method"},
 			},
 		},
 		[]scopes.Scope{scopes.ReadProject},
-		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+		explainOwnerTypeMismatch(func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
 			method, err := RequiredParam[string](args, "method")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -398,6 +538,11 @@ Use this tool to get details about individual projects, project fields, and proj
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
+			var session *mcp.ServerSession
+			if req != nil {
+				session = req.Session
+			}
+
 			// Detect owner type if not provided
 			if ownerType == "" {
 				ownerType, err = detectOwnerType(ctx, client, owner, projectNumber)
@@ -412,9 +557,12 @@ Use this tool to get details about individual projects, project fields, and proj
 				result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelProject(isPrivate))
 				return result, payload, err
 			case projectsMethodGetProjectField:
-				fieldID, err := RequiredBigInt(args, "field_id")
-				if err != nil {
-					return utils.NewToolResultError(err.Error()), nil, nil
+				fieldID, result := projectFieldIDFromArgs(ctx, client, args, owner, ownerType, projectNumber)
+				if result != nil {
+					return result, nil, nil
+				}
+				if fieldID == 0 {
+					return utils.NewToolResultError("missing required parameter: field_id or field_name"), nil, nil
 				}
 				result, payload, err := getProjectField(ctx, client, owner, ownerType, projectNumber, fieldID)
 				if shouldAttachIFCLabel(ctx, deps, result) {
@@ -433,7 +581,15 @@ Use this tool to get details about individual projects, project fields, and proj
 				if err != nil {
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
-				result, payload, err := getProjectItem(ctx, client, owner, ownerType, projectNumber, itemID, fields)
+				itemFields, err := OptionalStringArrayParam(args, "item_fields")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				fieldID, err := OptionalIntParam(args, "field_id")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				result, payload, err := getProjectItem(ctx, client, session, owner, ownerType, projectNumber, itemID, fields, itemFields, int64(fieldID))
 				if shouldAttachIFCLabel(ctx, deps, result) {
 					isPrivate, visibilityErr := FetchProjectIsPrivate(ctx, client, owner, ownerType, projectNumber)
 					if visibilityErr == nil {
@@ -441,10 +597,40 @@ Use this tool to get details about individual projects, project fields, and proj
 					}
 				}
 				return result, payload, err
+			case projectsMethodGetProjectItemCount:
+				gqlClient, err := deps.GetGQLClient(ctx)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				result, isPrivate, payload, err := getProjectItemCount(ctx, gqlClient, owner, ownerType, projectNumber)
+				result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelProject(isPrivate))
+				return result, payload, err
+			case projectsMethodGetProjectBoard:
+				fieldName, err := OptionalParam[string](args, "field_name")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				if fieldName == "" {
+					fieldName = DefaultProjectBoardField
+				}
+				itemsPerColumn, err := OptionalIntParamWithDefault(args, "items_per_column", DefaultProjectBoardItemsPerColumn)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				if itemsPerColumn < 1 || itemsPerColumn > MaxProjectBoardItemsPerColumn {
+					return utils.NewToolResultError(fmt.Sprintf("items_per_column must be between 1 and %d", MaxProjectBoardItemsPerColumn)), nil, nil
+				}
+				gqlClient, err := deps.GetGQLClient(ctx)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				result, isPrivate, payload, err := getProjectBoard(ctx, gqlClient, owner, ownerType, projectNumber, fieldName, itemsPerColumn)
+				result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelProjectContent(isPrivate))
+				return result, payload, err
 			default:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 			}
-		},
+		}),
 	)
 	return tool
 }
@@ -455,7 +641,7 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 		ToolsetMetadataProjects,
 		mcp.Tool{
 			Name:        "projects_write",
-			Description: t("TOOL_PROJECTS_WRITE_DESCRIPTION", "Create and manage GitHub Projects: create projects, add/update/delete items, create status updates, and add iteration fields."),
+			Description: t("TOOL_PROJECTS_WRITE_DESCRIPTION", "Create and manage GitHub Projects: create or copy projects, add/update/delete and reorder items, create status updates, and add iteration fields."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:           t("TOOL_PROJECTS_WRITE_USER_TITLE", "Manage GitHub Projects"),
 				ReadOnlyHint:    false,
@@ -474,6 +660,8 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 							projectsMethodCreateProjectStatusUpdate,
 							projectsMethodCreateProject,
 							projectsMethodCreateIterationField,
+							projectsMethodCopyProject,
+							projectsMethodUpdateProjectItemPosition,
 						},
 					},
 					"owner_type": {
@@ -491,11 +679,32 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 					},
 					"title": {
 						Type:        "string",
-						Description: "The project title. Required for 'create_project' method.",
+						Description: "The project title. Required for 'create_project' and 'copy_project' methods.",
+					},
+					"target_owner": {
+						Type:        "string",
+						Description: "The user or organization login to create the copy under. Used for 'copy_project' method; defaults to owner.",
+					},
+					"target_owner_type": {
+						Type:        "string",
+						Description: "Owner type of target_owner (user or org). Used for 'copy_project' method; detected automatically when omitted.",
+						Enum:        []any{"user", "org"},
+					},
+					"include_draft_issues": {
+						Type:        "boolean",
+						Description: "Whether to copy the project's draft issues too. Used for 'copy_project' method.",
 					},
 					"item_id": {
 						Type:        "number",
-						Description: "The project item ID. Required for 'update_project_item' and 'delete_project_item' methods.",
+						Description: "The project item ID. Required for 'update_project_item', 'delete_project_item' and 'update_project_item_position' methods.",
+					},
+					"after_item_id": {
+						Type:        "number",
+						Description: "The ID of the project item to place the item after. Used for 'update_project_item_position' method; omit to move the item to the top.",
+					},
+					"confirm": {
+						Type:        "boolean",
+						Description: "Must be true to execute 'delete_project_item'. When omitted or false, nothing is deleted and an error describing the item that would be deleted is returned instead.",
 					},
 					"item_type": {
 						Type:        "string",
@@ -575,7 +784,7 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 			},
 		},
 		[]scopes.Scope{scopes.Project},
-		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+		explainOwnerTypeMismatch(func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
 			method, err := RequiredParam[string](args, "method")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -637,7 +846,7 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 				var itemNumber int
 				switch itemType {
 				case "issue":
-					itemNumber, err = RequiredInt(args, "issue_number")
+					itemNumber, err = RequiredPositiveInt(args, "issue_number")
 					if err != nil {
 						return utils.NewToolResultError("issue_number is required when item_type is 'issue'"), nil, nil
 					}
@@ -670,6 +879,13 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 				if err != nil {
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
+				confirm, err := OptionalParam[bool](args, "confirm")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				if !confirm {
+					return previewProjectItemDeletion(ctx, client, owner, ownerType, projectNumber, itemID)
+				}
 				return deleteProjectItem(ctx, client, owner, ownerType, projectNumber, itemID)
 			case projectsMethodCreateProjectStatusUpdate:
 				body, err := OptionalParam[string](args, "body")
@@ -691,23 +907,38 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return createProjectStatusUpdate(ctx, gqlClient, owner, ownerType, projectNumber, body, status, startDate, targetDate)
 			case projectsMethodCreateIterationField:
 				return createIterationField(ctx, gqlClient, owner, ownerType, projectNumber, args)
+			case projectsMethodCopyProject:
+				return copyProject(ctx, client, gqlClient, owner, ownerType, projectNumber, args)
+			case projectsMethodUpdateProjectItemPosition:
+				itemID, err := RequiredBigInt(args, "item_id")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				var afterItemID int64
+				if _, ok := args["after_item_id"]; ok {
+					afterItemID, err = RequiredBigInt(args, "after_item_id")
+					if err != nil {
+						return utils.NewToolResultError(err.Error()), nil, nil
+					}
+				}
+				return updateProjectItemPosition(ctx, client, gqlClient, owner, ownerType, projectNumber, itemID, afterItemID)
 			default:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 			}
-		},
+		}),
 	)
 	return tool
 }
 
 // Helper functions for consolidated projects tools
 
-func listProjects(ctx context.Context, client *github.Client, args map[string]any, owner, ownerType string) (*mcp.CallToolResult, []bool, any, error) {
+func listProjects(ctx context.Context, client *github.Client, args map[string]any, owner, ownerType string, defaultPerPage int) (*mcp.CallToolResult, []bool, any, error) {
 	queryStr, err := OptionalParam[string](args, "query")
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil, nil
 	}
 
-	pagination, err := extractPaginationOptionsFromArgs(args)
+	pagination, err := extractPaginationOptionsFromArgs(args, defaultPerPage)
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil, nil
 	}
@@ -835,13 +1066,13 @@ func projectVisibilities(projects []MinimalProject) []bool {
 	return visibilities
 }
 
-func listProjectFields(ctx context.Context, client *github.Client, args map[string]any, owner, ownerType string) (*mcp.CallToolResult, any, error) {
+func listProjectFields(ctx context.Context, client *github.Client, args map[string]any, owner, ownerType string, defaultPerPage int) (*mcp.CallToolResult, any, error) {
 	projectNumber, err := RequiredInt(args, "project_number")
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
 	}
 
-	pagination, err := extractPaginationOptionsFromArgs(args)
+	pagination, err := extractPaginationOptionsFromArgs(args, defaultPerPage)
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
 	}
@@ -881,7 +1112,7 @@ func listProjectFields(ctx context.Context, client *github.Client, args map[stri
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func listProjectItems(ctx context.Context, client *github.Client, args map[string]any, owner, ownerType string) (*mcp.CallToolResult, any, error) {
+func listProjectItems(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, args map[string]any, owner, ownerType string, defaultPerPage int) (*mcp.CallToolResult, any, error) {
 	projectNumber, err := RequiredInt(args, "project_number")
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
@@ -897,11 +1128,50 @@ func listProjectItems(ctx context.Context, client *github.Client, args map[strin
 		return utils.NewToolResultError(err.Error()), nil, nil
 	}
 
-	pagination, err := extractPaginationOptionsFromArgs(args)
+	itemFields, err := OptionalStringArrayParam(args, "item_fields")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	pagination, err := extractPaginationOptionsFromArgs(args, defaultPerPage)
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
 	}
 
+	resolveContent, err := OptionalParam[bool](args, "resolve_content")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if resolveContent && pagination.PerPage > MaxResolvedProjectItems {
+		pagination.PerPage = MaxResolvedProjectItems
+	}
+
+	creator, err := OptionalParam[string](args, "creator")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	contentType, err := OptionalParam[string](args, "content_type")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	switch contentType {
+	case "", string(github.ProjectV2ItemContentTypeIssue), string(github.ProjectV2ItemContentTypePullRequest), string(github.ProjectV2ItemContentTypeDraftIssue):
+	default:
+		return utils.NewToolResultError(fmt.Sprintf("invalid content_type %q: must be one of Issue, PullRequest, DraftIssue", contentType)), nil, nil
+	}
+
+	updatedSinceStr, err := OptionalParam[string](args, "updated_since")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	var updatedSince time.Time
+	if updatedSinceStr != "" {
+		if updatedSince, err = parseISOTimestamp(updatedSinceStr); err != nil {
+			return utils.NewToolResultError(fmt.Sprintf("invalid updated_since: %s", err.Error())), nil, nil
+		}
+	}
+
 	var resp *github.Response
 	var projectItems []*github.ProjectV2Item
 
@@ -928,9 +1198,47 @@ func listProjectItems(ctx context.Context, client *github.Client, args map[strin
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	// Items are listed in the project's order, so their positions are known
+	// when the page starts at the first item and no query skips any.
+	var positions map[int64]int
+	if queryStr == "" && pagination.After == "" && pagination.Before == "" {
+		positions = make(map[int64]int, len(projectItems))
+		for i, item := range projectItems {
+			positions[item.GetID()] = i + 1
+		}
+	}
+
+	// The creator, content_type and updated_since filters are not supported
+	// by the API, so they only narrow the page that was fetched.
+	if creator != "" || contentType != "" || !updatedSince.IsZero() {
+		projectItems = slices.DeleteFunc(projectItems, func(item *github.ProjectV2Item) bool {
+			if creator != "" && !strings.EqualFold(item.GetCreator().GetLogin(), creator) {
+				return true
+			}
+			if !updatedSince.IsZero() && item.GetUpdatedAt().Before(updatedSince) {
+				return true
+			}
+			return contentType != "" && (item.ContentType == nil || string(*item.ContentType) != contentType)
+		})
+	}
+
 	minimalItems := make([]MinimalProjectItem, 0, len(projectItems))
 	for _, item := range projectItems {
-		minimalItems = append(minimalItems, convertToMinimalProjectItem(item))
+		minimalItem := convertToMinimalProjectItem(item)
+		minimalItem.Position = positions[item.GetID()]
+		minimalItems = append(minimalItems, minimalItem)
+	}
+
+	if resolveContent {
+		if err := resolveProjectItemContent(ctx, gqlClient, projectItems, minimalItems); err != nil {
+			return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to resolve project item content", err), nil, nil
+		}
+	}
+
+	if len(itemFields) > 0 {
+		for i := range minimalItems {
+			minimalItems[i] = selectProjectItemFields(minimalItems[i], itemFields)
+		}
 	}
 
 	response := map[string]any{
@@ -946,6 +1254,106 @@ func listProjectItems(ctx context.Context, client *github.Client, args map[strin
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// projectItemContentNodesQuery batches a nodes(ids:) lookup over project item content node IDs
+// to retrieve a summary of each item's issue, pull request or draft issue.
+type projectItemContentNodesQuery struct {
+	Nodes []struct {
+		TypeName githubv4.String `graphql:"__typename"`
+		Issue    struct {
+			ID         githubv4.ID
+			Number     githubv4.Int
+			Title      githubv4.String
+			State      githubv4.String
+			URL        githubv4.String
+			Repository struct {
+				NameWithOwner githubv4.String
+			}
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			ID         githubv4.ID
+			Number     githubv4.Int
+			Title      githubv4.String
+			State      githubv4.String
+			IsDraft    githubv4.Boolean
+			Merged     githubv4.Boolean
+			URL        githubv4.String
+			Repository struct {
+				NameWithOwner githubv4.String
+			}
+		} `graphql:"... on PullRequest"`
+		DraftIssue struct {
+			ID    githubv4.ID
+			Title githubv4.String
+		} `graphql:"... on DraftIssue"`
+	} `graphql:"nodes(ids: $ids)"`
+}
+
+// resolveProjectItemContent fills in Content for items whose REST payload carried only a
+// content node ID. minimalItems must be the converted form of items, index for index. Items
+// that already have content, or have no content node ID, are left untouched, and an empty
+// set of IDs short-circuits the round-trip.
+func resolveProjectItemContent(ctx context.Context, gqlClient *githubv4.Client, items []*github.ProjectV2Item, minimalItems []MinimalProjectItem) error {
+	ids := make([]githubv4.ID, 0, len(items))
+	for i, item := range items {
+		if minimalItems[i].Content != nil || item.GetContentNodeID() == "" {
+			continue
+		}
+		ids = append(ids, githubv4.ID(item.GetContentNodeID()))
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var q projectItemContentNodesQuery
+	if err := gqlClient.Query(ctx, &q, map[string]any{"ids": ids}); err != nil {
+		return err
+	}
+
+	contentByID := make(map[string]*MinimalProjectItemContent, len(q.Nodes))
+	for _, n := range q.Nodes {
+		switch n.TypeName {
+		case "Issue":
+			id, _ := n.Issue.ID.(string)
+			contentByID[id] = &MinimalProjectItemContent{
+				NodeID:     id,
+				Number:     int(n.Issue.Number),
+				Title:      string(n.Issue.Title),
+				State:      strings.ToLower(string(n.Issue.State)),
+				HTMLURL:    string(n.Issue.URL),
+				Repository: string(n.Issue.Repository.NameWithOwner),
+			}
+		case "PullRequest":
+			id, _ := n.PullRequest.ID.(string)
+			contentByID[id] = &MinimalProjectItemContent{
+				NodeID:     id,
+				Number:     int(n.PullRequest.Number),
+				Title:      string(n.PullRequest.Title),
+				State:      strings.ToLower(string(n.PullRequest.State)),
+				Draft:      bool(n.PullRequest.IsDraft),
+				Merged:     bool(n.PullRequest.Merged),
+				HTMLURL:    string(n.PullRequest.URL),
+				Repository: string(n.PullRequest.Repository.NameWithOwner),
+			}
+		case "DraftIssue":
+			id, _ := n.DraftIssue.ID.(string)
+			contentByID[id] = &MinimalProjectItemContent{
+				NodeID: id,
+				Title:  string(n.DraftIssue.Title),
+			}
+		}
+	}
+
+	for i, item := range items {
+		if minimalItems[i].Content != nil {
+			continue
+		}
+		if content, ok := contentByID[item.GetContentNodeID()]; ok {
+			minimalItems[i].Content = content
+		}
+	}
+	return nil
+}
+
 func fetchProjectV2(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int) (*github.ProjectV2, *github.Response, error) {
 	if ownerType == "org" {
 		return client.Projects.GetOrganizationProject(ctx, owner, projectNumber)
@@ -953,6 +1361,79 @@ func fetchProjectV2(ctx context.Context, client *github.Client, owner, ownerType
 	return client.Projects.GetUserProject(ctx, owner, projectNumber)
 }
 
+func fetchProjectField(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, fieldID int64) (*github.ProjectV2Field, *github.Response, error) {
+	if ownerType == "org" {
+		return client.Projects.GetOrganizationProjectField(ctx, owner, projectNumber, fieldID)
+	}
+	return client.Projects.GetUserProjectField(ctx, owner, projectNumber, fieldID)
+}
+
+// projectFieldIDFromArgs returns the field_id argument, or else the ID of the
+// field named by field_name, or 0 when neither is given. The result is set
+// when the arguments are invalid or no field has that name.
+func projectFieldIDFromArgs(ctx context.Context, client *github.Client, args map[string]any, owner, ownerType string, projectNumber int) (int64, *mcp.CallToolResult) {
+	if value, ok := args["field_id"]; ok && value != nil {
+		fieldID, err := toInt64(value)
+		if err != nil {
+			return 0, utils.NewToolResultError(fmt.Sprintf("parameter field_id is not a valid number: %v", err))
+		}
+		if fieldID != 0 {
+			return fieldID, nil
+		}
+	}
+	fieldName, err := OptionalParam[string](args, "field_name")
+	if err != nil {
+		return 0, utils.NewToolResultError(err.Error())
+	}
+	if fieldName == "" {
+		return 0, nil
+	}
+	return resolveProjectFieldID(ctx, client, owner, ownerType, projectNumber, fieldName)
+}
+
+// resolveProjectFieldID returns the ID of the project field named name, not
+// case sensitive, so callers can refer to fields such as "Status" by name.
+// When no field matches, the result lists the project's field names.
+func resolveProjectFieldID(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, name string) (int64, *mcp.CallToolResult) {
+	opts := &github.ListProjectsOptions{
+		ListProjectsPaginationOptions: github.ListProjectsPaginationOptions{PerPage: 100},
+	}
+	var names []string
+	for {
+		var projectFields []*github.ProjectV2Field
+		var resp *github.Response
+		var err error
+		if ownerType == "org" {
+			projectFields, resp, err = client.Projects.ListOrganizationProjectFields(ctx, owner, projectNumber, opts)
+		} else {
+			projectFields, resp, err = client.Projects.ListUserProjectFields(ctx, owner, projectNumber, opts)
+		}
+		if err != nil {
+			return 0, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err)
+		}
+		_ = resp.Body.Close()
+
+		for _, field := range projectFields {
+			if strings.EqualFold(field.GetName(), name) {
+				return field.GetID(), nil
+			}
+			names = append(names, field.GetName())
+		}
+		if resp.After == "" {
+			break
+		}
+		opts.After = resp.After
+	}
+	return 0, utils.NewToolResultError(fmt.Sprintf("project %d has no field named %q; fields: %s", projectNumber, name, strings.Join(names, ", ")))
+}
+
+func fetchProjectItem(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, itemID int64, opts *github.GetProjectItemOptions) (*github.ProjectV2Item, *github.Response, error) {
+	if ownerType == "org" {
+		return client.Projects.GetOrganizationProjectItem(ctx, owner, projectNumber, itemID, opts)
+	}
+	return client.Projects.GetUserProjectItem(ctx, owner, projectNumber, itemID, opts)
+}
+
 // FetchProjectIsPrivate returns whether a GitHub Project is private.
 func FetchProjectIsPrivate(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int) (bool, error) {
 	project, resp, err := fetchProjectV2(ctx, client, owner, ownerType, projectNumber)
@@ -997,16 +1478,7 @@ func getProject(ctx context.Context, client *github.Client, owner, ownerType str
 }
 
 func getProjectField(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, fieldID int64) (*mcp.CallToolResult, any, error) {
-	var resp *github.Response
-	var projectField *github.ProjectV2Field
-	var err error
-
-	if ownerType == "org" {
-		projectField, resp, err = client.Projects.GetOrganizationProjectField(ctx, owner, projectNumber, fieldID)
-	} else {
-		projectField, resp, err = client.Projects.GetUserProjectField(ctx, owner, projectNumber, fieldID)
-	}
-
+	projectField, resp, err := fetchProjectField(ctx, client, owner, ownerType, projectNumber, fieldID)
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx,
 			"failed to get project field",
@@ -1031,24 +1503,89 @@ func getProjectField(ctx context.Context, client *github.Client, owner, ownerTyp
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func getProjectItem(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, itemID int64, fields []int64) (*mcp.CallToolResult, any, error) {
-	var resp *github.Response
-	var projectItem *github.ProjectV2Item
-	var opts *github.GetProjectItemOptions
-	var err error
+// ProjectFieldOption is one option of a single-select project field.
+type ProjectFieldOption struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ProjectFieldOptions is the output of the list_project_field_options method.
+type ProjectFieldOptions struct {
+	FieldID   int64                `json:"field_id"`
+	FieldName string               `json:"field_name"`
+	Options   []ProjectFieldOption `json:"options"`
+}
 
+// listProjectFieldOptions returns the options of a single-select field, whose
+// IDs are what update_project_item expects as the field's value.
+func listProjectFieldOptions(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, fieldID int64) (*mcp.CallToolResult, any, error) {
+	projectField, resp, err := fetchProjectField(ctx, client, owner, ownerType, projectNumber, fieldID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx,
+			"failed to get project field",
+			resp,
+			err,
+		), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if dataType := projectField.GetDataType(); dataType != "single_select" {
+		return utils.NewToolResultError(fmt.Sprintf("field %q is a %s field, not a single_select field, and has no options", projectField.GetName(), dataType)), nil, nil
+	}
+
+	result := ProjectFieldOptions{
+		FieldID:   projectField.GetID(),
+		FieldName: projectField.GetName(),
+		Options:   make([]ProjectFieldOption, 0, len(projectField.Options)),
+	}
+	for _, option := range projectField.Options {
+		if option == nil {
+			continue
+		}
+		result.Options = append(result.Options, ProjectFieldOption{
+			ID:          option.GetID(),
+			Name:        option.GetName().GetRaw(),
+			Color:       option.GetColor(),
+			Description: option.GetDescription().GetRaw(),
+		})
+	}
+
+	return MarshalledTextResult(result), nil, nil
+}
+
+// getProjectItem fetches a project item, keeping only the parts named in
+// itemFields when it is non-empty. Without fields every field value is
+// included. Single-select values are named from the project's field
+// definitions, cached for session. When fieldID is non-zero only that field's
+// value is returned, and it is an error for the item to lack it.
+func getProjectItem(ctx context.Context, client *github.Client, session *mcp.ServerSession, owner, ownerType string, projectNumber int, itemID int64, fields []int64, itemFields []string, fieldID int64) (*mcp.CallToolResult, any, error) {
+	if fieldID != 0 && !slices.Contains(fields, fieldID) {
+		fields = append(fields, fieldID)
+	}
+
+	// A single item is cheap to return whole. If the project's fields cannot
+	// be listed, the item comes back without field values as the API returns
+	// it by default.
+	var definitions []*github.ProjectV2Field
+	if len(fields) == 0 {
+		if projectFields, err := cachedProjectFields(ctx, client, session, owner, ownerType, projectNumber); err == nil {
+			definitions = projectFields
+			for _, field := range projectFields {
+				fields = append(fields, field.GetID())
+			}
+		}
+	}
+
+	var opts *github.GetProjectItemOptions
 	if len(fields) > 0 {
 		opts = &github.GetProjectItemOptions{
 			Fields: fields,
 		}
 	}
 
-	if ownerType == "org" {
-		projectItem, resp, err = client.Projects.GetOrganizationProjectItem(ctx, owner, projectNumber, itemID, opts)
-	} else {
-		projectItem, resp, err = client.Projects.GetUserProjectItem(ctx, owner, projectNumber, itemID, opts)
-	}
-
+	projectItem, resp, err := fetchProjectItem(ctx, client, owner, ownerType, projectNumber, itemID, opts)
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx,
 			"failed to get project item",
@@ -1066,7 +1603,27 @@ func getProjectItem(ctx context.Context, client *github.Client, owner, ownerType
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get project item", resp, body), nil, nil
 	}
 
-	r, err := json.Marshal(convertToMinimalProjectItem(projectItem))
+	minimalItem := convertToMinimalProjectItem(projectItem)
+	if hasUnnamedSingleSelectValue(minimalItem.Fields) {
+		if definitions == nil {
+			// On failure the option IDs are returned as they are.
+			definitions, _ = cachedProjectFields(ctx, client, session, owner, ownerType, projectNumber)
+		}
+		resolveSingleSelectValues(minimalItem.Fields, definitions)
+	}
+	if fieldID != 0 {
+		idx := slices.IndexFunc(minimalItem.Fields, func(f MinimalProjectItemFieldValue) bool { return f.ID == fieldID })
+		if idx < 0 {
+			return utils.NewToolResultError(fmt.Sprintf("project item %d has no field with ID %d", itemID, fieldID)), nil, nil
+		}
+		r, err := json.Marshal(minimalItem.Fields[idx])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+		}
+		return utils.NewToolResultText(string(r)), nil, nil
+	}
+
+	r, err := json.Marshal(selectProjectItemFields(minimalItem, itemFields))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
 	}
@@ -1105,7 +1662,23 @@ func updateProjectItem(ctx context.Context, client *github.Client, owner, ownerT
 		}
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, ProjectUpdateFailedError, resp, body), nil, nil
 	}
-	r, err := json.Marshal(convertToMinimalProjectItem(updatedItem))
+	minimalItem := convertToMinimalProjectItem(updatedItem)
+	// Follow-up GraphQL calls need the node IDs, which the update response
+	// may leave out. The update has already succeeded, so a failed lookup
+	// only leaves them unset.
+	if minimalItem.NodeID == "" || minimalItem.ProjectNodeID == "" {
+		if item, resp, err := fetchProjectItem(ctx, client, owner, ownerType, projectNumber, itemID, nil); err == nil {
+			_ = resp.Body.Close()
+			if minimalItem.NodeID == "" {
+				minimalItem.NodeID = item.GetNodeID()
+			}
+			if minimalItem.ProjectNodeID == "" {
+				minimalItem.ProjectNodeID = item.GetProjectNodeID()
+			}
+		}
+	}
+
+	r, err := json.Marshal(minimalItem)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
 	}
@@ -1113,6 +1686,42 @@ func updateProjectItem(ctx context.Context, client *github.Client, owner, ownerT
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// previewProjectItemDeletion is the response to delete_project_item without
+// confirm: it fetches the item so the caller can check it is the intended one
+// before retrying with confirm set to true. Nothing is deleted.
+func previewProjectItemDeletion(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, itemID int64) (*mcp.CallToolResult, any, error) {
+	item, resp, err := fetchProjectItem(ctx, client, owner, ownerType, projectNumber, itemID, nil)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx,
+			"failed to get project item",
+			resp,
+			err,
+		), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get project item", resp, body), nil, nil
+	}
+
+	minimalItem := convertToMinimalProjectItem(item)
+	description := minimalItem.ContentType
+	if description == "" {
+		description = "item"
+	}
+	if minimalItem.Content != nil && minimalItem.Content.Title != "" {
+		description = fmt.Sprintf("%s %q", description, minimalItem.Content.Title)
+	}
+	return utils.NewToolResultError(fmt.Sprintf(
+		"confirmation required: delete_project_item would delete project item %d (%s) from project %d owned by %s. Call again with confirm set to true to delete it.",
+		itemID, description, projectNumber, owner,
+	)), nil, nil
+}
+
 func deleteProjectItem(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, itemID int64) (*mcp.CallToolResult, any, error) {
 	var resp *github.Response
 	var err error
@@ -1203,6 +1812,9 @@ func addProjectItem(ctx context.Context, gqlClient *githubv4.Client, owner, owne
 			Item struct {
 				ID             githubv4.ID
 				FullDatabaseID string `graphql:"fullDatabaseId"`
+				Project        struct {
+					URL githubv4.String
+				}
 			}
 		} `graphql:"addProjectV2ItemById(input: $input)"`
 	}
@@ -1213,6 +1825,20 @@ func addProjectItem(ctx context.Context, gqlClient *githubv4.Client, owner, owne
 		return utils.NewToolResultError(err.Error()), nil, nil
 	}
 
+	// Adding an item that is already in the project returns the existing
+	// item, so report that case instead of claiming a new item was added. If
+	// the check fails the add goes ahead, as it is safe to repeat.
+	if existing, err := findProjectItemForContent(ctx, gqlClient, nodeID, projectID); err == nil && existing != nil {
+		result := projectItemAddedResult(existing.ID, projectID, existing.FullDatabaseID, string(existing.Project.URL), owner, ownerType, projectNumber)
+		result["already_in_project"] = true
+		result["message"] = fmt.Sprintf("%s %s/%s#%d is already in project %s/%d, so no item was added", itemType, itemOwner, itemRepo, itemNumber, owner, projectNumber)
+		if existing.IsArchived {
+			result["archived"] = true
+			result["message"] = fmt.Sprintf("%s %s/%s#%d is already in project %s/%d as an archived item, so no item was added", itemType, itemOwner, itemRepo, itemNumber, owner, projectNumber)
+		}
+		return MarshalledTextResult(result), nil, nil
+	}
+
 	// Add the item to the project
 	input := githubv4.AddProjectV2ItemByIdInput{
 		ProjectID: projectID,
@@ -1224,23 +1850,100 @@ func addProjectItem(ctx context.Context, gqlClient *githubv4.Client, owner, owne
 		return utils.NewToolResultError(fmt.Sprintf(ProjectAddFailedError+": %v", err)), nil, nil
 	}
 
+	item := mutation.AddProjectV2ItemByID.Item
+	result := projectItemAddedResult(item.ID, projectID, item.FullDatabaseID, string(item.Project.URL), owner, ownerType, projectNumber)
+	result["message"] = fmt.Sprintf("Successfully added %s %s/%s#%d to project %s/%d", itemType, itemOwner, itemRepo, itemNumber, owner, projectNumber)
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// projectItemAddedResult describes a project item returned by
+// add_project_item. id is the item's node ID, which is also reported as
+// node_id alongside the project's node ID for follow-up GraphQL calls.
+func projectItemAddedResult(id, projectID githubv4.ID, fullDatabaseID, projectURL, owner, ownerType string, projectNumber int) map[string]any {
+	if projectURL == "" {
+		projectURL = projectHTMLURL(owner, ownerType, projectNumber)
+	}
 	result := map[string]any{
-		"id":      mutation.AddProjectV2ItemByID.Item.ID,
-		"message": fmt.Sprintf("Successfully added %s %s/%s#%d to project %s/%d", itemType, itemOwner, itemRepo, itemNumber, owner, projectNumber),
+		"id":              id,
+		"node_id":         id,
+		"project_node_id": projectID,
+		"project_url":     projectURL,
 	}
-	if fullDatabaseID := mutation.AddProjectV2ItemByID.Item.FullDatabaseID; fullDatabaseID != "" {
+	if fullDatabaseID != "" {
 		result["full_database_id"] = fullDatabaseID
+		result["item_url"] = projectItemHTMLURL(projectURL, fullDatabaseID)
 		if itemID, err := strconv.ParseInt(fullDatabaseID, 10, 64); err == nil {
 			result["item_id"] = itemID
 		}
 	}
+	return result
+}
 
-	r, err := json.Marshal(result)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+// contentProjectItem is an item linking an issue or pull request to a
+// project.
+type contentProjectItem struct {
+	ID             githubv4.ID
+	FullDatabaseID string `graphql:"fullDatabaseId"`
+	IsArchived     bool
+	Project        struct {
+		ID  githubv4.ID
+		URL githubv4.String
 	}
+}
 
-	return utils.NewToolResultText(string(r)), nil, nil
+// contentProjectItemsQuery reads the project items of an issue or pull
+// request.
+type contentProjectItemsQuery struct {
+	Node struct {
+		Issue struct {
+			ProjectItems struct {
+				Nodes []contentProjectItem
+			} `graphql:"projectItems(first: 100)"`
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			ProjectItems struct {
+				Nodes []contentProjectItem
+			} `graphql:"projectItems(first: 100)"`
+		} `graphql:"... on PullRequest"`
+	} `graphql:"node(id: $contentId)"`
+}
+
+// findProjectItemForContent returns the item linking an issue or pull
+// request to a project, or nil when it is not in the project.
+func findProjectItemForContent(ctx context.Context, gqlClient *githubv4.Client, contentID, projectID githubv4.ID) (*contentProjectItem, error) {
+	var query contentProjectItemsQuery
+	if err := gqlClient.Query(ctx, &query, map[string]any{"contentId": contentID}); err != nil {
+		return nil, err
+	}
+	items := slices.Concat(query.Node.Issue.ProjectItems.Nodes, query.Node.PullRequest.ProjectItems.Nodes)
+	for i := range items {
+		if items[i].Project.ID == projectID {
+			return &items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// projectHTMLURL builds the web URL of a project on github.com. It is the
+// fallback for when GitHub does not return the project's URL.
+func projectHTMLURL(owner, ownerType string, projectNumber int) string {
+	kind := "users"
+	if ownerType == "org" {
+		kind = "orgs"
+	}
+	return fmt.Sprintf("https://github.com/%s/%s/projects/%d", kind, url.PathEscape(owner), projectNumber)
+}
+
+// projectItemHTMLURL returns the URL that opens an item in the side pane of
+// its project.
+func projectItemHTMLURL(projectURL, itemID string) string {
+	return projectURL + "?pane=issue&itemId=" + url.QueryEscape(itemID)
 }
 
 // validateDateFormat checks that a date string is in YYYY-MM-DD format.
@@ -1323,7 +2026,7 @@ func createProjectStatusUpdate(ctx context.Context, gqlClient *githubv4.Client,
 }
 
 // listProjectStatusUpdates lists status updates for a project via GraphQL.
-func listProjectStatusUpdates(ctx context.Context, gqlClient *githubv4.Client, args map[string]any, owner, ownerType string) (*mcp.CallToolResult, bool, any, error) {
+func listProjectStatusUpdates(ctx context.Context, gqlClient *githubv4.Client, args map[string]any, owner, ownerType string, defaultPerPage int) (*mcp.CallToolResult, bool, any, error) {
 	if ownerType != "user" && ownerType != "org" {
 		return utils.NewToolResultError(fmt.Sprintf("invalid owner_type %q: must be \"user\" or \"org\"", ownerType)), false, nil, nil
 	}
@@ -1333,7 +2036,7 @@ func listProjectStatusUpdates(ctx context.Context, gqlClient *githubv4.Client, a
 		return utils.NewToolResultError(err.Error()), false, nil, nil
 	}
 
-	perPage, err := OptionalIntParamWithDefault(args, "per_page", MaxProjectsPerPage)
+	perPage, err := OptionalIntParamWithDefault(args, "per_page", defaultPerPage)
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), false, nil, nil
 	}
@@ -1341,7 +2044,7 @@ func listProjectStatusUpdates(ctx context.Context, gqlClient *githubv4.Client, a
 		perPage = MaxProjectsPerPage
 	}
 	if perPage < 1 {
-		perPage = MaxProjectsPerPage
+		perPage = defaultPerPage
 	}
 
 	afterCursor, err := OptionalParam[string](args, "after")
@@ -1406,6 +2109,109 @@ func listProjectStatusUpdates(ctx context.Context, gqlClient *githubv4.Client, a
 	return utils.NewToolResultText(string(r)), isPrivate, nil, nil
 }
 
+// listProjectWorkflows lists the built-in workflows of a project and whether
+// each is enabled via GraphQL.
+func listProjectWorkflows(ctx context.Context, gqlClient *githubv4.Client, args map[string]any, owner, ownerType string, defaultPerPage int) (*mcp.CallToolResult, bool, any, error) {
+	projectNumber, err := RequiredInt(args, "project_number")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), false, nil, nil
+	}
+
+	perPage, err := OptionalIntParamWithDefault(args, "per_page", defaultPerPage)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), false, nil, nil
+	}
+	if perPage > MaxProjectsPerPage {
+		perPage = MaxProjectsPerPage
+	}
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	afterCursor, err := OptionalParam[string](args, "after")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), false, nil, nil
+	}
+
+	vars := map[string]any{
+		"owner":         githubv4.String(owner),
+		"projectNumber": githubv4.Int(int32(projectNumber)), //nolint:gosec // Project numbers are small integers
+		"first":         githubv4.Int(int32(perPage)),       //nolint:gosec // perPage is bounded by MaxProjectsPerPage
+	}
+	if afterCursor != "" {
+		vars["after"] = githubv4.String(afterCursor)
+	} else {
+		vars["after"] = (*githubv4.String)(nil)
+	}
+
+	var project projectWorkflowsProject
+	if ownerType == "org" {
+		var q projectWorkflowsOrgQuery
+		if err := gqlClient.Query(ctx, &q, vars); err != nil {
+			return ghErrors.NewGitHubGraphQLErrorResponse(ctx, ProjectWorkflowListFailedError, err), false, nil, nil
+		}
+		project = q.Organization.ProjectV2
+	} else {
+		var q projectWorkflowsUserQuery
+		if err := gqlClient.Query(ctx, &q, vars); err != nil {
+			return ghErrors.NewGitHubGraphQLErrorResponse(ctx, ProjectWorkflowListFailedError, err), false, nil, nil
+		}
+		project = q.User.ProjectV2
+	}
+
+	workflows := make([]MinimalProjectWorkflow, 0, len(project.Workflows.Nodes))
+	for _, n := range project.Workflows.Nodes {
+		workflows = append(workflows, MinimalProjectWorkflow{
+			Number:  int(n.Number),
+			Name:    string(n.Name),
+			Enabled: bool(n.Enabled),
+		})
+	}
+
+	pi := project.Workflows.PageInfo
+	response := map[string]any{
+		"workflows": workflows,
+		"pageInfo": map[string]any{
+			"hasNextPage":     pi.HasNextPage,
+			"hasPreviousPage": pi.HasPreviousPage,
+			"nextCursor":      string(pi.EndCursor),
+			"prevCursor":      string(pi.StartCursor),
+		},
+	}
+
+	r, err := json.Marshal(response)
+	if err != nil {
+		return nil, false, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return utils.NewToolResultText(string(r)), !bool(project.Public), nil, nil
+}
+
+// getProjectItemCount returns the total number of items in a project via
+// GraphQL, without fetching the items themselves.
+func getProjectItemCount(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int) (*mcp.CallToolResult, bool, any, error) {
+	vars := map[string]any{
+		"owner":         githubv4.String(owner),
+		"projectNumber": githubv4.Int(int32(projectNumber)), //nolint:gosec // Project numbers are small integers
+	}
+
+	var project projectItemCountProject
+	if ownerType == "org" {
+		var q projectItemCountOrgQuery
+		if err := gqlClient.Query(ctx, &q, vars); err != nil {
+			return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project item count", err), false, nil, nil
+		}
+		project = q.Organization.ProjectV2
+	} else {
+		var q projectItemCountUserQuery
+		if err := gqlClient.Query(ctx, &q, vars); err != nil {
+			return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project item count", err), false, nil, nil
+		}
+		project = q.User.ProjectV2
+	}
+
+	return utils.NewToolResultText(strconv.Itoa(int(project.Items.TotalCount))), !bool(project.Public), nil, nil
+}
+
 // getProjectStatusUpdate fetches a single status update by its node ID via GraphQL.
 func getProjectStatusUpdate(ctx context.Context, gqlClient *githubv4.Client, statusUpdateID string) (*mcp.CallToolResult, bool, any, error) {
 	var q statusUpdateNodeQuery
@@ -1481,8 +2287,8 @@ func buildUpdateProjectItem(input map[string]any) (*github.UpdateProjectItemOpti
 	return payload, nil
 }
 
-func extractPaginationOptionsFromArgs(args map[string]any) (github.ListProjectsPaginationOptions, error) {
-	perPage, err := OptionalIntParamWithDefault(args, "per_page", MaxProjectsPerPage)
+func extractPaginationOptionsFromArgs(args map[string]any, defaultPerPage int) (github.ListProjectsPaginationOptions, error) {
+	perPage, err := OptionalIntParamWithDefault(args, "per_page", defaultPerPage)
 	if err != nil {
 		return github.ListProjectsPaginationOptions{}, err
 	}
@@ -1612,6 +2418,178 @@ func createProject(ctx context.Context, gqlClient *githubv4.Client, owner, owner
 	return MarshalledTextResult(result), nil, nil
 }
 
+// copyProjectFieldPollAttempts bounds how often copy_project reads the new
+// project's fields while GitHub is still copying them.
+const copyProjectFieldPollAttempts = 3
+
+// copyProjectFieldPollInterval is the wait between those reads.
+var copyProjectFieldPollInterval = time.Second
+
+// copiedProjectField is a field of a project created by copy_project.
+type copiedProjectField struct {
+	Name     string `json:"name"`
+	DataType string `json:"data_type"`
+}
+
+// copiedProjectFieldsQuery reads the fields of a copied project next to the
+// field count of its source. The fields connection is a union, so the common
+// field selection is repeated on each concrete field type.
+type copiedProjectFieldsQuery struct {
+	Source struct {
+		ProjectV2 struct {
+			Fields struct {
+				TotalCount githubv4.Int
+			}
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"source: node(id: $sourceId)"`
+	Copy struct {
+		ProjectV2 struct {
+			Fields struct {
+				TotalCount githubv4.Int
+				Nodes      []struct {
+					Field struct {
+						Name     githubv4.String
+						DataType githubv4.String
+					} `graphql:"... on ProjectV2Field"`
+					IterationField struct {
+						Name     githubv4.String
+						DataType githubv4.String
+					} `graphql:"... on ProjectV2IterationField"`
+					SingleSelectField struct {
+						Name     githubv4.String
+						DataType githubv4.String
+					} `graphql:"... on ProjectV2SingleSelectField"`
+				}
+			} `graphql:"fields(first: 100)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"copy: node(id: $copyId)"`
+}
+
+// copyProject handles the copy_project method for ProjectsWrite. It copies the
+// source project's fields, views and workflows (and optionally draft issues)
+// into a new project. GitHub copies fields asynchronously, so the new project's
+// fields are read up to copyProjectFieldPollAttempts times until they match the
+// source; fields_complete reports whether they did.
+func copyProject(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, args map[string]any) (*mcp.CallToolResult, any, error) {
+	title, err := RequiredParam[string](args, "title")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	targetOwner, err := OptionalParam[string](args, "target_owner")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	targetOwnerType, err := OptionalParam[string](args, "target_owner_type")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	includeDraftIssues, err := OptionalParam[bool](args, "include_draft_issues")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	if targetOwner == "" || strings.EqualFold(targetOwner, owner) {
+		targetOwner = owner
+		if targetOwnerType == "" {
+			targetOwnerType = ownerType
+		}
+	}
+	if targetOwnerType == "" {
+		targetOwnerType = ownerAccountType(ctx, client, nil, targetOwner)
+		if targetOwnerType == "" {
+			return utils.NewToolResultError(fmt.Sprintf("could not determine whether %s is a user or an organization; set target_owner_type", targetOwner)), nil, nil
+		}
+	}
+
+	sourceID, err := resolveProjectNodeID(ctx, gqlClient, owner, ownerType, projectNumber)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	targetOwnerID, err := getOwnerNodeID(ctx, gqlClient, targetOwner, targetOwnerType)
+	if err != nil {
+		return utils.NewToolResultError(fmt.Sprintf("failed to get owner ID: %v", err)), nil, nil
+	}
+
+	var mutation struct {
+		CopyProjectV2 struct {
+			ProjectV2 struct {
+				ID     githubv4.ID
+				Number githubv4.Int
+				Title  githubv4.String
+				URL    githubv4.String
+			}
+		} `graphql:"copyProjectV2(input: $input)"`
+	}
+	input := githubv4.CopyProjectV2Input{
+		ProjectID:          sourceID,
+		OwnerID:            githubv4.ID(targetOwnerID),
+		Title:              githubv4.String(title),
+		IncludeDraftIssues: githubv4.NewBoolean(githubv4.Boolean(includeDraftIssues)),
+	}
+	if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to copy project", err), nil, nil
+	}
+	project := mutation.CopyProjectV2.ProjectV2
+
+	result := struct {
+		ID             string               `json:"id"`
+		Number         int                  `json:"number"`
+		Title          string               `json:"title"`
+		URL            string               `json:"url"`
+		Fields         []copiedProjectField `json:"fields"`
+		FieldsComplete bool                 `json:"fields_complete"`
+		Note           string               `json:"note,omitempty"`
+	}{
+		ID:     fmt.Sprint(project.ID),
+		Number: int(project.Number),
+		Title:  string(project.Title),
+		URL:    string(project.URL),
+		Fields: []copiedProjectField{},
+	}
+
+	vars := map[string]any{
+		"sourceId": sourceID,
+		"copyId":   project.ID,
+	}
+poll:
+	for attempt := 1; ; attempt++ {
+		var query copiedProjectFieldsQuery
+		if err := gqlClient.Query(ctx, &query, vars); err != nil {
+			// The project exists; report it without its fields rather than failing.
+			result.Note = fmt.Sprintf("project copied, but its fields could not be read: %v", err)
+			break
+		}
+		copied := query.Copy.ProjectV2.Fields
+		result.Fields = result.Fields[:0]
+		for _, node := range copied.Nodes {
+			name, dataType := node.Field.Name, node.Field.DataType
+			if name == "" {
+				name, dataType = node.IterationField.Name, node.IterationField.DataType
+			}
+			if name == "" {
+				name, dataType = node.SingleSelectField.Name, node.SingleSelectField.DataType
+			}
+			result.Fields = append(result.Fields, copiedProjectField{Name: string(name), DataType: string(dataType)})
+		}
+		if copied.TotalCount >= query.Source.ProjectV2.Fields.TotalCount {
+			result.FieldsComplete = true
+			break
+		}
+		if attempt == copyProjectFieldPollAttempts {
+			result.Note = "GitHub is still copying the project; fields may be incomplete. List the project's fields again shortly."
+			break
+		}
+		select {
+		case <-ctx.Done():
+			result.Note = "project copied, but reading its fields was canceled"
+			break poll
+		case <-time.After(copyProjectFieldPollInterval):
+		}
+	}
+
+	return MarshalledTextResult(result), nil, nil
+}
+
 // createIterationField handles the create_iteration_field method for ProjectsWrite.
 //
 // GitHub's GraphQL API requires two mutations to fully configure an iteration field:
@@ -1820,21 +2798,12 @@ type ProjectV2IterationFieldIterationInput struct {
 // It first asks GitHub for the account type, then falls back to project probes
 // for older or mocked clients where the account type is unavailable.
 func detectOwnerType(ctx context.Context, client *github.Client, owner string, projectNumber int) (string, error) {
-	user, resp, err := client.Users.Get(ctx, owner)
-	if resp != nil && resp.Body != nil {
-		_ = resp.Body.Close()
-	}
-	if err == nil && resp != nil && resp.StatusCode == http.StatusOK {
-		switch user.GetType() {
-		case "User":
-			return "user", nil
-		case "Organization":
-			return "org", nil
-		}
+	if ownerType := ownerAccountType(ctx, client, nil, owner); ownerType != "" {
+		return ownerType, nil
 	}
 
 	// Try user first (more common for personal projects)
-	_, resp, err = client.Projects.GetUserProject(ctx, owner, projectNumber)
+	_, resp, err := client.Projects.GetUserProject(ctx, owner, projectNumber)
 	if err == nil && resp.StatusCode == http.StatusOK {
 		_ = resp.Body.Close()
 		return "user", nil
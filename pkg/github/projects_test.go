@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
@@ -185,6 +188,91 @@ func Test_ProjectsList_ListProjectFields(t *testing.T) {
 	})
 }
 
+func Test_ProjectsList_ListProjectFieldOptions(t *testing.T) {
+	toolDef := ProjectsList(translations.NullTranslationHelper)
+
+	t.Run("returns single-select options", func(t *testing.T) {
+		field := map[string]any{
+			"id":        101,
+			"name":      "Status",
+			"data_type": "single_select",
+			"options": []map[string]any{
+				{"id": "f75ad846", "name": map[string]any{"raw": "Todo", "html": "Todo"}, "color": "GRAY"},
+				{"id": "47fc9ee4", "name": map[string]any{"raw": "In Progress", "html": "In Progress"}, "color": "YELLOW", "description": map[string]any{"raw": "Being worked on"}},
+				{"id": "98236657", "name": map[string]any{"raw": "Done", "html": "Done"}, "color": "GREEN"},
+			},
+		}
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2FieldsByProjectByFieldID: mockResponse(t, http.StatusOK, field),
+		})
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_field_options",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"field_id":       float64(101),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response ProjectFieldOptions
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, int64(101), response.FieldID)
+		assert.Equal(t, "Status", response.FieldName)
+		assert.Equal(t, []ProjectFieldOption{
+			{ID: "f75ad846", Name: "Todo", Color: "GRAY"},
+			{ID: "47fc9ee4", Name: "In Progress", Color: "YELLOW", Description: "Being worked on"},
+			{ID: "98236657", Name: "Done", Color: "GREEN"},
+		}, response.Options)
+	})
+
+	t.Run("field without options", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2FieldsByProjectByFieldID: mockResponse(t, http.StatusOK, map[string]any{"id": 102, "name": "Estimate", "data_type": "number"}),
+		})
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_field_options",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"field_id":       float64(102),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `field "Estimate" is a number field`)
+	})
+
+	t.Run("missing field_id", func(t *testing.T) {
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_field_options",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "missing required parameter: field_id")
+	})
+}
+
 func verbosePullRequestProjectItemFixture() map[string]any {
 	return map[string]any{
 		"id":           1001,
@@ -364,6 +452,314 @@ func Test_ProjectsList_ListProjectItems(t *testing.T) {
 		require.True(t, ok)
 		assertMinimalPullRequestProjectItem(t, textContent.Text, item)
 	})
+
+	t.Run("item_fields selects the returned parts", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusOK, items),
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_fields":    []any{"title", "status", "Assignees", "creator"},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response struct {
+			Items []map[string]any `json:"items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Items, 1)
+		item := response.Items[0]
+		assert.Len(t, item, 4)
+		assert.NotContains(t, item, "node_id")
+		assert.NotContains(t, item, "created_at")
+		assert.Equal(t, float64(1001), item["id"])
+		assert.Equal(t, "creator", item["creator"])
+		assert.Equal(t, map[string]any{
+			"title":     "Reduce project item output",
+			"assignees": []any{"hubot"},
+		}, item["content"])
+		fields, ok := item["fields"].([]any)
+		require.True(t, ok)
+		require.Len(t, fields, 1)
+		assert.Equal(t, "Status", fields[0].(map[string]any)["name"])
+	})
+
+	t.Run("creator filters items within the page", func(t *testing.T) {
+		mixedItems := []map[string]any{
+			{"id": 21, "node_id": "PVTI_21", "content_type": "Issue", "creator": map[string]any{"login": "alice"}},
+			{"id": 22, "node_id": "PVTI_22", "content_type": "Issue", "creator": map[string]any{"login": "bob"}},
+			{"id": 23, "node_id": "PVTI_23", "content_type": "PullRequest", "creator": map[string]any{"login": "Alice"}},
+			{"id": 24, "node_id": "PVTI_24", "content_type": "DraftIssue"},
+		}
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusOK, mixedItems),
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"creator":        "alice",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response struct {
+			Items []MinimalProjectItem `json:"items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Items, 2)
+		assert.Equal(t, int64(21), response.Items[0].ID)
+		assert.Equal(t, int64(23), response.Items[1].ID)
+		// Positions are counted before the page is filtered.
+		assert.Equal(t, 1, response.Items[0].Position)
+		assert.Equal(t, 3, response.Items[1].Position)
+	})
+
+	t.Run("positions are omitted after the first page", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusOK, []map[string]any{
+				{"id": 31, "node_id": "PVTI_31", "content_type": "Issue"},
+			}),
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"after":          "cursor",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response struct {
+			Items []map[string]any `json:"items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Items, 1)
+		assert.NotContains(t, response.Items[0], "position")
+	})
+
+	t.Run("updated_since filters items within the page", func(t *testing.T) {
+		mixedItems := []map[string]any{
+			{"id": 41, "node_id": "PVTI_41", "content_type": "Issue", "updated_at": "2026-05-31T23:59:59Z"},
+			{"id": 42, "node_id": "PVTI_42", "content_type": "Issue", "updated_at": "2026-06-01T00:00:00Z"},
+			{"id": 43, "node_id": "PVTI_43", "content_type": "PullRequest", "updated_at": "2026-06-03T12:30:00Z"},
+			{"id": 44, "node_id": "PVTI_44", "content_type": "DraftIssue", "updated_at": "2026-04-15T08:00:00Z"},
+		}
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusOK, mixedItems),
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"updated_since":  "2026-06-01",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response struct {
+			Items []MinimalProjectItem `json:"items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Items, 2)
+		assert.Equal(t, int64(42), response.Items[0].ID)
+		assert.Equal(t, int64(43), response.Items[1].ID)
+	})
+
+	t.Run("invalid updated_since", func(t *testing.T) {
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"updated_since":  "yesterday",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "invalid updated_since")
+	})
+
+	t.Run("content_type filters items within the page", func(t *testing.T) {
+		mixedItems := []map[string]any{
+			{"id": 31, "node_id": "PVTI_31", "content_type": "Issue"},
+			{"id": 32, "node_id": "PVTI_32", "content_type": "PullRequest"},
+			{"id": 33, "node_id": "PVTI_33", "content_type": "DraftIssue"},
+			{"id": 34, "node_id": "PVTI_34", "content_type": "Issue"},
+		}
+
+		for contentType, expectedIDs := range map[string][]int64{
+			"Issue":       {31, 34},
+			"PullRequest": {32},
+			"DraftIssue":  {33},
+		} {
+			t.Run(contentType, func(t *testing.T) {
+				mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					GetOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusOK, mixedItems),
+				})
+				deps := BaseDeps{
+					Client: mustNewGHClient(t, mockedClient),
+				}
+				handler := toolDef.Handler(deps)
+				request := createMCPRequest(map[string]any{
+					"method":         "list_project_items",
+					"owner":          "octo-org",
+					"owner_type":     "org",
+					"project_number": float64(1),
+					"content_type":   contentType,
+				})
+				result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+				require.NoError(t, err)
+				require.False(t, result.IsError)
+
+				var response struct {
+					Items []MinimalProjectItem `json:"items"`
+				}
+				require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+				ids := make([]int64, 0, len(response.Items))
+				for _, item := range response.Items {
+					assert.Equal(t, contentType, item.ContentType)
+					ids = append(ids, item.ID)
+				}
+				assert.Equal(t, expectedIDs, ids)
+			})
+		}
+	})
+
+	t.Run("invalid content_type", func(t *testing.T) {
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(nil)),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"content_type":   "Discussion",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `invalid content_type "Discussion"`)
+	})
+
+	t.Run("resolve_content batch-resolves missing content", func(t *testing.T) {
+		opaqueItems := []map[string]any{
+			{"id": 11, "node_id": "PVTI_11", "content_type": "Issue", "content_node_id": "I_11"},
+			{"id": 12, "node_id": "PVTI_12", "content_type": "PullRequest", "content_node_id": "PR_12"},
+			{"id": 13, "node_id": "PVTI_13", "content_type": "DraftIssue", "content_node_id": "DI_13"},
+			{"id": 14, "node_id": "PVTI_14", "content_type": "Issue"},
+		}
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProject: expectQueryParams(t, map[string]string{
+				"per_page": "50",
+			}).andThen(mockResponse(t, http.StatusOK, opaqueItems)),
+		})
+		gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				"query($ids:[ID!]!){nodes(ids: $ids){__typename,... on Issue{id,number,title,state,url,repository{nameWithOwner}},... on PullRequest{id,number,title,state,isDraft,merged,url,repository{nameWithOwner}},... on DraftIssue{id,title}}}",
+				map[string]any{"ids": []any{"I_11", "PR_12", "DI_13"}},
+				githubv4mock.DataResponse(map[string]any{
+					"nodes": []any{
+						map[string]any{
+							"__typename": "Issue",
+							"id":         "I_11",
+							"number":     5,
+							"title":      "Fix login",
+							"state":      "OPEN",
+							"url":        "https://github.com/octo-org/app/issues/5",
+							"repository": map[string]any{"nameWithOwner": "octo-org/app"},
+						},
+						map[string]any{
+							"__typename": "PullRequest",
+							"id":         "PR_12",
+							"number":     6,
+							"title":      "Add tests",
+							"state":      "MERGED",
+							"isDraft":    false,
+							"merged":     true,
+							"url":        "https://github.com/octo-org/app/pull/6",
+							"repository": map[string]any{"nameWithOwner": "octo-org/app"},
+						},
+						map[string]any{"__typename": "DraftIssue", "id": "DI_13", "title": "Draft idea"},
+					},
+				}),
+			),
+		))
+
+		deps := BaseDeps{
+			Client:    mustNewGHClient(t, mockedClient),
+			GQLClient: gqlClient,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":          "list_project_items",
+			"owner":           "octo-org",
+			"owner_type":      "org",
+			"project_number":  float64(1),
+			"resolve_content": true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response struct {
+			Items []MinimalProjectItem `json:"items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Items, 4)
+
+		require.NotNil(t, response.Items[0].Content)
+		assert.Equal(t, 5, response.Items[0].Content.Number)
+		assert.Equal(t, "Fix login", response.Items[0].Content.Title)
+		assert.Equal(t, "open", response.Items[0].Content.State)
+		assert.Equal(t, "octo-org/app", response.Items[0].Content.Repository)
+
+		require.NotNil(t, response.Items[1].Content)
+		assert.Equal(t, 6, response.Items[1].Content.Number)
+		assert.True(t, response.Items[1].Content.Merged)
+
+		require.NotNil(t, response.Items[2].Content)
+		assert.Equal(t, "Draft idea", response.Items[2].Content.Title)
+
+		assert.Nil(t, response.Items[3].Content, "items without a content node ID stay unresolved")
+	})
 }
 
 func Test_detectOwnerType(t *testing.T) {
@@ -606,6 +1002,52 @@ func Test_ProjectsGet_GetProject(t *testing.T) {
 		assert.NotNil(t, response["id"])
 	})
 
+	t.Run("owner_type that does not match the account", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUsersByUsername:         mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("octocat"), Type: github.Ptr("User")}),
+			GetOrgsProjectsV2ByProject: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project",
+			"owner":          "octocat",
+			"owner_type":     "org",
+			"project_number": float64(1),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		assert.Equal(t, `owner_type is "org", but "octocat" is a user account; use owner_type "user" or omit it`, getErrorResult(t, result).Text)
+	})
+
+	t.Run("owner_type is not looked up when the call succeeds", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUsersByUsername: func(_ http.ResponseWriter, _ *http.Request) {
+				t.Error("unexpected account lookup")
+			},
+			GetOrgsProjectsV2ByProject: mockResponse(t, http.StatusOK, project),
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+	})
+
 	t.Run("unknown method", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
 		client := mustNewGHClient(t, mockedClient)
@@ -755,29 +1197,93 @@ func Test_ProjectsGet_GetProjectField(t *testing.T) {
 		textContent := getTextResult(t, result)
 		assert.Contains(t, textContent.Text, "missing required parameter: field_id")
 	})
-}
-
-func Test_ProjectsGet_GetProjectItem(t *testing.T) {
-	toolDef := ProjectsGet(translations.NullTranslationHelper)
 
-	item := verbosePullRequestProjectItemFixture()
+	// fieldsHandler serves the user project's fields over two pages.
+	fieldsHandler := func(t *testing.T) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+			if r.URL.Query().Get("after") == "" {
+				w.Header().Set("Link", `<https://api.github.com/users/octocat/projectsV2/1/fields?per_page=100&after=page2>; rel="next"`)
+				mockResponse(t, http.StatusOK, []map[string]any{
+					{"id": 100, "name": "Title", "data_type": "title"},
+					{"id": 102, "name": "Estimate", "data_type": "number"},
+				})(w, r)
+				return
+			}
+			assert.Equal(t, "page2", r.URL.Query().Get("after"))
+			mockResponse(t, http.StatusOK, []map[string]any{field})(w, r)
+		}
+	}
 
-	t.Run("success organization", func(t *testing.T) {
+	t.Run("resolves field_name to a field", func(t *testing.T) {
+		var requestedFieldID string
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-			GetOrgsProjectsV2ItemsByProjectByItemID: mockResponse(t, http.StatusOK, item),
+			GetUsersProjectsV2FieldsByUsernameByProject: fieldsHandler(t),
+			GetUsersProjectsV2FieldsByUsernameByProjectByFieldID: func(w http.ResponseWriter, r *http.Request) {
+				requestedFieldID = r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+				mockResponse(t, http.StatusOK, field)(w, r)
+			},
 		})
-
-		client := mustNewGHClient(t, mockedClient)
-		deps := BaseDeps{
-			Client: client,
-		}
+		deps := BaseDeps{Client: mustNewGHClient(t, mockedClient)}
 		handler := toolDef.Handler(deps)
 		request := createMCPRequest(map[string]any{
-			"method":         "get_project_item",
-			"owner":          "octo-org",
-			"owner_type":     "org",
-			"project_number": float64(1),
-			"item_id":        float64(1001),
+			"method":         "get_project_field",
+			"owner":          "octocat",
+			"owner_type":     "user",
+			"project_number": float64(1),
+			"field_name":     "status",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		assert.Equal(t, "101", requestedFieldID)
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "Status", response["name"])
+	})
+
+	t.Run("unknown field_name lists the project's fields", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUsersProjectsV2FieldsByUsernameByProject: fieldsHandler(t),
+		})
+		deps := BaseDeps{Client: mustNewGHClient(t, mockedClient)}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_field",
+			"owner":          "octocat",
+			"owner_type":     "user",
+			"project_number": float64(1),
+			"field_name":     "Priority",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		assert.Equal(t, `project 1 has no field named "Priority"; fields: Title, Estimate, Status`, getErrorResult(t, result).Text)
+	})
+}
+
+func Test_ProjectsGet_GetProjectItem(t *testing.T) {
+	toolDef := ProjectsGet(translations.NullTranslationHelper)
+
+	item := verbosePullRequestProjectItemFixture()
+
+	t.Run("success organization", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProjectByItemID: mockResponse(t, http.StatusOK, item),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
 		})
 		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
@@ -791,6 +1297,120 @@ func Test_ProjectsGet_GetProjectItem(t *testing.T) {
 		assertMinimalPullRequestProjectItem(t, textContent.Text, response)
 	})
 
+	t.Run("returns every field with single-select options named", func(t *testing.T) {
+		fieldLists := 0
+		var requestedFields []string
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2FieldsByProject: func(w http.ResponseWriter, r *http.Request) {
+				fieldLists++
+				mockResponse(t, http.StatusOK, []map[string]any{
+					{"id": 101, "name": "Status", "data_type": "single_select", "options": []map[string]any{
+						{"id": "opt1", "name": map[string]any{"raw": "Todo"}, "color": "GRAY"},
+						{"id": "opt2", "name": map[string]any{"raw": "Done"}, "color": "GREEN"},
+					}},
+					{"id": 102, "name": "Estimate", "data_type": "number"},
+				})(w, r)
+			},
+			GetOrgsProjectsV2ItemsByProjectByItemID: func(w http.ResponseWriter, r *http.Request) {
+				requestedFields = append(requestedFields, r.URL.Query().Get("fields"))
+				mockResponse(t, http.StatusOK, map[string]any{
+					"id":           1001,
+					"content_type": "Issue",
+					"fields": []map[string]any{
+						{"id": 101, "name": "Status", "data_type": "single_select", "value": "opt2"},
+						{"id": 102, "name": "Estimate", "data_type": "number", "value": 3},
+					},
+				})(w, r)
+			},
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequestWithSession(t, "test-client", false, map[string]any{
+			"method":         "get_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+		})
+
+		for range 2 {
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response MinimalProjectItem
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			require.Len(t, response.Fields, 2)
+			assert.Equal(t, map[string]any{"id": "opt2", "name": "Done", "color": "GREEN"}, response.Fields[0].Value)
+			assert.Equal(t, float64(3), response.Fields[1].Value)
+		}
+
+		// The field definitions are listed once per session.
+		assert.Equal(t, 1, fieldLists)
+		assert.Equal(t, []string{"101,102", "101,102"}, requestedFields)
+	})
+
+	t.Run("single field", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProjectByItemID: expectQueryParams(t, map[string]string{"fields": "301"}).andThen(
+				mockResponse(t, http.StatusOK, item),
+			),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"field_id":       float64(301),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var field MinimalProjectItemFieldValue
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &field))
+		assert.Equal(t, int64(301), field.ID)
+		assert.Equal(t, "Status", field.Name)
+		assert.Equal(t, "single_select", field.DataType)
+		assert.Equal(t, map[string]any{"id": "opt1", "name": "Done", "color": "GREEN"}, field.Value)
+	})
+
+	t.Run("single field missing from item", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProjectByItemID: mockResponse(t, http.StatusOK, item),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"field_id":       float64(999),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "project item 1001 has no field with ID 999")
+	})
+
 	t.Run("missing item_id", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
 		client := mustNewGHClient(t, mockedClient)
@@ -888,6 +1508,22 @@ func Test_ProjectsWrite_AddProjectItem(t *testing.T) {
 					},
 				}),
 			),
+			// Mock the project membership check; the issue is only in another project
+			githubv4mock.NewQueryMatcher(
+				contentProjectItemsQuery{},
+				map[string]any{
+					"contentId": githubv4.ID("I_issue123"),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"node": map[string]any{
+						"projectItems": map[string]any{
+							"nodes": []any{
+								map[string]any{"id": "PVTI_other", "fullDatabaseId": "900", "isArchived": false, "project": map[string]any{"id": "PVT_other", "url": "https://github.com/orgs/octo-org/projects/9"}},
+							},
+						},
+					},
+				}),
+			),
 			// Mock addProjectV2ItemById mutation
 			githubv4mock.NewMutationMatcher(
 				struct {
@@ -895,6 +1531,9 @@ func Test_ProjectsWrite_AddProjectItem(t *testing.T) {
 						Item struct {
 							ID             githubv4.ID
 							FullDatabaseID string `graphql:"fullDatabaseId"`
+							Project        struct {
+								URL githubv4.String
+							}
 						}
 					} `graphql:"addProjectV2ItemById(input: $input)"`
 				}{},
@@ -908,6 +1547,7 @@ func Test_ProjectsWrite_AddProjectItem(t *testing.T) {
 						"item": map[string]any{
 							"id":             "PVTI_item1",
 							"fullDatabaseId": "1001",
+							"project":        map[string]any{"url": "https://github.com/orgs/octo-org/projects/1"},
 						},
 					},
 				}),
@@ -941,7 +1581,98 @@ func Test_ProjectsWrite_AddProjectItem(t *testing.T) {
 		assert.NotNil(t, response["id"])
 		assert.Equal(t, float64(1001), response["item_id"])
 		assert.Equal(t, "1001", response["full_database_id"])
+		assert.Equal(t, "https://github.com/orgs/octo-org/projects/1", response["project_url"])
+		assert.Equal(t, "https://github.com/orgs/octo-org/projects/1?pane=issue&itemId=1001", response["item_url"])
 		assert.Contains(t, response["message"], "Successfully added")
+		assert.Equal(t, "PVTI_item1", response["node_id"])
+		assert.Equal(t, "PVT_project1", response["project_node_id"])
+	})
+
+	t.Run("issue already in project", func(t *testing.T) {
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				struct {
+					Repository struct {
+						Issue struct {
+							ID githubv4.ID
+						} `graphql:"issue(number: $issueNumber)"`
+					} `graphql:"repository(owner: $owner, name: $repo)"`
+				}{},
+				map[string]any{
+					"owner":       githubv4.String("item-owner"),
+					"repo":        githubv4.String("item-repo"),
+					"issueNumber": githubv4.Int(123),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"repository": map[string]any{
+						"issue": map[string]any{"id": "I_issue123"},
+					},
+				}),
+			),
+			githubv4mock.NewQueryMatcher(
+				struct {
+					Organization struct {
+						ProjectV2 struct {
+							ID githubv4.ID
+						} `graphql:"projectV2(number: $projectNumber)"`
+					} `graphql:"organization(login: $owner)"`
+				}{},
+				map[string]any{
+					"owner":         githubv4.String("octo-org"),
+					"projectNumber": githubv4.Int(1),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"organization": map[string]any{
+						"projectV2": map[string]any{"id": "PVT_project1"},
+					},
+				}),
+			),
+			githubv4mock.NewQueryMatcher(
+				contentProjectItemsQuery{},
+				map[string]any{
+					"contentId": githubv4.ID("I_issue123"),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"node": map[string]any{
+						"projectItems": map[string]any{
+							"nodes": []any{
+								map[string]any{"id": "PVTI_other", "fullDatabaseId": "900", "isArchived": false, "project": map[string]any{"id": "PVT_other", "url": "https://github.com/orgs/octo-org/projects/9"}},
+								map[string]any{"id": "PVTI_item1", "fullDatabaseId": "1001", "isArchived": false, "project": map[string]any{"id": "PVT_project1", "url": "https://github.com/orgs/octo-org/projects/1"}},
+							},
+						},
+					},
+				}),
+			),
+			// No mutation matcher: the item must not be added again.
+		)
+
+		deps := BaseDeps{
+			GQLClient: githubv4.NewClient(mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "add_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_owner":     "item-owner",
+			"item_repo":      "item-repo",
+			"issue_number":   float64(123),
+			"item_type":      "issue",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, true, response["already_in_project"])
+		assert.Equal(t, "PVTI_item1", response["id"])
+		assert.Equal(t, float64(1001), response["item_id"])
+		assert.Equal(t, "https://github.com/orgs/octo-org/projects/1?pane=issue&itemId=1001", response["item_url"])
+		assert.Equal(t, "issue item-owner/item-repo#123 is already in project octo-org/1, so no item was added", response["message"])
+		assert.Equal(t, "PVT_project1", response["project_node_id"])
+		assert.NotContains(t, response, "archived")
 	})
 
 	t.Run("success user with pull request", func(t *testing.T) {
@@ -996,6 +1727,9 @@ func Test_ProjectsWrite_AddProjectItem(t *testing.T) {
 						Item struct {
 							ID             githubv4.ID
 							FullDatabaseID string `graphql:"fullDatabaseId"`
+							Project        struct {
+								URL githubv4.String
+							}
 						}
 					} `graphql:"addProjectV2ItemById(input: $input)"`
 				}{},
@@ -1042,6 +1776,8 @@ func Test_ProjectsWrite_AddProjectItem(t *testing.T) {
 		assert.NotNil(t, response["id"])
 		assert.Equal(t, float64(1002), response["item_id"])
 		assert.Equal(t, "1002", response["full_database_id"])
+		// The mutation returned no project URL, so it is built from the owner and number.
+		assert.Equal(t, "https://github.com/users/octo-user/projects/2?pane=issue&itemId=1002", response["item_url"])
 		assert.Contains(t, response["message"], "Successfully added")
 	})
 
@@ -1154,6 +1890,42 @@ func Test_ProjectsWrite_UpdateProjectItem(t *testing.T) {
 		assertMinimalPullRequestProjectItem(t, textContent.Text, response)
 	})
 
+	t.Run("node IDs missing from the update are looked up", func(t *testing.T) {
+		fetches := 0
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PatchOrgsProjectsV2ItemsByProjectByItemID: mockResponse(t, http.StatusOK, map[string]any{"id": 1001, "content_type": "Issue"}),
+			GetOrgsProjectsV2ItemsByProjectByItemID: func(w http.ResponseWriter, r *http.Request) {
+				fetches++
+				mockResponse(t, http.StatusOK, map[string]any{"id": 1001, "node_id": "PVTI_1", "project_node_id": "PVT_project1"})(w, r)
+			},
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "update_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"updated_field": map[string]any{
+				"id":    float64(101),
+				"value": "In Progress",
+			},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response MinimalProjectItem
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "PVTI_1", response.NodeID)
+		assert.Equal(t, "PVT_project1", response.ProjectNodeID)
+		assert.Equal(t, 1, fetches)
+	})
+
 	t.Run("missing updated_field", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
 		client := mustNewGHClient(t, mockedClient)
@@ -1198,6 +1970,7 @@ func Test_ProjectsWrite_DeleteProjectItem(t *testing.T) {
 			"owner_type":     "org",
 			"project_number": float64(1),
 			"item_id":        float64(1001),
+			"confirm":        true,
 		})
 		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
@@ -1208,6 +1981,79 @@ func Test_ProjectsWrite_DeleteProjectItem(t *testing.T) {
 		assert.Contains(t, textContent.Text, "project item successfully deleted")
 	})
 
+	t.Run("without confirm previews the item and does not delete", func(t *testing.T) {
+		deleteCalled := false
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUsersProjectsV2ItemsByUsernameByProjectByItemID: mockResponse(t, http.StatusOK, map[string]any{
+				"id":           1001,
+				"content_type": "Issue",
+				"content": map[string]any{
+					"number": 42,
+					"title":  "Fix flaky login test",
+				},
+			}),
+			DeleteUsersProjectsV2ItemsByUsernameByProjectByItemID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				deleteCalled = true
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		for _, confirm := range []any{nil, false} {
+			request := createMCPRequest(map[string]any{
+				"method":         "delete_project_item",
+				"owner":          "octocat",
+				"owner_type":     "user",
+				"project_number": float64(1),
+				"item_id":        float64(1001),
+				"confirm":        confirm,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Contains(t, textContent.Text, "confirmation required")
+			assert.Contains(t, textContent.Text, `Issue "Fix flaky login test"`)
+		}
+		assert.False(t, deleteCalled, "item must not be deleted without confirm")
+	})
+
+	t.Run("confirmed delete skips the preview fetch", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUsersProjectsV2ItemsByUsernameByProjectByItemID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				t.Error("item should not be fetched when confirm is true")
+				w.WriteHeader(http.StatusInternalServerError)
+			}),
+			DeleteUsersProjectsV2ItemsByUsernameByProjectByItemID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "delete_project_item",
+			"owner":          "octocat",
+			"owner_type":     "user",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"confirm":        true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "project item successfully deleted")
+	})
+
 	t.Run("missing item_id", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
 		client := mustNewGHClient(t, mockedClient)
@@ -1406,6 +2252,124 @@ func Test_ProjectsList_ListProjectStatusUpdates(t *testing.T) {
 	})
 }
 
+func Test_ProjectsList_ListProjectWorkflows(t *testing.T) {
+	toolDef := ProjectsList(translations.NullTranslationHelper)
+
+	project := map[string]any{
+		"public": true,
+		"workflows": map[string]any{
+			"nodes": []map[string]any{
+				{"number": 1, "name": "Item closed", "enabled": true},
+				{"number": 2, "name": "Pull request merged", "enabled": false},
+			},
+			"pageInfo": map[string]any{
+				"hasNextPage":     true,
+				"hasPreviousPage": true,
+				"startCursor":     "c1",
+				"endCursor":       "c2",
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		owner       string
+		accountType string
+		query       any
+		response    map[string]any
+	}{
+		{
+			name:        "organization owner",
+			owner:       "octo-org",
+			accountType: "Organization",
+			query:       projectWorkflowsOrgQuery{},
+			response:    map[string]any{"organization": map[string]any{"projectV2": project}},
+		},
+		{
+			name:        "user owner",
+			owner:       "octocat",
+			accountType: "User",
+			query:       projectWorkflowsUserQuery{},
+			response:    map[string]any{"user": map[string]any{"projectV2": project}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			restClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUsersByUsername: mockResponse(t, http.StatusOK, map[string]any{"login": tc.owner, "type": tc.accountType}),
+			})
+			gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					tc.query,
+					map[string]any{
+						"owner":         githubv4.String(tc.owner),
+						"projectNumber": githubv4.Int(7),
+						"first":         githubv4.Int(MaxProjectsPerPage),
+						"after":         githubv4.String("c0"),
+					},
+					githubv4mock.DataResponse(tc.response),
+				),
+			)
+			deps := BaseDeps{
+				Client:    mustNewGHClient(t, restClient),
+				GQLClient: githubv4.NewClient(gqlMockedClient),
+			}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"method":         "list_project_workflows",
+				"owner":          tc.owner,
+				"project_number": float64(7),
+				"per_page":       float64(100),
+				"after":          "c0",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
+
+			assert.JSONEq(t, `{
+				"workflows": [
+					{"number": 1, "name": "Item closed", "enabled": true},
+					{"number": 2, "name": "Pull request merged", "enabled": false}
+				],
+				"pageInfo": {"hasNextPage": true, "hasPreviousPage": true, "nextCursor": "c2", "prevCursor": "c1"}
+			}`, textContent.Text)
+		})
+	}
+
+	t.Run("project not found", func(t *testing.T) {
+		restClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUsersByUsername: mockResponse(t, http.StatusOK, map[string]any{"login": "octo-org", "type": "Organization"}),
+		})
+		gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				projectWorkflowsOrgQuery{},
+				map[string]any{
+					"owner":         githubv4.String("octo-org"),
+					"projectNumber": githubv4.Int(99),
+					"first":         githubv4.Int(MaxProjectsPerPage),
+					"after":         (*githubv4.String)(nil),
+				},
+				githubv4mock.ErrorResponse("Could not resolve to a ProjectV2 with the number 99."),
+			),
+		)
+		deps := BaseDeps{
+			Client:    mustNewGHClient(t, restClient),
+			GQLClient: githubv4.NewClient(gqlMockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_workflows",
+			"owner":          "octo-org",
+			"project_number": float64(99),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to list project workflows")
+	})
+}
+
 func Test_ProjectsGet_GetProjectStatusUpdate(t *testing.T) {
 	toolDef := ProjectsGet(translations.NullTranslationHelper)
 
@@ -1456,6 +2420,225 @@ func Test_ProjectsGet_GetProjectStatusUpdate(t *testing.T) {
 	})
 }
 
+func Test_ProjectsGet_GetProjectItemCount(t *testing.T) {
+	toolDef := ProjectsGet(translations.NullTranslationHelper)
+
+	vars := map[string]any{
+		"owner":         githubv4.String("octo-org"),
+		"projectNumber": githubv4.Int(1),
+	}
+
+	t.Run("org project", func(t *testing.T) {
+		gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				projectItemCountOrgQuery{},
+				vars,
+				githubv4mock.DataResponse(map[string]any{
+					"organization": map[string]any{
+						"projectV2": map[string]any{
+							"public": true,
+							"items":  map[string]any{"totalCount": 137},
+						},
+					},
+				}),
+			),
+		)
+		deps := BaseDeps{GQLClient: githubv4.NewClient(gqlMockedClient)}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_item_count",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Equal(t, "137", getTextResult(t, result).Text)
+	})
+
+	t.Run("user project", func(t *testing.T) {
+		gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				projectItemCountUserQuery{},
+				vars,
+				githubv4mock.DataResponse(map[string]any{
+					"user": map[string]any{
+						"projectV2": map[string]any{
+							"public": false,
+							"items":  map[string]any{"totalCount": 0},
+						},
+					},
+				}),
+			),
+		)
+		deps := BaseDeps{GQLClient: githubv4.NewClient(gqlMockedClient)}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_item_count",
+			"owner":          "octo-org",
+			"owner_type":     "user",
+			"project_number": float64(1),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Equal(t, "0", getTextResult(t, result).Text)
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				projectItemCountOrgQuery{},
+				vars,
+				githubv4mock.ErrorResponse("Could not resolve to a ProjectV2 with the number 1."),
+			),
+		)
+		deps := BaseDeps{GQLClient: githubv4.NewClient(gqlMockedClient)}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_item_count",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to get project item count")
+	})
+}
+
+func Test_ProjectsGet_GetProjectBoard(t *testing.T) {
+	toolDef := ProjectsGet(translations.NullTranslationHelper)
+
+	vars := map[string]any{
+		"owner":         githubv4.String("octo-org"),
+		"projectNumber": githubv4.Int(1),
+		"fieldName":     githubv4.String("Status"),
+		"after":         (*githubv4.String)(nil),
+	}
+	item := func(status string, content map[string]any) map[string]any {
+		value := map[string]any{}
+		if status != "" {
+			value["name"] = status
+		}
+		return map[string]any{"fieldValueByName": value, "content": content}
+	}
+	issue := func(number int, title string) map[string]any {
+		return map[string]any{"number": number, "title": title, "url": "https://github.com/octo-org/api/issues/" + strconv.Itoa(number)}
+	}
+
+	t.Run("groups items by status", func(t *testing.T) {
+		gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				projectBoardOrgQuery{},
+				vars,
+				githubv4mock.DataResponse(map[string]any{
+					"organization": map[string]any{
+						"projectV2": map[string]any{
+							"public": false,
+							"field": map[string]any{
+								"__typename": "ProjectV2SingleSelectField",
+								"options":    []any{map[string]any{"name": "Todo"}, map[string]any{"name": "In Progress"}, map[string]any{"name": "Done"}},
+							},
+							"items": map[string]any{
+								"totalCount": 4,
+								"nodes": []any{
+									item("In Progress", issue(1, "Fix login")),
+									item("Todo", issue(2, "Add search")),
+									item("In Progress", issue(3, "Speed up CI")),
+									item("", map[string]any{"title": "Write roadmap"}),
+								},
+								"pageInfo": map[string]any{"hasNextPage": false},
+							},
+						},
+					},
+				}),
+			),
+		)
+		deps := BaseDeps{GQLClient: githubv4.NewClient(gqlMockedClient)}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":           "get_project_board",
+			"owner":            "octo-org",
+			"owner_type":       "org",
+			"project_number":   float64(1),
+			"items_per_column": float64(1),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var board ProjectBoard
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &board))
+		assert.Equal(t, "Status", board.Field)
+		assert.Equal(t, 4, board.TotalItems)
+		assert.False(t, board.Truncated)
+		assert.Equal(t, []string{"Todo", "In Progress", "Done", "No Status"}, board.ColumnOrder)
+		assert.Equal(t, ProjectBoardColumn{
+			TotalCount: 2,
+			Items:      []ProjectBoardItem{{Number: 1, Title: "Fix login", URL: "https://github.com/octo-org/api/issues/1"}},
+			Truncated:  true,
+		}, board.Columns["In Progress"])
+		assert.Equal(t, 1, board.Columns["Todo"].TotalCount)
+		assert.False(t, board.Columns["Todo"].Truncated)
+		assert.Equal(t, ProjectBoardColumn{Items: []ProjectBoardItem{}}, board.Columns["Done"])
+		assert.Equal(t, []ProjectBoardItem{{Title: "Write roadmap"}}, board.Columns["No Status"].Items)
+	})
+
+	fieldErrors := []struct {
+		name        string
+		field       any
+		expectedErr string
+	}{
+		{
+			name:        "unknown field",
+			field:       nil,
+			expectedErr: `project 1 has no field named "Status"`,
+		},
+		{
+			name:        "field is not single-select",
+			field:       map[string]any{"__typename": "ProjectV2Field"},
+			expectedErr: `field "Status" is not a single-select field`,
+		},
+	}
+	for _, tc := range fieldErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					projectBoardUserQuery{},
+					vars,
+					githubv4mock.DataResponse(map[string]any{
+						"user": map[string]any{
+							"projectV2": map[string]any{
+								"public": true,
+								"field":  tc.field,
+								"items":  map[string]any{"totalCount": 0, "nodes": []any{}, "pageInfo": map[string]any{"hasNextPage": false}},
+							},
+						},
+					}),
+				),
+			)
+			deps := BaseDeps{GQLClient: githubv4.NewClient(gqlMockedClient)}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"method":         "get_project_board",
+				"owner":          "octo-org",
+				"owner_type":     "user",
+				"project_number": float64(1),
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+			assert.Equal(t, tc.expectedErr, getErrorResult(t, result).Text)
+		})
+	}
+}
+
 func Test_ProjectsWrite_CreateProjectStatusUpdate(t *testing.T) {
 	toolDef := ProjectsWrite(translations.NullTranslationHelper)
 
@@ -1539,3 +2722,182 @@ func Test_ProjectsWrite_CreateProjectStatusUpdate(t *testing.T) {
 		assert.Equal(t, "AT_RISK", response["status"])
 	})
 }
+
+func Test_ProjectsWrite_UpdateProjectItemPosition(t *testing.T) {
+	toolDef := ProjectsWrite(translations.NullTranslationHelper)
+
+	projectItems := map[string]map[string]any{
+		"/orgs/octo-org/projectsV2/1/items/101": {"id": 101, "node_id": "PVTI_101", "project_node_id": "PVT_project1"},
+		"/orgs/octo-org/projectsV2/1/items/102": {"id": 102, "node_id": "PVTI_102", "project_node_id": "PVT_project1"},
+		"/orgs/octo-org/projectsV2/1/items/103": {"id": 103, "node_id": "PVTI_103", "project_node_id": "PVT_other"},
+	}
+	getItem := func(w http.ResponseWriter, r *http.Request) {
+		item, ok := projectItems[r.URL.Path]
+		if !ok {
+			mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+			return
+		}
+		mockResponse(t, http.StatusOK, item)(w, r)
+	}
+	orderNode := func(id, title string) map[string]any {
+		return map[string]any{"fullDatabaseId": id, "content": map[string]any{"title": title}}
+	}
+	afterID := githubv4.ID("PVTI_102")
+	orderVars := func(after any) map[string]any {
+		return map[string]any{"projectId": githubv4.ID("PVT_project1"), "after": after}
+	}
+
+	t.Run("moves the item and reports its neighbors", func(t *testing.T) {
+		gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewMutationMatcher(
+				updateProjectItemPositionMutation{},
+				githubv4.UpdateProjectV2ItemPositionInput{
+					ProjectID: githubv4.ID("PVT_project1"),
+					ItemID:    githubv4.ID("PVTI_101"),
+					AfterID:   &afterID,
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"updateProjectV2ItemPosition": map[string]any{"clientMutationId": nil}}),
+			),
+			githubv4mock.NewQueryMatcher(
+				projectItemOrderQuery{},
+				orderVars((*githubv4.String)(nil)),
+				githubv4mock.DataResponse(map[string]any{
+					"node": map[string]any{"items": map[string]any{
+						"nodes":    []any{orderNode("104", "Design"), orderNode("102", "Build API")},
+						"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c1"},
+					}},
+				}),
+			),
+			githubv4mock.NewQueryMatcher(
+				projectItemOrderQuery{},
+				orderVars(githubv4.String("c1")),
+				githubv4mock.DataResponse(map[string]any{
+					"node": map[string]any{"items": map[string]any{
+						"nodes":    []any{orderNode("101", "Write docs"), orderNode("105", "Release")},
+						"pageInfo": map[string]any{"hasNextPage": false},
+					}},
+				}),
+			),
+		)
+		deps := BaseDeps{
+			Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{GetOrgsProjectsV2ItemsByProjectByItemID: getItem})),
+			GQLClient: githubv4.NewClient(gqlMockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "update_project_item_position",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(101),
+			"after_item_id":  float64(102),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response ProjectItemPositionResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, ProjectItemPositionResult{
+			ItemID:   101,
+			Position: 3,
+			Previous: &ProjectItemNeighbor{ItemID: 102, Title: "Build API"},
+			Next:     &ProjectItemNeighbor{ItemID: 105, Title: "Release"},
+		}, response)
+	})
+
+	t.Run("moves the item to the top", func(t *testing.T) {
+		gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewMutationMatcher(
+				updateProjectItemPositionMutation{},
+				githubv4.UpdateProjectV2ItemPositionInput{
+					ProjectID: githubv4.ID("PVT_project1"),
+					ItemID:    githubv4.ID("PVTI_101"),
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"updateProjectV2ItemPosition": map[string]any{"clientMutationId": nil}}),
+			),
+			githubv4mock.NewQueryMatcher(
+				projectItemOrderQuery{},
+				orderVars((*githubv4.String)(nil)),
+				githubv4mock.DataResponse(map[string]any{
+					"node": map[string]any{"items": map[string]any{
+						"nodes":    []any{orderNode("101", "Write docs"), orderNode("104", "Design")},
+						"pageInfo": map[string]any{"hasNextPage": false},
+					}},
+				}),
+			),
+		)
+		deps := BaseDeps{
+			Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{GetOrgsProjectsV2ItemsByProjectByItemID: getItem})),
+			GQLClient: githubv4.NewClient(gqlMockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "update_project_item_position",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(101),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		assert.JSONEq(t, `{"item_id":101,"position":1,"previous":null,"next":{"item_id":104,"title":"Design"}}`, getTextResult(t, result).Text)
+	})
+
+	errorCases := []struct {
+		name        string
+		args        map[string]any
+		expectedErr string
+	}{
+		{
+			name:        "after item in another project",
+			args:        map[string]any{"item_id": float64(101), "after_item_id": float64(103)},
+			expectedErr: "after_item_id 103 belongs to a different project than item_id 101",
+		},
+		{
+			name:        "after item not in the project",
+			args:        map[string]any{"item_id": float64(101), "after_item_id": float64(999)},
+			expectedErr: "after_item_id 999 is not an item of project 1",
+		},
+		{
+			name:        "item not in the project",
+			args:        map[string]any{"item_id": float64(999)},
+			expectedErr: "item_id 999 is not an item of project 1",
+		},
+		{
+			name:        "item placed after itself",
+			args:        map[string]any{"item_id": float64(101), "after_item_id": float64(101)},
+			expectedErr: "after_item_id must differ from item_id",
+		},
+		{
+			name:        "missing item",
+			args:        map[string]any{},
+			expectedErr: "missing required parameter: item_id",
+		},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{GetOrgsProjectsV2ItemsByProjectByItemID: getItem})),
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient()),
+			}
+			handler := toolDef.Handler(deps)
+			args := map[string]any{
+				"method":         "update_project_item_position",
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(1),
+			}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErr)
+		})
+	}
+}
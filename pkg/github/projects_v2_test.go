@@ -3,7 +3,9 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -455,3 +457,141 @@ func Test_ProjectsWrite_CreateIterationField(t *testing.T) {
 		assert.Equal(t, "PVTIF_field1", response["id"])
 	})
 }
+
+// copyProjectGraphQLServer answers the queries and mutation of copy_project.
+// The copied project reports one more field on each read until it has all of
+// the source's fields.
+type copyProjectGraphQLServer struct {
+	sourceFields int
+	copiedFields int
+	fieldReads   int
+	copyInput    map[string]any
+}
+
+func (s *copyProjectGraphQLServer) handle(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Query     string         `json:"query"`
+		Variables map[string]any `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var data map[string]any
+	switch {
+	case strings.Contains(body.Query, "copyProjectV2("):
+		s.copyInput = body.Variables["input"].(map[string]any)
+		data = map[string]any{"copyProjectV2": map[string]any{"projectV2": map[string]any{
+			"id": "PVT_copy", "number": 7, "title": "Q3 roadmap", "url": "https://github.com/orgs/target-org/projects/7",
+		}}}
+	case strings.Contains(body.Query, "copy: node("):
+		s.fieldReads++
+		nodes := []any{}
+		for i := range s.copiedFields {
+			nodes = append(nodes, map[string]any{"name": fmt.Sprintf("Field %d", i+1), "dataType": "TEXT"})
+		}
+		data = map[string]any{
+			"source": map[string]any{"fields": map[string]any{"totalCount": s.sourceFields}},
+			"copy":   map[string]any{"fields": map[string]any{"totalCount": s.copiedFields, "nodes": nodes}},
+		}
+		s.copiedFields++
+	case strings.Contains(body.Query, "projectV2(number:"):
+		data = map[string]any{"organization": map[string]any{"projectV2": map[string]any{"id": "PVT_source"}}}
+	case strings.Contains(body.Query, "organization(login:"):
+		data = map[string]any{"organization": map[string]any{"id": "O_target"}}
+	default:
+		http.Error(w, "unexpected query: "+body.Query, http.StatusBadRequest)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+func Test_ProjectsWrite_CopyProject(t *testing.T) {
+	interval := copyProjectFieldPollInterval
+	copyProjectFieldPollInterval = 0
+	t.Cleanup(func() { copyProjectFieldPollInterval = interval })
+
+	toolDef := ProjectsWrite(translations.NullTranslationHelper)
+
+	call := func(t *testing.T, server *copyProjectGraphQLServer) map[string]any {
+		t.Helper()
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /users/target-org": mockResponse(t, http.StatusOK, map[string]any{"login": "target-org", "type": "Organization"}),
+			})),
+			GQLClient: githubv4.NewClient(MockHTTPClientWithHandler(server.handle)),
+			Obsv:      stubExporters(),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":               "copy_project",
+			"owner":                "octo-org",
+			"owner_type":           "org",
+			"project_number":       float64(3),
+			"target_owner":         "target-org",
+			"title":                "Q3 roadmap",
+			"include_draft_issues": true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		return response
+	}
+
+	t.Run("returns the copied fields once they are all there", func(t *testing.T) {
+		server := &copyProjectGraphQLServer{sourceFields: 2, copiedFields: 1}
+		response := call(t, server)
+
+		assert.Equal(t, map[string]any{
+			"projectId":          "PVT_source",
+			"ownerId":            "O_target",
+			"title":              "Q3 roadmap",
+			"includeDraftIssues": true,
+		}, server.copyInput)
+		assert.Equal(t, 2, server.fieldReads)
+		assert.Equal(t, "PVT_copy", response["id"])
+		assert.Equal(t, float64(7), response["number"])
+		assert.Equal(t, "https://github.com/orgs/target-org/projects/7", response["url"])
+		assert.Equal(t, true, response["fields_complete"])
+		assert.Equal(t, []any{
+			map[string]any{"name": "Field 1", "data_type": "TEXT"},
+			map[string]any{"name": "Field 2", "data_type": "TEXT"},
+		}, response["fields"])
+		assert.NotContains(t, response, "note")
+	})
+
+	t.Run("stops polling after a bounded number of reads", func(t *testing.T) {
+		server := &copyProjectGraphQLServer{sourceFields: 10}
+		response := call(t, server)
+
+		assert.Equal(t, copyProjectFieldPollAttempts, server.fieldReads)
+		assert.Equal(t, false, response["fields_complete"])
+		assert.Len(t, response["fields"], copyProjectFieldPollAttempts-1)
+		assert.Contains(t, response["note"], "still copying")
+	})
+	t.Run("asks for target_owner_type when the target owner cannot be resolved", func(t *testing.T) {
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /users/ghost-org": mockResponse(t, http.StatusNotFound, map[string]any{"message": "Not Found"}),
+			})),
+			GQLClient: githubv4.NewClient(MockHTTPClientWithHandler((&copyProjectGraphQLServer{}).handle)),
+			Obsv:      stubExporters(),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "copy_project",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(3),
+			"target_owner":   "ghost-org",
+			"title":          "Q3 roadmap",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "set target_owner_type")
+	})
+}
@@ -12,5 +12,6 @@ func AllPrompts(t translations.TranslationHelperFunc) []inventory.ServerPrompt {
 		// Issue prompts
 		AssignCodingAgentPrompt(t),
 		IssueToFixWorkflowPrompt(t),
+		TriageDigestPrompt(t),
 	}
 }
@@ -149,7 +149,7 @@ Possible options:
 				result, err := GetPullRequestReviews(ctx, client, deps, owner, repo, pullNumber, pagination)
 				return attachIFC(result), nil, err
 			case "get_comments":
-				result, err := GetIssueComments(ctx, client, deps, owner, repo, pullNumber, pagination)
+				result, err := GetIssueComments(ctx, client, deps, owner, repo, pullNumber, pagination, IssueCommentFilter{}, false)
 				return attachIFC(result), nil, err
 			case "get_check_runs":
 				result, err := GetPullRequestCheckRuns(ctx, client, owner, repo, pullNumber, pagination)
@@ -1596,6 +1596,10 @@ func SearchPullRequests(t translations.TranslationHelperFunc) inventory.ServerTo
 				Description: "Sort order",
 				Enum:        []any{"asc", "desc"},
 			},
+			"resolve_me": {
+				Type:        "boolean",
+				Description: "Replace @me in assignee:, author:, mentions: and involves: qualifiers with the authenticated user's login. Use when the token does not support @me.",
+			},
 		},
 		Required: []string{"query"},
 	}
@@ -1613,8 +1617,8 @@ func SearchPullRequests(t translations.TranslationHelperFunc) inventory.ServerTo
 			InputSchema: schema,
 		},
 		[]scopes.Scope{scopes.Repo},
-		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
-			result, err := searchHandler(ctx, deps.GetClient, args, "pr", "failed to search pull requests", ifcSearchPostProcessOption(ctx, deps))
+		func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			result, err := searchHandler(ctx, deps.GetClient, deps.GetRepoPolicy(), args, "pr", "failed to search pull requests", ifcSearchPostProcessOption(ctx, deps), withSearchSession(req))
 			return result, nil, err
 		})
 }
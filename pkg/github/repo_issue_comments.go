@@ -0,0 +1,156 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// RepoIssueComment is an issue comment listed across a whole repository,
+// along with the issue or pull request it was posted on.
+type RepoIssueComment struct {
+	MinimalIssueComment
+	IssueNumber int    `json:"issue_number,omitempty"`
+	IssueURL    string `json:"issue_url,omitempty"`
+}
+
+// ListRepoIssueComments creates a tool that lists issue and pull request
+// comments across a repository, optionally only those updated since a time.
+func ListRepoIssueComments(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"since": {
+				Type:        "string",
+				Description: "Only return comments updated at or after this time. ISO 8601 timestamp (YYYY-MM-DDThh:mm:ssZ or YYYY-MM-DD).",
+			},
+		},
+		Required: []string{"owner", "repo"},
+	}
+	WithPagination(schema)
+
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "list_repository_issue_comments",
+			Description: t("TOOL_LIST_REPOSITORY_ISSUE_COMMENTS_DESCRIPTION", "List comments on all issues and pull requests in a repository, oldest first, each with the URL of the issue it belongs to. Use 'since' to see recent discussion activity, e.g. for a weekly digest, without listing issues first. Does not include pull request review comments."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_REPOSITORY_ISSUE_COMMENTS_USER_TITLE", "List repository issue comments"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			since, err := OptionalParam[string](args, "since")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			opts := &github.IssueListCommentsOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			if since != "" {
+				sinceTime, err := parseISOTimestamp(since)
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("failed to list repository issue comments: %s", err.Error())), nil, nil
+				}
+				opts.Since = &sinceTime
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			// Issue number 0 lists the comments of every issue in the repository.
+			comments, resp, err := client.Issues.ListComments(ctx, owner, repo, 0, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository issue comments", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if deps.GetFlags(ctx).LockdownMode {
+				cache, err := deps.GetRepoAccessCache(ctx)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to get repo access cache: %w", err)
+				}
+				if cache == nil {
+					return nil, nil, fmt.Errorf("lockdown cache is not configured")
+				}
+				filtered := make([]*github.IssueComment, 0, len(comments))
+				for _, comment := range comments {
+					login := comment.GetUser().GetLogin()
+					if login == "" {
+						continue
+					}
+					isSafeContent, err := cache.IsSafeContent(ctx, login, owner, repo)
+					if err != nil {
+						return utils.NewToolResultError(fmt.Sprintf("failed to check lockdown mode: %v", err)), nil, nil
+					}
+					if isSafeContent {
+						filtered = append(filtered, comment)
+					}
+				}
+				comments = filtered
+			}
+
+			result := make([]RepoIssueComment, 0, len(comments))
+			for _, comment := range comments {
+				result = append(result, convertToRepoIssueComment(comment))
+			}
+
+			callResult := MarshalledTextResult(result)
+			callResult = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, callResult, ifc.LabelRepoUserContent)
+			return callResult, nil, nil
+		})
+}
+
+// convertToRepoIssueComment derives the parent issue's number from the
+// comment's API issue_url and its web URL from the comment permalink.
+func convertToRepoIssueComment(comment *github.IssueComment) RepoIssueComment {
+	c := RepoIssueComment{MinimalIssueComment: convertToMinimalIssueComment(comment)}
+	if apiURL := comment.GetIssueURL(); apiURL != "" {
+		if number, err := strconv.Atoi(apiURL[strings.LastIndex(apiURL, "/")+1:]); err == nil {
+			c.IssueNumber = number
+		}
+	}
+	if htmlURL, _, ok := strings.Cut(comment.GetHTMLURL(), "#"); ok {
+		c.IssueURL = htmlURL
+	}
+	return c
+}
@@ -0,0 +1,109 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepoIssueComments(t *testing.T) {
+	serverTool := ListRepoIssueComments(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_repository_issue_comments", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "since")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	createdAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	comments := []*github.IssueComment{
+		{
+			ID:        github.Ptr(int64(11)),
+			Body:      github.Ptr("Reproduced on main"),
+			HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/4#issuecomment-11"),
+			IssueURL:  github.Ptr("https://api.github.com/repos/owner/repo/issues/4"),
+			User:      &github.User{Login: github.Ptr("octocat")},
+			CreatedAt: &github.Timestamp{Time: createdAt},
+		},
+		{
+			ID:       github.Ptr(int64(12)),
+			Body:     github.Ptr("LGTM"),
+			HTMLURL:  github.Ptr("https://github.com/owner/repo/pull/7#issuecomment-12"),
+			IssueURL: github.Ptr("https://api.github.com/repos/owner/repo/issues/7"),
+			User:     &github.User{Login: github.Ptr("hubot")},
+		},
+	}
+
+	t.Run("lists comments since a timestamp with their issues", func(t *testing.T) {
+		var query map[string]string
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			"GET /repos/owner/repo/issues/comments": func(w http.ResponseWriter, r *http.Request) {
+				query = map[string]string{
+					"since":    r.URL.Query().Get("since"),
+					"page":     r.URL.Query().Get("page"),
+					"per_page": r.URL.Query().Get("per_page"),
+				}
+				_ = json.NewEncoder(w).Encode(comments)
+			},
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"owner":   "owner",
+			"repo":    "repo",
+			"since":   "2026-03-01",
+			"page":    float64(2),
+			"perPage": float64(50),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		assert.Equal(t, map[string]string{"since": "2026-03-01T00:00:00Z", "page": "2", "per_page": "50"}, query)
+
+		var response []RepoIssueComment
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response, 2)
+		assert.Equal(t, int64(11), response[0].ID)
+		assert.Equal(t, "Reproduced on main", response[0].Body)
+		assert.Equal(t, "octocat", response[0].User.Login)
+		assert.Equal(t, "2026-03-02T10:00:00Z", response[0].CreatedAt)
+		assert.Equal(t, 4, response[0].IssueNumber)
+		assert.Equal(t, "https://github.com/owner/repo/issues/4", response[0].IssueURL)
+		assert.Equal(t, 7, response[1].IssueNumber)
+		assert.Equal(t, "https://github.com/owner/repo/pull/7", response[1].IssueURL)
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "since": "last week"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "invalid ISO 8601 timestamp")
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			"GET /repos/owner/repo/issues/comments": mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+		}))}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to list repository issue comments")
+	})
+}
@@ -0,0 +1,190 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// RepoPolicy restricts which repositories tools may operate on, regardless of
+// what the token can reach. Patterns have the form owner/repo, where either
+// part may be a glob (for example octo-org/* or octo-org/api-*). Matching is
+// case-insensitive, like GitHub logins and repository names.
+//
+// A repository is permitted when it matches no deny pattern and, if any allow
+// patterns are configured, at least one of them. A nil *RepoPolicy permits
+// everything.
+type RepoPolicy struct {
+	allow []string
+	deny  []string
+}
+
+// NewRepoPolicy validates the allow and deny patterns and returns the policy
+// they describe. It returns nil when both lists are empty.
+func NewRepoPolicy(allow, deny []string) (*RepoPolicy, error) {
+	if len(allow) == 0 && len(deny) == 0 {
+		return nil, nil
+	}
+	p := &RepoPolicy{}
+	for _, list := range []struct {
+		patterns []string
+		dst      *[]string
+	}{{allow, &p.allow}, {deny, &p.deny}} {
+		for _, pattern := range list.patterns {
+			pattern = strings.ToLower(strings.TrimSpace(pattern))
+			if pattern == "" {
+				continue
+			}
+			if err := validateRepoPattern(pattern); err != nil {
+				return nil, err
+			}
+			*list.dst = append(*list.dst, pattern)
+		}
+	}
+	return p, nil
+}
+
+func validateRepoPattern(pattern string) error {
+	owner, repo, ok := strings.Cut(pattern, "/")
+	if !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return fmt.Errorf("invalid repository pattern %q: must be owner/repo or owner/*", pattern)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid repository pattern %q: %w", pattern, err)
+	}
+	return nil
+}
+
+// IsRepoPermitted reports whether tools may operate on owner/repo.
+func (p *RepoPolicy) IsRepoPermitted(owner, repo string) bool {
+	if p == nil {
+		return true
+	}
+	name := strings.ToLower(owner + "/" + repo)
+	for _, pattern := range p.deny {
+		if matched, _ := path.Match(pattern, name); matched {
+			return false
+		}
+	}
+	if len(p.allow) == 0 {
+		return true
+	}
+	for _, pattern := range p.allow {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOwnerPermitted reports whether tools may operate on owner-level resources,
+// such as projects, of owner. An owner is refused when all of its repositories
+// are denied, or when allow patterns are configured and none covers it.
+func (p *RepoPolicy) IsOwnerPermitted(owner string) bool {
+	if p == nil {
+		return true
+	}
+	owner = strings.ToLower(owner)
+	for _, pattern := range p.deny {
+		ownerPattern, repoPattern, _ := strings.Cut(pattern, "/")
+		if matched, _ := path.Match(ownerPattern, owner); matched && repoPattern == "*" {
+			return false
+		}
+	}
+	if len(p.allow) == 0 {
+		return true
+	}
+	for _, pattern := range p.allow {
+		ownerPattern, _, _ := strings.Cut(pattern, "/")
+		if matched, _ := path.Match(ownerPattern, owner); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func repoNotPermittedError(owner, repo string) error {
+	return fmt.Errorf("repository %s/%s is not permitted by server policy", owner, repo)
+}
+
+// checkRepo returns an error if the policy does not permit owner/repo. Tools
+// that read the repository from a URL call it once the URL is parsed, since
+// checkArgs only sees owner and repo arguments.
+func (p *RepoPolicy) checkRepo(owner, repo string) error {
+	if !p.IsRepoPermitted(owner, repo) {
+		return repoNotPermittedError(owner, repo)
+	}
+	return nil
+}
+
+// repoQualifierPattern matches repo:owner/name qualifiers in a search query.
+var repoQualifierPattern = regexp.MustCompile(`(?:^|[\s(])-?repo:"?([^\s/"()]+)/([^\s"()]+)`)
+
+// checkSearchQuery returns an error if query names a repository the policy
+// does not permit through a repo: qualifier.
+func (p *RepoPolicy) checkSearchQuery(query string) error {
+	if p == nil {
+		return nil
+	}
+	for _, m := range repoQualifierPattern.FindAllStringSubmatch(query, -1) {
+		if !p.IsRepoPermitted(m[1], m[2]) {
+			return repoNotPermittedError(m[1], m[2])
+		}
+	}
+	return nil
+}
+
+// checkScopedSearchQuery returns an error if the policy has allow patterns and
+// query names no repository through a repo: qualifier. Searches scoped only by
+// org:, user: or keywords would otherwise return issues, pull requests, code
+// or commits from repositories outside the allow list.
+func (p *RepoPolicy) checkScopedSearchQuery(query string) error {
+	if p == nil || len(p.allow) == 0 {
+		return nil
+	}
+	for _, m := range repoQualifierPattern.FindAllStringSubmatch(query, -1) {
+		if !strings.HasPrefix(strings.TrimLeft(m[0], " \t\n("), "-") {
+			return nil
+		}
+	}
+	return errors.New("search queries must include a repo: qualifier naming a permitted repository when the server policy allows only specific repositories")
+}
+
+// checkArgs enforces the policy on a tool call before the handler runs. Calls
+// naming both owner and repo are checked against the repository, calls naming
+// only an owner against the owner, and a search query's repo: qualifiers
+// against the repositories they name.
+func (p *RepoPolicy) checkArgs(args any) error {
+	if p == nil {
+		return nil
+	}
+	m, ok := args.(map[string]any)
+	if !ok {
+		// Typed arguments: inspect the same fields through their JSON form.
+		b, err := json.Marshal(args)
+		if err != nil || json.Unmarshal(b, &m) != nil {
+			return nil
+		}
+	}
+
+	owner, _ := m["owner"].(string)
+	repo, _ := m["repo"].(string)
+	switch {
+	case owner != "" && repo != "":
+		if err := p.checkRepo(owner, repo); err != nil {
+			return err
+		}
+	case owner != "":
+		if !p.IsOwnerPermitted(owner) {
+			return fmt.Errorf("owner %s is not permitted by server policy", owner)
+		}
+	}
+
+	if query, ok := m["query"].(string); ok {
+		return p.checkSearchQuery(query)
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRepoPolicy(t *testing.T) {
+	policy, err := NewRepoPolicy(nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+	assert.True(t, policy.IsRepoPermitted("any", "repo"))
+	assert.True(t, policy.IsOwnerPermitted("any"))
+
+	for _, pattern := range []string{"octo-org", "octo-org/", "/repo", "a/b/c", "octo-org/[", "*"} {
+		_, err := NewRepoPolicy([]string{pattern}, nil)
+		assert.Error(t, err, pattern)
+	}
+}
+
+func TestRepoPolicy_IsRepoPermitted(t *testing.T) {
+	tests := []struct {
+		name     string
+		allow    []string
+		deny     []string
+		owner    string
+		repo     string
+		expected bool
+	}{
+		{name: "exact allow", allow: []string{"octo-org/api"}, owner: "octo-org", repo: "api", expected: true},
+		{name: "not in allow list", allow: []string{"octo-org/api"}, owner: "octo-org", repo: "web", expected: false},
+		{name: "owner wildcard allow", allow: []string{"octo-org/*"}, owner: "octo-org", repo: "web", expected: true},
+		{name: "owner wildcard does not match other owners", allow: []string{"octo-org/*"}, owner: "other", repo: "web", expected: false},
+		{name: "glob in repo name", allow: []string{"octo-org/api-*"}, owner: "octo-org", repo: "api-gateway", expected: true},
+		{name: "case insensitive", allow: []string{"Octo-Org/API"}, owner: "octo-org", repo: "Api", expected: true},
+		{name: "deny only", deny: []string{"octo-org/secrets"}, owner: "octo-org", repo: "secrets", expected: false},
+		{name: "deny only permits others", deny: []string{"octo-org/secrets"}, owner: "octo-org", repo: "api", expected: true},
+		{name: "deny wins over allow", allow: []string{"octo-org/*"}, deny: []string{"octo-org/secrets"}, owner: "octo-org", repo: "secrets", expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := NewRepoPolicy(tc.allow, tc.deny)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, policy.IsRepoPermitted(tc.owner, tc.repo))
+		})
+	}
+}
+
+func TestRepoPolicy_IsOwnerPermitted(t *testing.T) {
+	policy, err := NewRepoPolicy([]string{"octo-org/api", "team-*/*"}, []string{"team-secret/*", "octo-org/secrets"})
+	require.NoError(t, err)
+
+	assert.True(t, policy.IsOwnerPermitted("octo-org"))
+	assert.True(t, policy.IsOwnerPermitted("Team-Web"))
+	assert.False(t, policy.IsOwnerPermitted("team-secret"))
+	assert.False(t, policy.IsOwnerPermitted("other"))
+}
+
+func TestRepoPolicy_CheckSearchQuery(t *testing.T) {
+	policy, err := NewRepoPolicy([]string{"octo-org/*"}, nil)
+	require.NoError(t, err)
+
+	assert.NoError(t, policy.checkSearchQuery("is:issue bug"))
+	assert.NoError(t, policy.checkSearchQuery("repo:octo-org/api bug"))
+	assert.EqualError(t, policy.checkSearchQuery("bug (repo:octo-org/api OR repo:other/web)"),
+		"repository other/web is not permitted by server policy")
+	assert.EqualError(t, policy.checkSearchQuery(`repo:"other/web"`),
+		"repository other/web is not permitted by server policy")
+}
+
+func TestRepoPolicy_CheckScopedSearchQuery(t *testing.T) {
+	policy, err := NewRepoPolicy([]string{"octo-org/*"}, nil)
+	require.NoError(t, err)
+
+	assert.NoError(t, policy.checkScopedSearchQuery("repo:octo-org/api bug"))
+	assert.NoError(t, policy.checkScopedSearchQuery("bug (repo:octo-org/api OR repo:octo-org/web)"))
+	assert.Error(t, policy.checkScopedSearchQuery("bug"))
+	assert.Error(t, policy.checkScopedSearchQuery("org:octo-org bug"))
+	assert.Error(t, policy.checkScopedSearchQuery("bug -repo:octo-org/api"))
+
+	denyOnly, err := NewRepoPolicy(nil, []string{"octo-org/secrets"})
+	require.NoError(t, err)
+	assert.NoError(t, denyOnly.checkScopedSearchQuery("org:octo-org bug"))
+}
+
+func TestRepoPolicy_DeniedCallsMakeNoRequests(t *testing.T) {
+	const scopedSearchErr = "search queries must include a repo: qualifier naming a permitted repository when the server policy allows only specific repositories"
+	policy, err := NewRepoPolicy([]string{"octo-org/*"}, []string{"octo-org/secrets"})
+	require.NoError(t, err)
+
+	var requests atomic.Int32
+	httpClient := MockHTTPClientWithHandler(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient())
+
+	tests := []struct {
+		name        string
+		tool        string
+		args        map[string]any
+		expectedErr string
+	}{
+		{
+			name:        "issue_read on a denied repository",
+			tool:        "issue_read",
+			args:        map[string]any{"method": "get", "owner": "octo-org", "repo": "secrets", "issue_number": float64(1)},
+			expectedErr: "repository octo-org/secrets is not permitted by server policy",
+		},
+		{
+			name:        "sub_issue_write on a repository outside the allow list",
+			tool:        "sub_issue_write",
+			args:        map[string]any{"method": "add", "owner": "other", "repo": "web", "issue_number": float64(1), "sub_issue_id": float64(2)},
+			expectedErr: "repository other/web is not permitted by server policy",
+		},
+		{
+			name:        "search_issues scoped to a denied repository",
+			tool:        "search_issues",
+			args:        map[string]any{"query": "bug", "owner": "octo-org", "repo": "secrets"},
+			expectedErr: "repository octo-org/secrets is not permitted by server policy",
+		},
+		{
+			name:        "search_issues with a denied repo qualifier",
+			tool:        "search_issues",
+			args:        map[string]any{"query": "bug repo:other/web"},
+			expectedErr: "repository other/web is not permitted by server policy",
+		},
+		{
+			name:        "lock_issue on a denied repository by URL",
+			tool:        "lock_issue",
+			args:        map[string]any{"issue_url": "https://github.com/octo-org/secrets/issues/1"},
+			expectedErr: "repository octo-org/secrets is not permitted by server policy",
+		},
+		{
+			name:        "unlock_issue on a repository outside the allow list by URL",
+			tool:        "unlock_issue",
+			args:        map[string]any{"issue_url": "https://github.com/other/web/issues/1"},
+			expectedErr: "repository other/web is not permitted by server policy",
+		},
+		{
+			name:        "get_comment_by_url on a denied repository",
+			tool:        "get_comment_by_url",
+			args:        map[string]any{"url": "https://github.com/octo-org/secrets/issues/1#issuecomment-2"},
+			expectedErr: "repository octo-org/secrets is not permitted by server policy",
+		},
+		{
+			name:        "issue_write closing as a duplicate of an issue in a denied repository",
+			tool:        "issue_write",
+			args:        map[string]any{"method": "update", "owner": "octo-org", "repo": "api", "issue_number": float64(1), "state": "closed", "state_reason": "duplicate", "duplicate_of": "octo-org/secrets#4"},
+			expectedErr: "repository octo-org/secrets is not permitted by server policy",
+		},
+		{
+			name:        "search_issues scoped only by organization",
+			tool:        "search_issues",
+			args:        map[string]any{"query": "org:octo-org secret"},
+			expectedErr: scopedSearchErr,
+		},
+		{
+			name:        "search_code without a repo qualifier",
+			tool:        "search_code",
+			args:        map[string]any{"query": "password"},
+			expectedErr: scopedSearchErr,
+		},
+		{
+			name:        "search_commits excluding a repository only",
+			tool:        "search_commits",
+			args:        map[string]any{"query": "fix -repo:octo-org/api"},
+			expectedErr: scopedSearchErr,
+		},
+		{
+			name:        "projects_list for an owner outside the allow list",
+			tool:        "projects_list",
+			args:        map[string]any{"method": "list_projects", "owner": "other", "owner_type": "org"},
+			expectedErr: "owner other is not permitted by server policy",
+		},
+	}
+	tools := make(map[string]func(any) mcp.ToolHandler)
+	for _, st := range AllTools(translations.NullTranslationHelper) {
+		tools[st.Tool.Name] = st.Handler
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			newHandler, ok := tools[tc.tool]
+			require.True(t, ok, tc.tool)
+			deps := BaseDeps{
+				Client:     mustNewGHClient(t, httpClient),
+				GQLClient:  gqlClient,
+				RepoPolicy: policy,
+			}
+			handler := newHandler(deps)
+			request := createMCPRequest(tc.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+			assert.Equal(t, tc.expectedErr, getErrorResult(t, result).Text)
+		})
+	}
+	assert.Zero(t, requests.Load())
+}
+
+func TestPrepareSearchArgs_RepoPolicy(t *testing.T) {
+	policy, err := NewRepoPolicy(nil, []string{"octo-org/secrets"})
+	require.NoError(t, err)
+
+	_, _, err = prepareSearchArgs(map[string]any{"query": "bug", "owner": "octo-org", "repo": "secrets"}, "issue", policy)
+	assert.EqualError(t, err, "repository octo-org/secrets is not permitted by server policy")
+
+	query, _, err := prepareSearchArgs(map[string]any{"query": "bug", "owner": "octo-org", "repo": "api"}, "issue", policy)
+	require.NoError(t, err)
+	assert.Equal(t, "repo:octo-org/api is:issue bug", query)
+}
@@ -168,11 +168,11 @@ func ListCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 					},
 					"since": {
 						Type:        "string",
-						Description: "Only commits after this date will be returned (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ or YYYY-MM-DD)",
+						Description: "Only commits after this date will be returned (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ or YYYY-MM-DD, or relative such as '7 days ago', 'yesterday' or 'today')",
 					},
 					"until": {
 						Type:        "string",
-						Description: "Only commits before this date will be returned (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ or YYYY-MM-DD)",
+						Description: "Only commits before this date will be returned (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ or YYYY-MM-DD, or relative such as '7 days ago', 'yesterday' or 'today')",
 					},
 				},
 				Required: []string{"owner", "repo"},
@@ -227,14 +227,14 @@ func ListCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 				},
 			}
 			if sinceStr != "" {
-				sinceTime, err := parseISOTimestamp(sinceStr)
+				sinceTime, err := parseFlexibleTimestamp(sinceStr)
 				if err != nil {
 					return utils.NewToolResultError(fmt.Sprintf("invalid since timestamp: %s", err)), nil, nil
 				}
 				opts.Since = sinceTime
 			}
 			if untilStr != "" {
-				untilTime, err := parseISOTimestamp(untilStr)
+				untilTime, err := parseFlexibleTimestamp(untilStr)
 				if err != nil {
 					return utils.NewToolResultError(fmt.Sprintf("invalid until timestamp: %s", err)), nil, nil
 				}
@@ -1830,6 +1830,88 @@ func ListReleases(t translations.TranslationHelperFunc) inventory.ServerTool {
 	)
 }
 
+// GetRepository creates a tool to get the metadata of a GitHub repository.
+func GetRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "get_repository",
+			Description: t("TOOL_GET_REPOSITORY_DESCRIPTION", "Get metadata for a GitHub repository: default branch, visibility, whether it is archived, whether issues and projects are enabled, open issue count and topics."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_REPOSITORY_USER_TITLE", "Get repository"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get repository", resp, body), nil, nil
+			}
+
+			visibility := repository.GetVisibility()
+			if visibility == "" {
+				// GHES versions without the visibility field only report private.
+				visibility = "public"
+				if repository.GetPrivate() {
+					visibility = "private"
+				}
+			}
+
+			result := MarshalledTextResult(MinimalRepositoryMetadata{
+				FullName:        repository.GetFullName(),
+				Description:     repository.GetDescription(),
+				DefaultBranch:   repository.GetDefaultBranch(),
+				Visibility:      visibility,
+				Archived:        repository.GetArchived(),
+				HasIssues:       repository.GetHasIssues(),
+				HasProjects:     repository.GetHasProjects(),
+				OpenIssuesCount: repository.GetOpenIssuesCount(),
+				Topics:          repository.Topics,
+			})
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelRepoMetadata(repository.GetPrivate()))
+			return result, nil, nil
+		},
+	)
+}
+
 // GetLatestRelease creates a tool to get the latest release in a GitHub repository.
 func GetLatestRelease(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
@@ -3602,6 +3602,106 @@ func Test_ListReleases(t *testing.T) {
 	}
 }
 
+func Test_GetRepository(t *testing.T) {
+	serverTool := GetRepository(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_repository", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	mockRepo := &github.Repository{
+		FullName:        github.Ptr("owner/repo"),
+		Description:     github.Ptr("A test repository"),
+		DefaultBranch:   github.Ptr("main"),
+		Visibility:      github.Ptr("internal"),
+		Private:         github.Ptr(true),
+		Archived:        github.Ptr(false),
+		HasIssues:       github.Ptr(false),
+		HasProjects:     github.Ptr(true),
+		OpenIssuesCount: github.Ptr(7),
+		Topics:          []string{"mcp", "go"},
+		Owner:           &github.User{Login: github.Ptr("owner")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expected       MinimalRepositoryMetadata
+		expectedErrMsg string
+	}{
+		{
+			name: "successful repository fetch",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposByOwnerByRepo: mockResponse(t, http.StatusOK, mockRepo),
+			}),
+			expected: MinimalRepositoryMetadata{
+				FullName:        "owner/repo",
+				Description:     "A test repository",
+				DefaultBranch:   "main",
+				Visibility:      "internal",
+				HasProjects:     true,
+				OpenIssuesCount: 7,
+				Topics:          []string{"mcp", "go"},
+			},
+		},
+		{
+			name: "visibility falls back to the private flag",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposByOwnerByRepo: mockResponse(t, http.StatusOK, &github.Repository{
+					FullName:      github.Ptr("owner/repo"),
+					DefaultBranch: github.Ptr("trunk"),
+					Private:       github.Ptr(true),
+					HasIssues:     github.Ptr(true),
+				}),
+			}),
+			expected: MinimalRepositoryMetadata{
+				FullName:      "owner/repo",
+				DefaultBranch: "trunk",
+				Visibility:    "private",
+				HasIssues:     true,
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			expectError:    true,
+			expectedErrMsg: "failed to get repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: mustNewGHClient(t, tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var returned MinimalRepositoryMetadata
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
 func Test_GetLatestRelease(t *testing.T) {
 	serverTool := GetLatestRelease(translations.NullTranslationHelper)
 	tool := serverTool.Tool
@@ -0,0 +1,49 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// DefaultMaxResponseBytes is the largest serialized result a guarded tool
+// returns when no limit is configured.
+const DefaultMaxResponseBytes = 1 << 20
+
+// ResponseTooLargeNotice replaces a tool result whose serialized size exceeds
+// the configured limit, telling the caller how to ask for less.
+type ResponseTooLargeNotice struct {
+	Truncated        bool   `json:"truncated"`
+	ResponseBytes    int    `json:"response_bytes"`
+	MaxResponseBytes int    `json:"max_response_bytes"`
+	Message          string `json:"message"`
+}
+
+// limitResponseSize returns result unchanged when its serialized size is
+// within the limit configured on deps. Otherwise it returns an error result
+// carrying a ResponseTooLargeNotice, with hint describing how to fetch a
+// smaller page. Error results are passed through as they are.
+func limitResponseSize(deps ToolDependencies, result *mcp.CallToolResult, hint string) *mcp.CallToolResult {
+	if result == nil || result.IsError {
+		return result
+	}
+	limit := deps.GetMaxResponseBytes()
+	if limit <= 0 {
+		limit = DefaultMaxResponseBytes
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil || len(data) <= limit {
+		return result
+	}
+
+	notice := MarshalledTextResult(ResponseTooLargeNotice{
+		Truncated:        true,
+		ResponseBytes:    len(data),
+		MaxResponseBytes: limit,
+		Message:          fmt.Sprintf("The response is %s bytes, over the server's limit of %s bytes, and was not returned. %s", formatThousands(len(data)), formatThousands(limit), hint),
+	})
+	notice.IsError = true
+	return notice
+}
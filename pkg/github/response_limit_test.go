@@ -0,0 +1,112 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitResponseSize(t *testing.T) {
+	result := MarshalledTextResult(map[string]any{"body": strings.Repeat("x", 500)})
+
+	t.Run("within the limit", func(t *testing.T) {
+		assert.Same(t, result, limitResponseSize(BaseDeps{MaxResponseBytes: 10_000}, result, "Paginate."))
+	})
+
+	t.Run("zero uses the default limit", func(t *testing.T) {
+		assert.Same(t, result, limitResponseSize(BaseDeps{}, result, "Paginate."))
+	})
+
+	t.Run("over the limit", func(t *testing.T) {
+		got := limitResponseSize(BaseDeps{MaxResponseBytes: 100}, result, "Paginate.")
+		require.True(t, got.IsError)
+
+		var notice ResponseTooLargeNotice
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, got).Text), &notice))
+		assert.True(t, notice.Truncated)
+		assert.Greater(t, notice.ResponseBytes, 500)
+		assert.Equal(t, 100, notice.MaxResponseBytes)
+		assert.Contains(t, notice.Message, "over the server's limit of 100 bytes")
+		assert.True(t, strings.HasSuffix(notice.Message, "Paginate."))
+	})
+
+	t.Run("errors pass through", func(t *testing.T) {
+		errResult := MarshalledTextResult(strings.Repeat("x", 500))
+		errResult.IsError = true
+		assert.Same(t, errResult, limitResponseSize(BaseDeps{MaxResponseBytes: 100}, errResult, "Paginate."))
+	})
+}
+
+func Test_SearchIssues_ResponseLimit(t *testing.T) {
+	issues := make([]*github.Issue, 0, 20)
+	for i := range 20 {
+		issues = append(issues, &github.Issue{
+			Number: github.Ptr(i + 1),
+			Title:  github.Ptr("Flaky test"),
+			Body:   github.Ptr(strings.Repeat("stack trace line\n", 20)),
+		})
+	}
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetSearchIssues: mockResponse(t, http.StatusOK, &github.IssuesSearchResult{
+			Total:  github.Ptr(len(issues)),
+			Issues: issues,
+		}),
+	}))
+	serverTool := SearchIssues(translations.NullTranslationHelper)
+	request := createMCPRequest(map[string]any{"query": "flaky"})
+
+	deps := BaseDeps{Client: client, MaxResponseBytes: 2_000}
+	handler := serverTool.Handler(deps)
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+
+	var notice ResponseTooLargeNotice
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &notice))
+	assert.True(t, notice.Truncated)
+	assert.Equal(t, 2_000, notice.MaxResponseBytes)
+	assert.Contains(t, notice.Message, "perPage")
+
+	deps = BaseDeps{Client: client}
+	handler = serverTool.Handler(deps)
+	result, err = handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+}
+
+func Test_ListProjectItems_ResponseLimit(t *testing.T) {
+	items := make([]map[string]any, 0, 30)
+	for i := range 30 {
+		items = append(items, map[string]any{"id": i + 1, "node_id": "PVTI_item", "content_type": "Issue"})
+	}
+	deps := BaseDeps{
+		Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusOK, items),
+		})),
+		MaxResponseBytes: 500,
+	}
+	serverTool := ProjectsList(translations.NullTranslationHelper)
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"method":         "list_project_items",
+		"owner":          "octo-org",
+		"owner_type":     "org",
+		"project_number": float64(1),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+
+	var notice ResponseTooLargeNotice
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &notice))
+	assert.True(t, notice.Truncated)
+	assert.Equal(t, 500, notice.MaxResponseBytes)
+	assert.Contains(t, notice.Message, "per_page")
+}
@@ -231,6 +231,9 @@ func SearchCode(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			if err := deps.GetRepoPolicy().checkScopedSearchQuery(query); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 			sort, err := OptionalParam[string](args, "sort")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -533,6 +536,9 @@ func SearchCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			if err := deps.GetRepoPolicy().checkScopedSearchQuery(query); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 			sort, err := OptionalParam[string](args, "sort")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MaxSearchResults is the most results the search API returns for a query,
+// however it is paged.
+const MaxSearchResults = 1000
+
+// MaxMultiRepoSearchRepos caps how many repositories search_issues_multi_repo
+// combines into one query.
+const MaxMultiRepoSearchRepos = 30
+
+// buildMultiRepoSearchQuery prefixes query with a repo: qualifier for each
+// repository. GitHub matches issues in any of the repositories a query names.
+// Repositories are names under owner, or owner/name for another owner.
+func buildMultiRepoSearchQuery(owner string, repos []string, query string) (string, error) {
+	if hasRepoFilter(query) {
+		return "", fmt.Errorf("query must not contain repo: qualifiers; list the repositories in repos")
+	}
+
+	seen := make(map[string]bool, len(repos))
+	qualifiers := make([]string, 0, len(repos)+1)
+	for _, repo := range repos {
+		repo = strings.TrimSpace(repo)
+		if !strings.Contains(repo, "/") {
+			repo = owner + "/" + repo
+		}
+		repoOwner, name, _ := strings.Cut(repo, "/")
+		if repoOwner == "" || name == "" || strings.Contains(name, "/") || strings.ContainsAny(repo, " \t") {
+			return "", fmt.Errorf("invalid repository %q in repos", repo)
+		}
+		if seen[strings.ToLower(repo)] {
+			continue
+		}
+		seen[strings.ToLower(repo)] = true
+		qualifiers = append(qualifiers, "repo:"+repo)
+	}
+	if len(qualifiers) == 0 {
+		return "", fmt.Errorf("repos must name at least one repository")
+	}
+	if len(qualifiers) > MaxMultiRepoSearchRepos {
+		return "", fmt.Errorf("repos names %d repositories, more than the %d that can be searched at once", len(qualifiers), MaxMultiRepoSearchRepos)
+	}
+
+	if query = strings.TrimSpace(query); query != "" {
+		qualifiers = append(qualifiers, query)
+	}
+	return strings.Join(qualifiers, " "), nil
+}
+
+// SearchIssuesMultiRepo creates a tool that searches issues across several
+// repositories with a single search request.
+func SearchIssuesMultiRepo(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Owner of the repositories",
+			},
+			"repos": {
+				Type:        "array",
+				Description: fmt.Sprintf("Names of the repositories to search, under owner, or as owner/name for another owner. At most %d.", MaxMultiRepoSearchRepos),
+				Items: &jsonschema.Schema{
+					Type: "string",
+				},
+				MinItems: jsonschema.Ptr(1),
+			},
+			"query": {
+				Type:        "string",
+				Description: "Search query using GitHub issues search syntax, without repo: qualifiers. Leave empty to match every issue in the repositories.",
+			},
+			"sort": {
+				Type:        "string",
+				Description: "Sort field by number of matches of categories, defaults to best match",
+				Enum: []any{
+					"comments",
+					"reactions",
+					"reactions-+1",
+					"reactions--1",
+					"reactions-smile",
+					"reactions-thinking_face",
+					"reactions-heart",
+					"reactions-tada",
+					"interactions",
+					"created",
+					"updated",
+				},
+			},
+			"order": {
+				Type:        "string",
+				Description: "Sort order",
+				Enum:        []any{"asc", "desc"},
+			},
+			"body_preview_chars": bodyPreviewCharsSchema(),
+		},
+		Required: []string{"owner", "repos"},
+	}
+	WithPagination(schema)
+
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "search_issues_multi_repo",
+			Description: t("TOOL_SEARCH_ISSUES_MULTI_REPO_DESCRIPTION", fmt.Sprintf("Search for issues in several repositories at once, e.g. across a team's repositories in an organization. "+
+				"Matches issues in any of the repositories with one search request. The search API returns at most %d results per query; 'truncated' is set when more match.", MaxSearchResults)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_SEARCH_ISSUES_MULTI_REPO_USER_TITLE", "Search issues in multiple repositories"),
+				ReadOnlyHint: true,
+			},
+			InputSchema:  schema,
+			OutputSchema: searchIssuesOutputSchema(),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repos, err := OptionalStringArrayParam(args, "repos")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			query, err := OptionalParam[string](args, "query")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if (pagination.Page-1)*pagination.PerPage >= MaxSearchResults {
+				return utils.NewToolResultError(fmt.Sprintf("the search API returns at most %d results per query, so page %d is out of reach; narrow the query instead", MaxSearchResults, pagination.Page)), nil, nil
+			}
+
+			combined, err := buildMultiRepoSearchQuery(owner, repos, query)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			searchArgs := map[string]any{"query": combined}
+			for _, key := range []string{"sort", "order", "page", "perPage", "body_preview_chars"} {
+				if v, ok := args[key]; ok {
+					searchArgs[key] = v
+				}
+			}
+			result, err := searchIssuesHandler(ctx, deps, searchArgs, ifcSearchPostProcessOption(ctx, deps), withSearchSession(req))
+			return limitResponseSize(deps, result, "Request a smaller page with perPage or narrow the query, and fetch the rest with page."), nil, err
+		})
+}
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SearchIssuesMultiRepo(t *testing.T) {
+	serverTool := SearchIssuesMultiRepo(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "search_issues_multi_repo", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repos"})
+
+	searchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(1500),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{
+				Number:        github.Ptr(7),
+				Title:         github.Ptr("Crash on start"),
+				State:         github.Ptr("open"),
+				HTMLURL:       github.Ptr("https://github.com/acme/web/issues/7"),
+				RepositoryURL: github.Ptr("https://api.github.com/repos/acme/web"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		args           map[string]any
+		expectedQuery  string
+		expectedErrMsg string
+	}{
+		{
+			name:          "combines the repositories with the query",
+			args:          map[string]any{"owner": "acme", "repos": []any{"api", "web", "API", "tools/cli"}, "query": "label:bug is:open"},
+			expectedQuery: "is:issue repo:acme/api repo:acme/web repo:tools/cli label:bug is:open",
+		},
+		{
+			name:          "without a query",
+			args:          map[string]any{"owner": "acme", "repos": []any{"api"}},
+			expectedQuery: "is:issue repo:acme/api",
+		},
+		{
+			name:           "repo qualifier in the query",
+			args:           map[string]any{"owner": "acme", "repos": []any{"api"}, "query": "repo:acme/web bug"},
+			expectedErrMsg: "query must not contain repo: qualifiers",
+		},
+		{
+			name:           "no repositories",
+			args:           map[string]any{"owner": "acme", "repos": []any{}},
+			expectedErrMsg: "repos must name at least one repository",
+		},
+		{
+			name:           "invalid repository",
+			args:           map[string]any{"owner": "acme", "repos": []any{"acme/api/extra"}},
+			expectedErrMsg: `invalid repository "acme/api/extra" in repos`,
+		},
+		{
+			name:           "page beyond the result cap",
+			args:           map[string]any{"owner": "acme", "repos": []any{"api"}, "page": float64(11), "perPage": float64(100)},
+			expectedErrMsg: "the search API returns at most 1000 results per query, so page 11 is out of reach",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var queries []string
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchIssues: func(w http.ResponseWriter, r *http.Request) {
+					queries = append(queries, r.URL.Query().Get("q"))
+					mockResponse(t, http.StatusOK, searchResult)(w, r)
+				},
+			}))}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				assert.Empty(t, queries)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Equal(t, []string{tc.expectedQuery}, queries)
+
+			var response SearchIssuesResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.True(t, response.Truncated)
+			assert.Equal(t, 1500, *response.Total)
+			require.Len(t, response.Items, 1)
+			assert.Equal(t, "web", response.Items[0].IssueRef.Repo)
+		})
+	}
+}
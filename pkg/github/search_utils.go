@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -38,6 +39,67 @@ func hasTypeFilter(query string) bool {
 	return hasFilter(query, "type")
 }
 
+// searchMeQualifierPattern matches a user qualifier naming the searching user,
+// e.g. assignee:me, -author:@me or (involves:me), as a whitespace-separated token.
+var searchMeQualifierPattern = regexp.MustCompile(`(?i)^(\(*-?(?:assignee|author|mentions|involves):)(@?me)(\)*)$`)
+
+// searchQuotedPattern matches a quoted phrase in a search query. An
+// unterminated quote runs to the end of the query.
+var searchQuotedPattern = regexp.MustCompile(`"[^"]*("|$)`)
+
+var searchTokenPattern = regexp.MustCompile(`\S+`)
+
+// rewriteSearchMe rewrites the value of assignee:, author:, mentions: and
+// involves: qualifiers that name the searching user. Plain "me" would match
+// the literal user "me", so both "me" and "@me" become "@me", or login when it
+// is set. Quoted phrases are left untouched.
+func rewriteSearchMe(query, login string) string {
+	value := "@me"
+	if login != "" {
+		value = login
+	}
+	rewrite := func(segment string) string {
+		return searchTokenPattern.ReplaceAllStringFunc(segment, func(token string) string {
+			m := searchMeQualifierPattern.FindStringSubmatch(token)
+			if m == nil {
+				return token
+			}
+			return m[1] + value + m[3]
+		})
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, loc := range searchQuotedPattern.FindAllStringIndex(query, -1) {
+		b.WriteString(rewrite(query[last:loc[0]]))
+		b.WriteString(query[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	b.WriteString(rewrite(query[last:]))
+	return b.String()
+}
+
+// resolveSearchMe replaces @me in the query's user qualifiers with the
+// authenticated login when the resolve_me argument is set. App installation
+// tokens have no user for @me to refer to, so they are rejected.
+func resolveSearchMe(ctx context.Context, client *github.Client, session *mcp.ServerSession, args map[string]any, query string) (string, *mcp.CallToolResult) {
+	resolveMe, err := OptionalParam[bool](args, "resolve_me")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	if !resolveMe {
+		return query, nil
+	}
+	login, resp, err := authenticatedLogin(ctx, client, session)
+	if errors.Is(err, errAppTokenHasNoUser) {
+		return "", utils.NewToolResultError("resolve_me: " + err.Error())
+	}
+	if err != nil {
+		return "", ghErrors.NewGitHubAPIErrorResponse(ctx, "resolve_me: failed to get authenticated user", resp, err)
+	}
+	return rewriteSearchMe(query, login), nil
+}
+
 // searchPostProcessFn is invoked after a successful search response, before
 // the call result is returned. It may attach additional metadata (such as IFC
 // labels) to the call result based on the search payload.
@@ -45,6 +107,7 @@ type searchPostProcessFn func(ctx context.Context, result *github.IssuesSearchRe
 
 type searchConfig struct {
 	postProcess searchPostProcessFn
+	session     *mcp.ServerSession
 }
 
 type searchOption func(*searchConfig)
@@ -55,14 +118,26 @@ func withSearchPostProcess(fn searchPostProcessFn) searchOption {
 	return func(c *searchConfig) { c.postProcess = fn }
 }
 
+// withSearchSession sets the session the authenticated login looked up for
+// resolve_me is cached on.
+func withSearchSession(req *mcp.CallToolRequest) searchOption {
+	return func(c *searchConfig) {
+		if req != nil {
+			c.session = req.Session
+		}
+	}
+}
+
 // prepareSearchArgs resolves the search query string and REST search options from the tool args,
 // applying the standard is:<type> / repo:<owner>/<repo> munging shared by search_issues and
-// search_pull_requests.
-func prepareSearchArgs(args map[string]any, searchType string) (string, *github.SearchOptions, error) {
+// search_pull_requests. Queries naming a repository the policy does not permit are rejected, and
+// no repo: qualifier is added for one. With an allow list, queries naming no repository are rejected.
+func prepareSearchArgs(args map[string]any, searchType string, policy *RepoPolicy) (string, *github.SearchOptions, error) {
 	query, err := RequiredParam[string](args, "query")
 	if err != nil {
 		return "", nil, err
 	}
+	query = rewriteSearchMe(query, "")
 
 	if !hasSpecificFilter(query, "is", searchType) {
 		query = fmt.Sprintf("is:%s %s", searchType, query)
@@ -79,8 +154,17 @@ func prepareSearchArgs(args map[string]any, searchType string) (string, *github.
 	}
 
 	if owner != "" && repo != "" && !hasRepoFilter(query) {
+		if !policy.IsRepoPermitted(owner, repo) {
+			return "", nil, repoNotPermittedError(owner, repo)
+		}
 		query = fmt.Sprintf("repo:%s/%s %s", owner, repo, query)
 	}
+	if err := policy.checkSearchQuery(query); err != nil {
+		return "", nil, err
+	}
+	if err := policy.checkScopedSearchQuery(query); err != nil {
+		return "", nil, err
+	}
 
 	sort, err := OptionalParam[string](args, "sort")
 	if err != nil {
@@ -112,9 +196,26 @@ func prepareSearchArgs(args map[string]any, searchType string) (string, *github.
 	return query, opts, nil
 }
 
+// trimSearchIssueLabels reduces each result's labels to their name and color,
+// the label shape list_issues and issue_read return.
+func trimSearchIssueLabels(result *github.IssuesSearchResult) {
+	for _, issue := range result.Issues {
+		if issue == nil {
+			continue
+		}
+		for i, label := range issue.Labels {
+			if label == nil {
+				continue
+			}
+			issue.Labels[i] = &github.Label{Name: label.Name, Color: label.Color}
+		}
+	}
+}
+
 func searchHandler(
 	ctx context.Context,
 	getClient GetClientFn,
+	policy *RepoPolicy,
 	args map[string]any,
 	searchType string,
 	errorPrefix string,
@@ -124,7 +225,7 @@ func searchHandler(
 	for _, opt := range options {
 		opt(&cfg)
 	}
-	query, opts, err := prepareSearchArgs(args, searchType)
+	query, opts, err := prepareSearchArgs(args, searchType, policy)
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil
 	}
@@ -133,6 +234,10 @@ func searchHandler(
 	if err != nil {
 		return utils.NewToolResultErrorFromErr(errorPrefix+": failed to get GitHub client", err), nil
 	}
+	query, errResult := resolveSearchMe(ctx, client, cfg.session, args, query)
+	if errResult != nil {
+		return errResult, nil
+	}
 	result, resp, err := client.Search.Issues(ctx, query, opts)
 	if err != nil {
 		return utils.NewToolResultErrorFromErr(errorPrefix, err), nil
@@ -147,6 +252,8 @@ func searchHandler(
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, errorPrefix, resp, body), nil
 	}
 
+	trimSearchIssueLabels(result)
+
 	r, err := json.Marshal(result)
 	if err != nil {
 		return utils.NewToolResultErrorFromErr(errorPrefix+": failed to marshal response", err), nil
@@ -1,9 +1,15 @@
 package github
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
 	"testing"
 
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_hasFilter(t *testing.T) {
@@ -350,3 +356,115 @@ func Test_hasTypeFilter(t *testing.T) {
 		})
 	}
 }
+
+func Test_rewriteSearchMe(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		login    string
+		expected string
+	}{
+		{
+			name:     "plain me becomes @me",
+			query:    "is:open assignee:me",
+			expected: "is:open assignee:@me",
+		},
+		{
+			name:     "multiple qualifiers",
+			query:    "author:me mentions:ME involves:@me assignee:me",
+			expected: "author:@me mentions:@me involves:@me assignee:@me",
+		},
+		{
+			name:     "negated and grouped qualifiers",
+			query:    "-author:me (assignee:me OR mentions:me)",
+			expected: "-author:@me (assignee:@me OR mentions:@me)",
+		},
+		{
+			name:     "other users and qualifiers are untouched",
+			query:    "assignee:meg author:someone label:me me",
+			expected: "assignee:meg author:someone label:me me",
+		},
+		{
+			name:     "quoted segments are untouched",
+			query:    `"assignee:me in title" author:me "mentions:me`,
+			expected: `"assignee:me in title" author:@me "mentions:me`,
+		},
+		{
+			name:     "resolves to the login",
+			query:    `assignee:@me author:me "involves:@me"`,
+			login:    "octocat",
+			expected: `assignee:octocat author:octocat "involves:@me"`,
+		},
+		{
+			name:     "whitespace is preserved",
+			query:    "  assignee:me\tis:open ",
+			expected: "  assignee:@me\tis:open ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rewriteSearchMe(tt.query, tt.login))
+		})
+	}
+}
+
+func Test_SearchPullRequests_ResolveMe(t *testing.T) {
+	var queries []string
+	deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetUser: mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("octocat"), Type: github.Ptr("User")}),
+		GetSearchIssues: func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.Query().Get("q"))
+			_ = json.NewEncoder(w).Encode(&github.IssuesSearchResult{Total: github.Ptr(0)})
+		},
+	}))}
+	serverTool := SearchPullRequests(translations.NullTranslationHelper)
+	handler := serverTool.Handler(deps)
+
+	for _, resolveMe := range []bool{false, true} {
+		request := createMCPRequest(map[string]any{"query": "review-requested:@me author:me", "resolve_me": resolveMe})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+	}
+	assert.Equal(t, []string{
+		"is:pr review-requested:@me author:@me",
+		"is:pr review-requested:@me author:octocat",
+	}, queries)
+}
+
+func Test_SearchIssues_TrimsLabels(t *testing.T) {
+	deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetSearchIssues: mockResponse(t, http.StatusOK, &github.IssuesSearchResult{
+			Total: github.Ptr(1),
+			Issues: []*github.Issue{{
+				Number: github.Ptr(42),
+				Labels: []*github.Label{{
+					ID:          github.Ptr(int64(208045946)),
+					NodeID:      github.Ptr("MDU6TGFiZWwyMDgwNDU5NDY="),
+					URL:         github.Ptr("https://api.github.com/repos/owner/repo/labels/bug"),
+					Name:        github.Ptr("bug"),
+					Color:       github.Ptr("d73a4a"),
+					Description: github.Ptr("Something isn't working"),
+					Default:     github.Ptr(true),
+				}},
+			}},
+		}),
+	}))}
+	serverTool := SearchIssues(translations.NullTranslationHelper)
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{"query": "repo:owner/repo is:open"})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response struct {
+		Items []struct {
+			Labels []map[string]any `json:"labels"`
+		} `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Len(t, response.Items, 1)
+	assert.Equal(t, []map[string]any{{"name": "bug", "color": "d73a4a"}}, response.Items[0].Labels)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/github/github-mcp-server/pkg/octicons"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -47,9 +48,34 @@ type MCPServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// DefaultPageSize overrides the default page size of list tools when the
+	// caller omits perPage. Zero keeps each tool's own default.
+	DefaultPageSize int
+
+	// MaxResponseBytes caps the serialized size of large list and search
+	// results; bigger results are replaced by a notice suggesting pagination.
+	// Zero uses DefaultMaxResponseBytes.
+	MaxResponseBytes int
+
+	// AllowedRepos and DeniedRepos restrict the repositories tools may operate
+	// on, as owner/repo patterns where either part may be a glob (e.g.
+	// octo-org/*). Empty lists permit every repository the token can reach.
+	AllowedRepos []string
+	DeniedRepos  []string
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
+	// ToolMetrics, when set, registers the get_server_metrics debug tool to
+	// report the counters it keeps. It only receives the tool call metrics
+	// when it is the metrics sink of the server's dependencies.
+	ToolMetrics *ToolMetrics
+
+	// VerboseErrors includes the path, query locations and extensions of each
+	// GraphQL error in tool error results. GraphQL clients need a
+	// transport.GraphQLErrorsTransport for the path and extensions to be known.
+	VerboseErrors bool
+
 	// InsidersMode expands to the curated set of feature flags enabled for insiders.
 	InsidersMode bool
 
@@ -97,7 +123,13 @@ func NewMCPServer(ctx context.Context, cfg *MCPServerConfig, deps ToolDependenci
 	// Add middlewares. Order matters - for example, the error context middleware should be applied last so that it runs FIRST (closest to the handler) to ensure all errors are captured,
 	// and any middleware that needs to read or modify the context should be before it.
 	ghServer.AddReceivingMiddleware(middleware...)
+	// Filled in below, once the tools are registered and before any call.
+	registeredTools := make(map[string]bool)
+	ghServer.AddReceivingMiddleware(ToolCallMetricsMiddleware(deps, registeredTools))
 	ghServer.AddReceivingMiddleware(InjectDepsMiddleware(deps))
+	if cfg.VerboseErrors {
+		ghServer.AddReceivingMiddleware(enableVerboseErrors)
+	}
 	ghServer.AddReceivingMiddleware(addGitHubAPIErrorToContext)
 
 	if unrecognized := inv.UnrecognizedToolsets(); len(unrecognized) > 0 {
@@ -106,6 +138,13 @@ func NewMCPServer(ctx context.Context, cfg *MCPServerConfig, deps ToolDependenci
 
 	// Register GitHub tools/resources/prompts from the inventory.
 	inv.RegisterAll(ctx, ghServer, deps)
+	for _, tool := range inv.ToolsForRegistration(ctx) {
+		registeredTools[tool.Tool.Name] = true
+	}
+	if cfg.ToolMetrics != nil {
+		registeredTools[ServerMetricsToolName] = true
+		RegisterServerMetricsTool(ghServer, cfg.ToolMetrics, cfg.Translator)
+	}
 
 	// Register MCP App UI resources whenever the embedded UI assets are
 	// available. The resources are static HTML and are only referenced by
@@ -147,6 +186,12 @@ func addGitHubAPIErrorToContext(next mcp.MethodHandler) mcp.MethodHandler {
 	}
 }
 
+func enableVerboseErrors(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (result mcp.Result, err error) {
+		return next(gherrors.ContextWithVerboseErrors(ctx), method, req)
+	}
+}
+
 // NewServer creates a new GitHub MCP server with the given version, server
 // name, display title, and options. If name or title are empty the defaults
 // "github-mcp-server" and "GitHub MCP Server" are used.
@@ -200,3 +245,23 @@ func MarshalledTextResult(v any) *mcp.CallToolResult {
 
 	return utils.NewToolResultText(string(data))
 }
+
+// StructuredTextResult is MarshalledTextResult for tools that declare an output
+// schema: v is returned both as JSON text and as structured content.
+func StructuredTextResult(v any) *mcp.CallToolResult {
+	result := MarshalledTextResult(v)
+	if !result.IsError {
+		result.StructuredContent = v
+	}
+	return result
+}
+
+// mustOutputSchemaFor infers a tool output schema from the Go type a handler
+// returns, so the declared shape cannot drift from the marshalled one.
+func mustOutputSchemaFor[T any]() *jsonschema.Schema {
+	schema, err := jsonschema.For[T](nil)
+	if err != nil {
+		panic(fmt.Sprintf("failed to infer output schema for %T: %v", *new(T), err))
+	}
+	return schema
+}
@@ -0,0 +1,204 @@
+package github
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/observability/metrics"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ServerMetricsToolName is the name of the debug tool that reports the
+// per-tool counters kept by ToolMetrics.
+const ServerMetricsToolName = "get_server_metrics"
+
+// Metric keys emitted for every tools/call request, tagged with the tool name
+// under MetricTagTool.
+const (
+	MetricToolCalls    = "mcp.tool.calls"
+	MetricToolErrors   = "mcp.tool.errors"
+	MetricToolDuration = "mcp.tool.duration"
+	MetricTagTool      = "tool"
+)
+
+// UnknownToolMetricsName is the tool tag that calls to unregistered tool names
+// are emitted with, so clients cannot grow the set of tag values without bound.
+const UnknownToolMetricsName = "(unknown)"
+
+// ToolMetrics is a metrics.Metrics sink that keeps in-memory invocation, error
+// and duration counters for each tool from the tool call metrics, for the
+// get_server_metrics tool to report. Other metrics are discarded. It is safe
+// for concurrent use. Counters only grow; they are reset when the process
+// restarts.
+type ToolMetrics struct {
+	store *toolMetricsStore
+	tags  map[string]string
+}
+
+var _ metrics.Metrics = (*ToolMetrics)(nil)
+
+type toolMetricsStore struct {
+	tools sync.Map // tool name -> *toolCounters
+}
+
+type toolCounters struct {
+	invocations atomic.Int64
+	errors      atomic.Int64
+	durationNs  atomic.Int64
+}
+
+// ToolMetricsSnapshot is a point-in-time copy of the counters of one tool.
+type ToolMetricsSnapshot struct {
+	Invocations     int64 `json:"invocations"`
+	Errors          int64 `json:"errors"`
+	TotalDurationMs int64 `json:"total_duration_ms"`
+}
+
+// NewToolMetrics returns an empty ToolMetrics.
+func NewToolMetrics() *ToolMetrics {
+	return &ToolMetrics{store: &toolMetricsStore{}}
+}
+
+// counters returns the counters of the tool named by the tool tag, or nil when
+// key is not a tool call metric.
+func (m *ToolMetrics) counters(key string, tags map[string]string) *toolCounters {
+	switch key {
+	case MetricToolCalls, MetricToolErrors, MetricToolDuration:
+	default:
+		return nil
+	}
+	name, ok := tags[MetricTagTool]
+	if !ok {
+		name, ok = m.tags[MetricTagTool]
+	}
+	if !ok {
+		return nil
+	}
+	c, ok := m.store.tools.Load(name)
+	if !ok {
+		c, _ = m.store.tools.LoadOrStore(name, &toolCounters{})
+	}
+	return c.(*toolCounters)
+}
+
+// Increment implements metrics.Metrics.
+func (m *ToolMetrics) Increment(key string, tags map[string]string) {
+	m.Counter(key, tags, 1)
+}
+
+// Counter implements metrics.Metrics.
+func (m *ToolMetrics) Counter(key string, tags map[string]string, value int64) {
+	c := m.counters(key, tags)
+	if c == nil {
+		return
+	}
+	switch key {
+	case MetricToolCalls:
+		c.invocations.Add(value)
+	case MetricToolErrors:
+		c.errors.Add(value)
+	}
+}
+
+// Distribution implements metrics.Metrics. Tool call durations are reported
+// with DistributionMs, so plain distributions are discarded.
+func (m *ToolMetrics) Distribution(_ string, _ map[string]string, _ float64) {}
+
+// DistributionMs implements metrics.Metrics.
+func (m *ToolMetrics) DistributionMs(key string, tags map[string]string, value time.Duration) {
+	if key != MetricToolDuration {
+		return
+	}
+	if c := m.counters(key, tags); c != nil {
+		c.durationNs.Add(int64(value))
+	}
+}
+
+// WithTags implements metrics.Metrics. The returned sink shares m's counters.
+func (m *ToolMetrics) WithTags(tags map[string]string) metrics.Metrics {
+	merged := maps.Clone(m.tags)
+	if merged == nil {
+		merged = make(map[string]string, len(tags))
+	}
+	maps.Copy(merged, tags)
+	return &ToolMetrics{store: m.store, tags: merged}
+}
+
+// Snapshot returns the current counters keyed by tool name.
+func (m *ToolMetrics) Snapshot() map[string]ToolMetricsSnapshot {
+	snapshot := make(map[string]ToolMetricsSnapshot)
+	m.store.tools.Range(func(key, value any) bool {
+		c := value.(*toolCounters)
+		snapshot[key.(string)] = ToolMetricsSnapshot{
+			Invocations:     c.invocations.Load(),
+			Errors:          c.errors.Load(),
+			TotalDurationMs: time.Duration(c.durationNs.Load()).Milliseconds(),
+		}
+		return true
+	})
+	return snapshot
+}
+
+// ToolCallMetricsMiddleware returns receiving middleware that times every
+// tools/call request and emits MetricToolCalls, MetricToolErrors and
+// MetricToolDuration through deps.Metrics. Calls are tagged with the tool name
+// when it is in registered, and with UnknownToolMetricsName otherwise. A call
+// counts as an error when the handler fails or returns a result marked IsError.
+func ToolCallMetricsMiddleware(deps ToolDependencies, registered map[string]bool) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			callReq, ok := req.(*mcp.CallToolRequest)
+			if method != "tools/call" || !ok || callReq.Params == nil {
+				return next(ctx, method, req)
+			}
+
+			start := time.Now()
+			result, err := next(ctx, method, req)
+			failed := err != nil
+			if callResult, ok := result.(*mcp.CallToolResult); ok && callResult != nil && callResult.IsError {
+				failed = true
+			}
+
+			name := callReq.Params.Name
+			if !registered[name] {
+				name = UnknownToolMetricsName
+			}
+			tags := map[string]string{MetricTagTool: name}
+			m := deps.Metrics(ctx)
+			m.Increment(MetricToolCalls, tags)
+			if failed {
+				m.Increment(MetricToolErrors, tags)
+			}
+			m.DistributionMs(MetricToolDuration, tags, time.Since(start))
+			return result, err
+		}
+	}
+}
+
+// RegisterServerMetricsTool adds the get_server_metrics debug tool, which
+// dumps the counters of m as JSON. It is registered outside the inventory so
+// that it is only ever available when the server is configured with
+// ToolMetrics.
+func RegisterServerMetricsTool(s *mcp.Server, m *ToolMetrics, t translations.TranslationHelperFunc) {
+	s.AddTool(&mcp.Tool{
+		Name:        ServerMetricsToolName,
+		Description: t("TOOL_GET_SERVER_METRICS_DESCRIPTION", "Debug tool: report per-tool invocation, error and total duration counters since the server process started."),
+		Annotations: &mcp.ToolAnnotations{
+			Title:        t("TOOL_GET_SERVER_METRICS_USER_TITLE", "Get server metrics"),
+			ReadOnlyHint: true,
+		},
+		InputSchema: &jsonschema.Schema{
+			Type:       "object",
+			Properties: map[string]*jsonschema.Schema{},
+		},
+	}, func(_ context.Context, _ *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return MarshalledTextResult(map[string]any{
+			"tools": m.Snapshot(),
+		}), nil
+	})
+}
@@ -0,0 +1,126 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/observability"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolCallMetricsMiddleware(t *testing.T) {
+	metrics := NewToolMetrics()
+	obsv, err := observability.NewExporters(slog.New(slog.DiscardHandler), metrics)
+	require.NoError(t, err)
+	srv := NewServer("test", "", "", nil)
+	registered := map[string]bool{"ok_tool": true, "failing_tool": true, ServerMetricsToolName: true}
+	srv.AddReceivingMiddleware(ToolCallMetricsMiddleware(BaseDeps{Obsv: obsv}, registered))
+
+	schema := &jsonschema.Schema{Type: "object"}
+	srv.AddTool(&mcp.Tool{Name: "ok_tool", InputSchema: schema}, func(_ context.Context, _ *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		time.Sleep(5 * time.Millisecond)
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "ok"}}}, nil
+	})
+	srv.AddTool(&mcp.Tool{Name: "failing_tool", InputSchema: schema}, func(_ context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if string(req.Params.Arguments) == `{"hard":true}` {
+			return nil, errors.New("boom")
+		}
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "bad input"}}}, nil
+	})
+	RegisterServerMetricsTool(srv, metrics, translations.NullTranslationHelper)
+
+	st, ct := mcp.NewInMemoryTransports()
+	ss, err := srv.Connect(context.Background(), st, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ss.Close() })
+	cs, err := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil).Connect(context.Background(), ct, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cs.Close() })
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cs.CallTool(context.Background(), &mcp.CallToolParams{Name: "ok_tool", Arguments: map[string]any{}})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	_, err = cs.CallTool(context.Background(), &mcp.CallToolParams{Name: "failing_tool", Arguments: map[string]any{}})
+	require.NoError(t, err)
+	_, err = cs.CallTool(context.Background(), &mcp.CallToolParams{Name: "failing_tool", Arguments: map[string]any{"hard": true}})
+	require.ErrorContains(t, err, "boom")
+
+	for _, name := range []string{"no_such_tool", "another_missing_tool"} {
+		_, err = cs.CallTool(context.Background(), &mcp.CallToolParams{Name: name, Arguments: map[string]any{}})
+		require.Error(t, err)
+	}
+
+	result, err := cs.CallTool(context.Background(), &mcp.CallToolParams{Name: ServerMetricsToolName, Arguments: map[string]any{}})
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var got struct {
+		Tools map[string]ToolMetricsSnapshot `json:"tools"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+
+	okTool := got.Tools["ok_tool"]
+	assert.Equal(t, int64(3), okTool.Invocations)
+	assert.Zero(t, okTool.Errors)
+	assert.GreaterOrEqual(t, okTool.TotalDurationMs, int64(15))
+
+	failingTool := got.Tools["failing_tool"]
+	assert.Equal(t, int64(2), failingTool.Invocations)
+	assert.Equal(t, int64(2), failingTool.Errors)
+
+	// Calls to unregistered names share one bucket instead of adding a key each.
+	assert.Equal(t, int64(2), got.Tools[UnknownToolMetricsName].Invocations)
+	assert.NotContains(t, got.Tools, "no_such_tool")
+	assert.NotContains(t, got.Tools, "another_missing_tool")
+
+	// The snapshot is taken inside the metrics tool call, before it is recorded.
+	assert.NotContains(t, got.Tools, ServerMetricsToolName)
+	assert.Equal(t, int64(1), metrics.Snapshot()[ServerMetricsToolName].Invocations)
+}
+
+func TestToolMetrics_Concurrent(t *testing.T) {
+	metrics := NewToolMetrics()
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tags := map[string]string{MetricTagTool: "tool"}
+			metrics.Increment(MetricToolCalls, tags)
+			if i%5 == 0 {
+				metrics.Increment(MetricToolErrors, tags)
+			}
+			metrics.DistributionMs(MetricToolDuration, tags, time.Millisecond)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, ToolMetricsSnapshot{Invocations: 50, Errors: 10, TotalDurationMs: 50}, metrics.Snapshot()["tool"])
+}
+
+func TestToolMetrics_IgnoresOtherMetrics(t *testing.T) {
+	metrics := NewToolMetrics()
+	metrics.Increment("some.other.metric", map[string]string{MetricTagTool: "tool"})
+	metrics.Increment(MetricToolCalls, nil)
+	assert.Empty(t, metrics.Snapshot())
+
+	tagged := metrics.WithTags(map[string]string{MetricTagTool: "tool"})
+	tagged.Increment(MetricToolCalls, nil)
+	assert.Equal(t, int64(1), metrics.Snapshot()["tool"].Invocations)
+}
@@ -34,6 +34,8 @@ type stubDeps struct {
 	t                 translations.TranslationHelperFunc
 	flags             FeatureFlags
 	contentWindowSize int
+	defaultPageSize   int
+	maxResponseBytes  int
 	obsv              observability.Exporters
 }
 
@@ -64,6 +66,9 @@ func (s stubDeps) GetRepoAccessCache(_ context.Context) (*lockdown.RepoAccessCac
 func (s stubDeps) GetT() translations.TranslationHelperFunc          { return s.t }
 func (s stubDeps) GetFlags(_ context.Context) FeatureFlags           { return s.flags }
 func (s stubDeps) GetContentWindowSize() int                         { return s.contentWindowSize }
+func (s stubDeps) GetDefaultPageSize() int                           { return s.defaultPageSize }
+func (s stubDeps) GetMaxResponseBytes() int                          { return s.maxResponseBytes }
+func (s stubDeps) GetRepoPolicy() *RepoPolicy                        { return nil }
 func (s stubDeps) IsFeatureEnabled(_ context.Context, _ string) bool { return false }
 func (s stubDeps) Logger(_ context.Context) *slog.Logger {
 	return s.obsv.Logger()
@@ -0,0 +1,51 @@
+package github
+
+import (
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/muesli/cache2go"
+)
+
+// sessionCache keeps values looked up from the API per MCP session for a
+// fixed time, so repeated calls in one session reuse them. Without a session,
+// as in stateless HTTP mode, lookups miss and nothing is stored.
+type sessionCache[V any] struct {
+	table *cache2go.CacheTable
+	ttl   time.Duration
+}
+
+// sessionCacheKey scopes a key to the session it was cached for.
+type sessionCacheKey struct {
+	session *mcp.ServerSession
+	key     string
+}
+
+// newSessionCache returns a cache backed by the cache2go table name whose
+// entries expire after ttl.
+func newSessionCache[V any](name string, ttl time.Duration) *sessionCache[V] {
+	return &sessionCache[V]{table: cache2go.Cache(name), ttl: ttl}
+}
+
+// get returns the value cached for key in session. Keys are not case
+// sensitive, like the GitHub logins and names they are built from.
+func (c *sessionCache[V]) get(session *mcp.ServerSession, key string) (V, bool) {
+	var zero V
+	if session == nil {
+		return zero, false
+	}
+	item, err := c.table.Value(sessionCacheKey{session: session, key: strings.ToLower(key)})
+	if err != nil {
+		return zero, false
+	}
+	return item.Data().(V), true
+}
+
+// set caches value for key in session.
+func (c *sessionCache[V]) set(session *mcp.ServerSession, key string, value V) {
+	if session == nil {
+		return
+	}
+	c.table.Add(sessionCacheKey{session: session, key: strings.ToLower(key)}, c.ttl, value)
+}
@@ -0,0 +1,27 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionCache(t *testing.T) {
+	cache := newSessionCache[int]("session-cache-test", time.Minute)
+	session, other := &mcp.ServerSession{}, &mcp.ServerSession{}
+
+	cache.set(session, "Octo-Org", 1)
+
+	value, ok := cache.get(session, "octo-org")
+	assert.True(t, ok, "keys should not be case sensitive")
+	assert.Equal(t, 1, value)
+
+	_, ok = cache.get(other, "octo-org")
+	assert.False(t, ok, "values should not be shared between sessions")
+
+	cache.set(nil, "octo-org", 2)
+	_, ok = cache.get(nil, "octo-org")
+	assert.False(t, ok, "calls without a session should not be cached")
+}
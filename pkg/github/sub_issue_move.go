@@ -0,0 +1,128 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// SubIssueParentRef identifies a parent issue in a move_sub_issue response.
+type SubIssueParentRef struct {
+	Number int    `json:"number"`
+	URL    string `json:"url,omitempty"`
+}
+
+// SubIssueMoveResult is the output of move_sub_issue. OldParent is nil when
+// the sub-issue had no parent or its parent could not be determined, which
+// is the case when the sub-issue is given by ID only.
+type SubIssueMoveResult struct {
+	SubIssueID     int64              `json:"sub_issue_id"`
+	SubIssueNumber int                `json:"sub_issue_number,omitempty"`
+	OldParent      *SubIssueParentRef `json:"old_parent"`
+	NewParent      SubIssueParentRef  `json:"new_parent"`
+}
+
+type subIssueParentQuery struct {
+	Repository struct {
+		Issue struct {
+			Parent *struct {
+				Number     githubv4.Int
+				URL        githubv4.String
+				Repository struct {
+					NameWithOwner githubv4.String
+				}
+			}
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// MoveSubIssueToParent re-parents a sub-issue with a single add call that
+// replaces its current parent, so the sub-issue is never left without one.
+// When the sub-issue is given by number, its ID is resolved and its current
+// parent is looked up first; the move is refused if that parent is in another
+// repository, is already newParent, or differs from currentParent when one is
+// given. A sub-issue given by ID is moved without these checks.
+// currentParent, subIssueID and subIssueNumber are zero when not
+// provided; exactly one of subIssueID and subIssueNumber must be set.
+func MoveSubIssueToParent(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner, repo string, subIssueID, subIssueNumber, currentParent, newParent int) (*mcp.CallToolResult, error) {
+	if (subIssueID == 0) == (subIssueNumber == 0) {
+		return utils.NewToolResultError("provide exactly one of sub_issue_id and sub_issue_number"), nil
+	}
+	if subIssueNumber != 0 && subIssueNumber == newParent {
+		return utils.NewToolResultError("an issue cannot be its own parent"), nil
+	}
+	if currentParent != 0 && currentParent == newParent {
+		return utils.NewToolResultError("new_parent must differ from the current parent"), nil
+	}
+
+	result := SubIssueMoveResult{SubIssueID: int64(subIssueID), SubIssueNumber: subIssueNumber}
+	if subIssueNumber != 0 {
+		issue, resp, err := client.Issues.Get(ctx, owner, repo, subIssueNumber)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get sub-issue #%d", subIssueNumber), resp, err), nil
+		}
+		_ = resp.Body.Close()
+		if issue.IsPullRequest() {
+			return utils.NewToolResultError(fmt.Sprintf("#%d is a pull request; only issues can be sub-issues", subIssueNumber)), nil
+		}
+		result.SubIssueID = issue.GetID()
+
+		var query subIssueParentQuery
+		vars := map[string]any{
+			"owner":       githubv4.String(owner),
+			"repo":        githubv4.String(repo),
+			"issueNumber": githubv4.Int(subIssueNumber), // #nosec G115 - issue numbers are always small positive integers
+		}
+		if err := gqlClient.Query(ctx, &query, vars); err != nil {
+			return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to get the parent of #%d", subIssueNumber), err), nil
+		}
+
+		if parent := query.Repository.Issue.Parent; parent != nil {
+			parentNumber := int(parent.Number)
+			if nameWithOwner := string(parent.Repository.NameWithOwner); !strings.EqualFold(nameWithOwner, owner+"/"+repo) {
+				return utils.NewToolResultError(fmt.Sprintf("the parent of #%d is %s#%d, in a different repository; move_sub_issue only moves sub-issues between parents in %s/%s", subIssueNumber, nameWithOwner, parentNumber, owner, repo)), nil
+			}
+			if parentNumber == newParent {
+				return utils.NewToolResultError(fmt.Sprintf("#%d is already a sub-issue of #%d", subIssueNumber, newParent)), nil
+			}
+			if currentParent != 0 && parentNumber != currentParent {
+				return utils.NewToolResultError(fmt.Sprintf("#%d is a sub-issue of #%d, not #%d; nothing was changed", subIssueNumber, parentNumber, currentParent)), nil
+			}
+			result.OldParent = &SubIssueParentRef{Number: parentNumber, URL: string(parent.URL)}
+		} else if currentParent != 0 {
+			return utils.NewToolResultError(fmt.Sprintf("#%d has no parent, not #%d; nothing was changed", subIssueNumber, currentParent)), nil
+		}
+	}
+
+	if err := validateSubIssueIDs(int(result.SubIssueID), 0, 0); err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+
+	parent, resp, err := client.SubIssue.Add(ctx, owner, repo, int64(newParent), github.SubIssueRequest{
+		SubIssueID:    result.SubIssueID,
+		ReplaceParent: github.Ptr(true),
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to move sub-issue to #%d; nothing was changed", newParent), resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, fmt.Sprintf("failed to move sub-issue to #%d; nothing was changed", newParent), resp, body), nil
+	}
+
+	result.NewParent = SubIssueParentRef{Number: newParent, URL: (*github.Issue)(parent).GetHTMLURL()}
+	return MarshalledTextResult(result), nil
+}
@@ -0,0 +1,151 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GranularMoveSubIssue(t *testing.T) {
+	serverTool := GranularMoveSubIssue(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "move_sub_issue", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "new_parent"})
+
+	vars := map[string]any{
+		"owner":       githubv4.String("owner"),
+		"repo":        githubv4.String("repo"),
+		"issueNumber": githubv4.Int(5),
+	}
+	parentResponse := func(parent any) githubv4mock.Matcher {
+		return githubv4mock.NewQueryMatcher(subIssueParentQuery{}, vars, githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{"issue": map[string]any{"parent": parent}},
+		}))
+	}
+	parent := func(number int, nameWithOwner string) map[string]any {
+		return map[string]any{
+			"number":     number,
+			"url":        fmt.Sprintf("https://github.com/%s/issues/%d", nameWithOwner, number),
+			"repository": map[string]any{"nameWithOwner": nameWithOwner},
+		}
+	}
+	getSubIssue := mockResponse(t, http.StatusOK, &github.Issue{ID: github.Ptr(int64(555)), Number: github.Ptr(5)})
+	addToNewParent := expectPath(t, "/repos/owner/repo/issues/9/sub_issues").andThen(
+		expectRequestBody(t, map[string]any{"sub_issue_id": float64(555), "replace_parent": true}).andThen(
+			mockResponse(t, http.StatusCreated, &github.Issue{Number: github.Ptr(9), HTMLURL: github.Ptr("https://github.com/owner/repo/issues/9")}),
+		),
+	)
+
+	tests := []struct {
+		name           string
+		args           map[string]any
+		restHandlers   map[string]http.HandlerFunc
+		gqlMatchers    []githubv4mock.Matcher
+		expectedResult *SubIssueMoveResult
+		expectedErrMsg string
+	}{
+		{
+			name: "moves a sub-issue given by number and reports both parents",
+			args: map[string]any{"owner": "owner", "repo": "repo", "sub_issue_number": float64(5), "new_parent": float64(9)},
+			restHandlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber:           getSubIssue,
+				PostReposIssuesSubIssuesByOwnerByRepoByIssueNumber: addToNewParent,
+			},
+			gqlMatchers: []githubv4mock.Matcher{parentResponse(parent(3, "owner/repo"))},
+			expectedResult: &SubIssueMoveResult{
+				SubIssueID:     555,
+				SubIssueNumber: 5,
+				OldParent:      &SubIssueParentRef{Number: 3, URL: "https://github.com/owner/repo/issues/3"},
+				NewParent:      SubIssueParentRef{Number: 9, URL: "https://github.com/owner/repo/issues/9"},
+			},
+		},
+		{
+			name: "sub-issue given by ID is moved without a parent lookup",
+			args: map[string]any{"owner": "owner", "repo": "repo", "sub_issue_id": float64(555), "new_parent": float64(9)},
+			restHandlers: map[string]http.HandlerFunc{
+				PostReposIssuesSubIssuesByOwnerByRepoByIssueNumber: addToNewParent,
+			},
+			expectedResult: &SubIssueMoveResult{
+				SubIssueID: 555,
+				NewParent:  SubIssueParentRef{Number: 9, URL: "https://github.com/owner/repo/issues/9"},
+			},
+		},
+		{
+			name: "parent in another repository",
+			args: map[string]any{"owner": "owner", "repo": "repo", "sub_issue_number": float64(5), "new_parent": float64(9)},
+			restHandlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: getSubIssue,
+			},
+			gqlMatchers:    []githubv4mock.Matcher{parentResponse(parent(3, "other/tracker"))},
+			expectedErrMsg: "the parent of #5 is other/tracker#3, in a different repository",
+		},
+		{
+			name: "current parent does not match",
+			args: map[string]any{"owner": "owner", "repo": "repo", "sub_issue_number": float64(5), "current_parent": float64(4), "new_parent": float64(9)},
+			restHandlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: getSubIssue,
+			},
+			gqlMatchers:    []githubv4mock.Matcher{parentResponse(parent(3, "owner/repo"))},
+			expectedErrMsg: "#5 is a sub-issue of #3, not #4; nothing was changed",
+		},
+		{
+			name: "already under the new parent",
+			args: map[string]any{"owner": "owner", "repo": "repo", "sub_issue_number": float64(5), "new_parent": float64(3)},
+			restHandlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: getSubIssue,
+			},
+			gqlMatchers:    []githubv4mock.Matcher{parentResponse(parent(3, "owner/repo"))},
+			expectedErrMsg: "#5 is already a sub-issue of #3",
+		},
+		{
+			name:           "both ID and number",
+			args:           map[string]any{"owner": "owner", "repo": "repo", "sub_issue_id": float64(555), "sub_issue_number": float64(5), "new_parent": float64(9)},
+			expectedErrMsg: "provide exactly one of sub_issue_id and sub_issue_number",
+		},
+		{
+			name: "add fails",
+			args: map[string]any{"owner": "owner", "repo": "repo", "sub_issue_id": float64(555), "new_parent": float64(9)},
+			restHandlers: map[string]http.HandlerFunc{
+				PostReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusForbidden, `{"message": "Must have write access to repository"}`),
+			},
+			expectedErrMsg: "failed to move sub-issue to #9; nothing was changed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(tc.restHandlers)),
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(tc.gqlMatchers...)),
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.args)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response SubIssueMoveResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, *tc.expectedResult, response)
+		})
+	}
+}
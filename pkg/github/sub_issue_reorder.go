@@ -0,0 +1,291 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ReorderedSubIssue is an entry of the final order returned by
+// reorder_sub_issues.
+type ReorderedSubIssue struct {
+	ID     int64  `json:"id"`
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+}
+
+// subIssueMove is a single reprioritize call planned by reorder_sub_issues.
+// Exactly one of afterID and beforeID is set.
+type subIssueMove struct {
+	subIssueID int64
+	afterID    int64
+	beforeID   int64
+}
+
+// ReorderSubIssues creates a tool that puts all sub-issues of a parent issue
+// into a given order with as few reprioritize calls as possible.
+func ReorderSubIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "reorder_sub_issues",
+			Description: t("TOOL_REORDER_SUB_ISSUES_DESCRIPTION", "Reorder all sub-issues of a parent issue in one call. Pass every sub-issue ID in the desired final order; only sub-issues that are out of place are moved. Nothing is changed if the IDs do not exactly match the current sub-issues."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_REORDER_SUB_ISSUES_USER_TITLE", "Reorder sub-issues"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the parent issue",
+					},
+					"sub_issue_ids": {
+						Type:        "array",
+						Description: "IDs of all sub-issues in the desired order. IDs are not the same as issue numbers",
+						Items: &jsonschema.Schema{
+							Type: "number",
+						},
+						MinItems: jsonschema.Ptr(1),
+						MaxItems: jsonschema.Ptr(MaxEpicProgressChildren),
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number", "sub_issue_ids"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredPositiveInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ids, err := OptionalIntArrayParam(args, "sub_issue_ids")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if len(ids) == 0 {
+				return utils.NewToolResultError("missing required parameter: sub_issue_ids"), nil, nil
+			}
+			desired := make([]int64, len(ids))
+			for i, id := range ids {
+				desired[i] = int64(id)
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			subIssues, truncated, result, err := listAllSubIssues(ctx, client, owner, repo, issueNumber)
+			if result != nil || err != nil {
+				return result, nil, err
+			}
+			if truncated {
+				return utils.NewToolResultError(fmt.Sprintf("issue #%d has more than %d sub-issues, which is more than reorder_sub_issues supports", issueNumber, MaxEpicProgressChildren)), nil, nil
+			}
+
+			current := make([]int64, len(subIssues))
+			byID := make(map[int64]*github.SubIssue, len(subIssues))
+			for i, sub := range subIssues {
+				current[i] = (*github.Issue)(sub).GetID()
+				byID[current[i]] = sub
+			}
+			if err := validateSubIssueOrder(current, desired); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			moves := planSubIssueMoves(current, desired)
+			for i, move := range moves {
+				message := "failed to reprioritize sub-issue"
+				if i > 0 {
+					message = fmt.Sprintf("failed to reprioritize sub-issue %d after %d of %d moves were applied, so the sub-issues are only partially reordered", move.subIssueID, i, len(moves))
+				}
+				if result, err := reprioritizeSubIssueForReorder(ctx, client, owner, repo, issueNumber, move, message); result != nil || err != nil {
+					return result, nil, err
+				}
+			}
+
+			order := make([]ReorderedSubIssue, len(desired))
+			for i, id := range desired {
+				sub := (*github.Issue)(byID[id])
+				order[i] = ReorderedSubIssue{
+					ID:     id,
+					Number: sub.GetNumber(),
+					Title:  sanitize.Sanitize(sub.GetTitle()),
+				}
+			}
+			return MarshalledTextResult(map[string]any{
+				"issue_number": issueNumber,
+				"order":        order,
+				"moves":        len(moves),
+			}), nil, nil
+		})
+}
+
+// validateSubIssueOrder checks that desired is a permutation of current and
+// describes the missing, extra and duplicate IDs if it is not.
+func validateSubIssueOrder(current, desired []int64) error {
+	remaining := make(map[int64]bool, len(current))
+	for _, id := range current {
+		remaining[id] = true
+	}
+	seen := make(map[int64]bool, len(desired))
+	var extra, duplicate []string
+	for _, id := range desired {
+		switch {
+		case seen[id]:
+			duplicate = append(duplicate, fmt.Sprint(id))
+		case !remaining[id]:
+			extra = append(extra, fmt.Sprint(id))
+		}
+		seen[id] = true
+		delete(remaining, id)
+	}
+	var missing []string
+	for _, id := range current {
+		if remaining[id] {
+			missing = append(missing, fmt.Sprint(id))
+		}
+	}
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, "missing sub-issue IDs: "+strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		problems = append(problems, "IDs that are not sub-issues of this issue: "+strings.Join(extra, ", "))
+	}
+	if len(duplicate) > 0 {
+		problems = append(problems, "duplicate IDs: "+strings.Join(duplicate, ", "))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("sub_issue_ids must list every current sub-issue exactly once; %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// planSubIssueMoves returns the reprioritize calls that turn current into
+// desired, which must be a permutation of it. The sub-issues forming the
+// longest run already in the desired relative order stay put; each other one
+// is placed right after its predecessor in desired, or at the top if it
+// comes first. Applying the moves in order yields desired.
+func planSubIssueMoves(current, desired []int64) []subIssueMove {
+	rank := make(map[int64]int, len(desired))
+	for i, id := range desired {
+		rank[id] = i
+	}
+	ranks := make([]int, len(current))
+	for i, id := range current {
+		ranks[i] = rank[id]
+	}
+	keep := make(map[int64]bool, len(current))
+	for _, i := range longestIncreasingSubsequence(ranks) {
+		keep[current[i]] = true
+	}
+
+	order := slices.Clone(current)
+	var moves []subIssueMove
+	for i, id := range desired {
+		if keep[id] {
+			continue
+		}
+		move := subIssueMove{subIssueID: id}
+		from := slices.Index(order, id)
+		order = slices.Delete(order, from, from+1)
+		if i == 0 {
+			move.beforeID = order[0]
+			order = slices.Insert(order, 0, id)
+		} else {
+			move.afterID = desired[i-1]
+			order = slices.Insert(order, slices.Index(order, desired[i-1])+1, id)
+		}
+		moves = append(moves, move)
+	}
+	return moves
+}
+
+// longestIncreasingSubsequence returns the indices of a longest strictly
+// increasing subsequence of values.
+func longestIncreasingSubsequence(values []int) []int {
+	if len(values) == 0 {
+		return nil
+	}
+	// tails[k] is the index of the smallest tail of an increasing run of length k+1.
+	var tails []int
+	prev := make([]int, len(values))
+	for i, v := range values {
+		k, _ := slices.BinarySearchFunc(tails, v, func(idx, target int) int {
+			return values[idx] - target
+		})
+		if k > 0 {
+			prev[i] = tails[k-1]
+		} else {
+			prev[i] = -1
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	result := make([]int, len(tails))
+	for i, k := len(tails)-1, tails[len(tails)-1]; i >= 0; i, k = i-1, prev[k] {
+		result[i] = k
+	}
+	return result
+}
+
+func reprioritizeSubIssueForReorder(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, move subIssueMove, message string) (*mcp.CallToolResult, error) {
+	request := github.SubIssueRequest{SubIssueID: move.subIssueID}
+	if move.afterID != 0 {
+		request.AfterID = github.Ptr(move.afterID)
+	} else {
+		request.BeforeID = github.Ptr(move.beforeID)
+	}
+
+	_, resp, err := client.SubIssue.Reprioritize(ctx, owner, repo, int64(issueNumber), request)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, message, resp, body), nil
+	}
+	return nil, nil
+}
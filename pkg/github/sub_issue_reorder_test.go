@@ -0,0 +1,166 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// subIssueOrderServer serves the sub-issue list and reprioritize endpoints
+// from an in-memory order that reprioritize calls update.
+type subIssueOrderServer struct {
+	order []int64
+	moves int
+}
+
+func (s *subIssueOrderServer) handlers(t *testing.T) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, _ *http.Request) {
+			subIssues := make([]*github.SubIssue, len(s.order))
+			for i, id := range s.order {
+				subIssues[i] = &github.SubIssue{ID: github.Ptr(id), Number: github.Ptr(int(id) / 100), Title: github.Ptr("Task")}
+			}
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(subIssues)
+		},
+		PatchReposIssuesSubIssuesPriorityByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+			var req github.SubIssueRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			s.order = applySubIssueMove(s.order, subIssueMove{subIssueID: req.SubIssueID, afterID: req.GetAfterID(), beforeID: req.GetBeforeID()})
+			s.moves++
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(&github.SubIssue{ID: github.Ptr(req.SubIssueID)})
+		},
+	}
+}
+
+func applySubIssueMove(order []int64, move subIssueMove) []int64 {
+	from := slices.Index(order, move.subIssueID)
+	order = slices.Delete(order, from, from+1)
+	if move.afterID != 0 {
+		return slices.Insert(order, slices.Index(order, move.afterID)+1, move.subIssueID)
+	}
+	return slices.Insert(order, slices.Index(order, move.beforeID), move.subIssueID)
+}
+
+func Test_ReorderSubIssues(t *testing.T) {
+	serverTool := ReorderSubIssues(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "reorder_sub_issues", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number", "sub_issue_ids"})
+
+	server := &subIssueOrderServer{order: []int64{100, 200, 300, 400, 500}}
+	deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(server.handlers(t)))}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":         "owner",
+		"repo":          "repo",
+		"issue_number":  float64(1),
+		"sub_issue_ids": []any{float64(500), float64(100), float64(200), float64(400), float64(300)},
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response struct {
+		Order []ReorderedSubIssue `json:"order"`
+		Moves int                 `json:"moves"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	// 100, 200 and 300 (or 400) are already in order, so only two moves are needed.
+	assert.Equal(t, 2, response.Moves)
+	assert.Equal(t, 2, server.moves)
+	assert.Equal(t, []int64{500, 100, 200, 400, 300}, server.order)
+	require.Len(t, response.Order, 5)
+	assert.Equal(t, ReorderedSubIssue{ID: 500, Number: 5, Title: "Task"}, response.Order[0])
+}
+
+func Test_ReorderSubIssues_Validation(t *testing.T) {
+	tests := []struct {
+		name        string
+		ids         []any
+		expectedErr string
+	}{
+		{
+			name:        "missing and extra IDs",
+			ids:         []any{float64(300), float64(100), float64(999)},
+			expectedErr: "sub_issue_ids must list every current sub-issue exactly once; missing sub-issue IDs: 200; IDs that are not sub-issues of this issue: 999",
+		},
+		{
+			name:        "duplicate ID",
+			ids:         []any{float64(300), float64(100), float64(200), float64(100)},
+			expectedErr: "sub_issue_ids must list every current sub-issue exactly once; duplicate IDs: 100",
+		},
+		{
+			name:        "empty list",
+			ids:         []any{},
+			expectedErr: "missing required parameter: sub_issue_ids",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &subIssueOrderServer{order: []int64{100, 200, 300}}
+			deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(server.handlers(t)))}
+			serverTool := ReorderSubIssues(translations.NullTranslationHelper)
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_number":  float64(1),
+				"sub_issue_ids": tc.ids,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+			assert.Equal(t, tc.expectedErr, getErrorResult(t, result).Text)
+			assert.Zero(t, server.moves)
+			assert.Equal(t, []int64{100, 200, 300}, server.order)
+		})
+	}
+}
+
+func Test_PlanSubIssueMoves(t *testing.T) {
+	assert.Empty(t, planSubIssueMoves([]int64{1, 2, 3}, []int64{1, 2, 3}))
+	assert.Equal(t, []subIssueMove{{subIssueID: 3, beforeID: 1}}, planSubIssueMoves([]int64{1, 2, 3}, []int64{3, 1, 2}))
+	assert.Equal(t, []subIssueMove{{subIssueID: 1, afterID: 3}}, planSubIssueMoves([]int64{1, 2, 3}, []int64{2, 3, 1}))
+
+	rng := rand.New(rand.NewSource(1))
+	for range 200 {
+		n := 1 + rng.Intn(15)
+		current := make([]int64, n)
+		for i := range current {
+			current[i] = int64(i + 1)
+		}
+		desired := slices.Clone(current)
+		rng.Shuffle(n, func(i, j int) { desired[i], desired[j] = desired[j], desired[i] })
+
+		ranks := make([]int, n)
+		for i, id := range current {
+			ranks[i] = slices.Index(desired, id)
+		}
+		moves := planSubIssueMoves(current, desired)
+		assert.Len(t, moves, n-len(longestIncreasingSubsequence(ranks)))
+
+		order := slices.Clone(current)
+		for _, move := range moves {
+			order = applySubIssueMove(order, move)
+		}
+		assert.Equal(t, desired, order)
+	}
+}
@@ -0,0 +1,206 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// MaxSubIssuesPerPage caps perPage for get_sub_issues over GraphQL. Pages
+// larger than a single GraphQL connection page are read in several requests.
+const MaxSubIssuesPerPage = 500
+
+// MinimalSubIssue is the trimmed output type for sub-issues listed over GraphQL.
+// ID is the REST id that sub_issue_write expects.
+type MinimalSubIssue struct {
+	ID          int64        `json:"id,omitempty"`
+	Number      int          `json:"number"`
+	Title       string       `json:"title"`
+	State       string       `json:"state"`
+	StateReason string       `json:"state_reason,omitempty"`
+	HTMLURL     string       `json:"html_url"`
+	Repository  string       `json:"repository"`
+	User        *MinimalUser `json:"user,omitempty"`
+	Labels      []string     `json:"labels,omitempty"`
+	Assignees   []string     `json:"assignees,omitempty"`
+	CreatedAt   string       `json:"created_at,omitempty"`
+	UpdatedAt   string       `json:"updated_at,omitempty"`
+	ClosedAt    string       `json:"closed_at,omitempty"`
+	IssueRef    IssueRef     `json:"issue_ref"`
+}
+
+// MinimalSubIssuesResponse is the output of get_sub_issues over GraphQL.
+type MinimalSubIssuesResponse struct {
+	SubIssues  []MinimalSubIssue `json:"sub_issues"`
+	TotalCount int               `json:"totalCount"`
+	PageInfo   MinimalPageInfo   `json:"pageInfo"`
+}
+
+type subIssueNode struct {
+	ID             githubv4.ID
+	FullDatabaseID githubv4.String `graphql:"fullDatabaseId"`
+	Number         githubv4.Int
+	Title          githubv4.String
+	State          githubv4.String
+	StateReason    githubv4.String
+	URL            githubv4.String
+	CreatedAt      githubv4.DateTime
+	UpdatedAt      githubv4.DateTime
+	ClosedAt       *githubv4.DateTime
+	Author         struct {
+		Login githubv4.String
+	}
+	Repository struct {
+		NameWithOwner githubv4.String
+	}
+	Labels struct {
+		Nodes []struct {
+			Name githubv4.String
+		}
+	} `graphql:"labels(first: 20)"`
+	Assignees struct {
+		Nodes []struct {
+			Login githubv4.String
+		}
+	} `graphql:"assignees(first: 10)"`
+}
+
+type subIssuesPageQuery struct {
+	Repository struct {
+		Issue struct {
+			SubIssues struct {
+				TotalCount githubv4.Int
+				Nodes      []subIssueNode
+				PageInfo   PageInfoFragment
+			} `graphql:"subIssues(first: $first, after: $after)"`
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetSubIssuesGraphQL lists the sub-issues of an issue through the GraphQL
+// subIssues connection, walking it with cursors rather than page numbers.
+// perPage may exceed the 100 items of a single connection page, up to
+// MaxSubIssuesPerPage; the connection is then read in several requests.
+func GetSubIssuesGraphQL(ctx context.Context, client *githubv4.Client, deps ToolDependencies, owner string, repo string, issueNumber int, perPage int, after string) (*mcp.CallToolResult, error) {
+	if perPage < 1 || perPage > MaxSubIssuesPerPage {
+		return utils.NewToolResultError(fmt.Sprintf("perPage must be between 1 and %d", MaxSubIssuesPerPage)), nil
+	}
+
+	cache, err := deps.GetRepoAccessCache(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
+	}
+	flags := deps.GetFlags(ctx)
+	if flags.LockdownMode && cache == nil {
+		return nil, fmt.Errorf("lockdown cache is not configured")
+	}
+
+	vars := map[string]any{
+		"owner":       githubv4.String(owner),
+		"repo":        githubv4.String(repo),
+		"issueNumber": githubv4.Int(int32(issueNumber)), // #nosec G115 - issue numbers are always small positive integers
+		"after":       (*githubv4.String)(nil),
+	}
+	if after != "" {
+		vars["after"] = githubv4.String(after)
+	}
+
+	response := MinimalSubIssuesResponse{SubIssues: []MinimalSubIssue{}}
+	read := 0
+	for firstPage := true; ; firstPage = false {
+		vars["first"] = githubv4.Int(int32(min(perPage-read, 100))) // #nosec G115 - bounded by 100
+
+		var query subIssuesPageQuery
+		if err := client.Query(ctx, &query, vars); err != nil {
+			if result := issueNotFoundResult(ctx, "failed to list sub-issues", err); result != nil {
+				return result, nil
+			}
+			return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list sub-issues", err), nil
+		}
+		connection := query.Repository.Issue.SubIssues
+
+		for _, node := range connection.Nodes {
+			if flags.LockdownMode {
+				login := string(node.Author.Login)
+				if login == "" {
+					continue
+				}
+				isSafeContent, err := cache.IsSafeContent(ctx, login, owner, repo)
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("failed to check lockdown mode: %v", err)), nil
+				}
+				if !isSafeContent {
+					continue
+				}
+			}
+			response.SubIssues = append(response.SubIssues, minimalSubIssue(node))
+		}
+		read += len(connection.Nodes)
+
+		if firstPage {
+			response.TotalCount = int(connection.TotalCount)
+			response.PageInfo.HasPreviousPage = connection.PageInfo.HasPreviousPage
+			response.PageInfo.StartCursor = string(connection.PageInfo.StartCursor)
+		}
+		response.PageInfo.HasNextPage = connection.PageInfo.HasNextPage
+		if connection.PageInfo.EndCursor != "" {
+			response.PageInfo.EndCursor = string(connection.PageInfo.EndCursor)
+		}
+
+		if !connection.PageInfo.HasNextPage || read >= perPage || len(connection.Nodes) == 0 {
+			break
+		}
+		vars["after"] = githubv4.String(connection.PageInfo.EndCursor)
+	}
+
+	return MarshalledTextResult(response), nil
+}
+
+func minimalSubIssue(node subIssueNode) MinimalSubIssue {
+	m := MinimalSubIssue{
+		Number:      int(node.Number),
+		Title:       sanitize.Sanitize(string(node.Title)),
+		State:       string(node.State),
+		StateReason: string(node.StateReason),
+		HTMLURL:     string(node.URL),
+		Repository:  string(node.Repository.NameWithOwner),
+		CreatedAt:   node.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   node.UpdatedAt.Format(time.RFC3339),
+	}
+	if id, err := strconv.ParseInt(string(node.FullDatabaseID), 10, 64); err == nil {
+		m.ID = id
+	}
+	if login := string(node.Author.Login); login != "" {
+		m.User = &MinimalUser{Login: login}
+	}
+	if node.ClosedAt != nil {
+		m.ClosedAt = node.ClosedAt.Format(time.RFC3339)
+	}
+	for _, label := range node.Labels.Nodes {
+		m.Labels = append(m.Labels, string(label.Name))
+	}
+	for _, assignee := range node.Assignees.Nodes {
+		m.Assignees = append(m.Assignees, string(assignee.Login))
+	}
+	owner, repo, _ := strings.Cut(m.Repository, "/")
+	nodeID, _ := node.ID.(string)
+	m.IssueRef = IssueRef{
+		Owner:  owner,
+		Repo:   repo,
+		Number: m.Number,
+		ID:     m.ID,
+		NodeID: nodeID,
+		URL:    m.HTMLURL,
+		Title:  m.Title,
+		State:  strings.ToLower(m.State),
+	}
+	return m
+}
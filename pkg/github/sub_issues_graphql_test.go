@@ -0,0 +1,176 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// subIssuesConnectionServer serves the subIssues connection of an issue with
+// total children, paging with "cursor-<n>" cursors, and records the
+// variables of each request.
+type subIssuesConnectionServer struct {
+	total    int
+	requests []map[string]any
+}
+
+func (s *subIssuesConnectionServer) handle(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Variables map[string]any `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.requests = append(s.requests, body.Variables)
+
+	start := 0
+	if after, ok := body.Variables["after"].(string); ok {
+		start, _ = strconv.Atoi(after[len("cursor-"):])
+	}
+	end := min(start+int(body.Variables["first"].(float64)), s.total)
+
+	nodes := make([]any, 0, end-start)
+	for i := start; i < end; i++ {
+		nodes = append(nodes, map[string]any{
+			"id":             fmt.Sprintf("I_%d", i+1),
+			"fullDatabaseId": strconv.Itoa(5000 + i),
+			"number":         i + 1,
+			"title":          fmt.Sprintf("Task %d", i+1),
+			"state":          "OPEN",
+			"stateReason":    "",
+			"url":            fmt.Sprintf("https://github.com/owner/repo/issues/%d", i+1),
+			"createdAt":      "2024-01-01T00:00:00Z",
+			"updatedAt":      "2024-01-02T00:00:00Z",
+			"closedAt":       nil,
+			"author":         map[string]any{"login": "octocat"},
+			"repository":     map[string]any{"nameWithOwner": "owner/repo"},
+			"labels":         map[string]any{"nodes": []any{map[string]any{"name": "task"}}},
+			"assignees":      map[string]any{"nodes": []any{}},
+		})
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"data": map[string]any{
+			"repository": map[string]any{
+				"issue": map[string]any{
+					"subIssues": map[string]any{
+						"totalCount": s.total,
+						"nodes":      nodes,
+						"pageInfo": map[string]any{
+							"hasNextPage":     end < s.total,
+							"hasPreviousPage": start > 0,
+							"startCursor":     fmt.Sprintf("cursor-%d", start+1),
+							"endCursor":       fmt.Sprintf("cursor-%d", end),
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func Test_IssueRead_GetSubIssuesGraphQL(t *testing.T) {
+	serverTool := IssueRead(translations.NullTranslationHelper)
+
+	call := func(t *testing.T, server *subIssuesConnectionServer, args map[string]any) (MinimalSubIssuesResponse, string) {
+		t.Helper()
+		deps := BaseDeps{
+			GQLClient: githubv4.NewClient(MockHTTPClientWithHandler(server.handle)),
+			Client:    mustNewGHClient(t, MockHTTPClientWithHandler(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNotFound) })),
+		}
+		handler := serverTool.Handler(deps)
+		args["method"] = "get_sub_issues"
+		args["owner"] = "owner"
+		args["repo"] = "repo"
+		args["issue_number"] = float64(42)
+		request := createMCPRequest(args)
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		if result.IsError {
+			return MinimalSubIssuesResponse{}, text
+		}
+		var response MinimalSubIssuesResponse
+		require.NoError(t, json.Unmarshal([]byte(text), &response))
+		return response, ""
+	}
+
+	t.Run("use_graphql returns trimmed sub-issues with cursors", func(t *testing.T) {
+		server := &subIssuesConnectionServer{total: 45}
+		response, errText := call(t, server, map[string]any{"use_graphql": true, "perPage": float64(20), "after": "cursor-20"})
+		require.Empty(t, errText)
+
+		require.Len(t, server.requests, 1)
+		assert.Equal(t, float64(20), server.requests[0]["first"])
+		assert.Equal(t, "cursor-20", server.requests[0]["after"])
+
+		require.Len(t, response.SubIssues, 20)
+		assert.Equal(t, MinimalSubIssue{
+			ID:         5020,
+			Number:     21,
+			Title:      "Task 21",
+			State:      "OPEN",
+			HTMLURL:    "https://github.com/owner/repo/issues/21",
+			Repository: "owner/repo",
+			User:       &MinimalUser{Login: "octocat"},
+			Labels:     []string{"task"},
+			CreatedAt:  "2024-01-01T00:00:00Z",
+			UpdatedAt:  "2024-01-02T00:00:00Z",
+			IssueRef: IssueRef{
+				Owner:  "owner",
+				Repo:   "repo",
+				Number: 21,
+				ID:     5020,
+				NodeID: "I_21",
+				URL:    "https://github.com/owner/repo/issues/21",
+				Title:  "Task 21",
+				State:  "open",
+			},
+		}, response.SubIssues[0])
+		assert.Equal(t, 45, response.TotalCount)
+		assert.Equal(t, MinimalPageInfo{HasNextPage: true, HasPreviousPage: true, StartCursor: "cursor-21", EndCursor: "cursor-40"}, response.PageInfo)
+	})
+
+	t.Run("perPage above 100 switches to GraphQL and reads several pages", func(t *testing.T) {
+		server := &subIssuesConnectionServer{total: 250}
+		response, errText := call(t, server, map[string]any{"perPage": float64(150)})
+		require.Empty(t, errText)
+
+		require.Len(t, server.requests, 2)
+		assert.Equal(t, float64(100), server.requests[0]["first"])
+		assert.Nil(t, server.requests[0]["after"])
+		assert.Equal(t, float64(50), server.requests[1]["first"])
+		assert.Equal(t, "cursor-100", server.requests[1]["after"])
+
+		require.Len(t, response.SubIssues, 150)
+		assert.Equal(t, 150, response.SubIssues[149].Number)
+		assert.Equal(t, 250, response.TotalCount)
+		assert.Equal(t, MinimalPageInfo{HasNextPage: true, StartCursor: "cursor-1", EndCursor: "cursor-150"}, response.PageInfo)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		server := &subIssuesConnectionServer{total: 120}
+		response, errText := call(t, server, map[string]any{"perPage": float64(500)})
+		require.Empty(t, errText)
+
+		require.Len(t, server.requests, 2)
+		assert.Len(t, response.SubIssues, 120)
+		assert.False(t, response.PageInfo.HasNextPage)
+		assert.Equal(t, "cursor-120", response.PageInfo.EndCursor)
+	})
+
+	t.Run("page numbers are rejected", func(t *testing.T) {
+		server := &subIssuesConnectionServer{total: 10}
+		_, errText := call(t, server, map[string]any{"use_graphql": true, "page": float64(2)})
+		assert.Equal(t, "page is not supported when listing sub-issues over GraphQL; use the after cursor instead", errText)
+		assert.Empty(t, server.requests)
+	})
+}
@@ -178,9 +178,12 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		GetMe(t),
 		GetTeams(t),
 		GetTeamMembers(t),
+		GetRateLimit(t),
+		RunGraphQLQuery(t),
 
 		// Repository tools
 		SearchRepositories(t),
+		GetRepository(t),
 		GetFileContents(t),
 		ListCommits(t),
 		SearchCode(t),
@@ -210,12 +213,35 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		// Issue tools
 		IssueRead(t),
 		SearchIssues(t),
+		SearchIssuesMultiRepo(t),
 		ListIssues(t),
+		ListRepoIssueComments(t),
+		ExportIssues(t),
+		ListStaleIssues(t),
+		GetCommentByURL(t),
+		LockIssue(t),
+		UnlockIssue(t),
+		GetEpicProgress(t),
+		PostEpicStatusComment(t),
+		GetIssueOverview(t),
+		GetIssueEditHistory(t),
+		GetIssueLinkedPullRequests(t),
+		ListIssueParticipants(t),
+		GetTriageDigest(t),
 		ListIssueTypes(t),
+		SetIssueType(t),
 		ListIssueFields(t),
+		ListIssueTemplates(t),
 		IssueWrite(t),
+		CreateIssueFromTemplate(t),
+		AssignIssueToMe(t),
+		ListMyIssues(t),
+		AddLabelsToIssuesBulk(t),
+		CloseIssuesByMilestone(t),
 		AddIssueComment(t),
 		SubIssueWrite(t),
+		ReorderSubIssues(t),
+		GetIssueBlockers(t),
 		IssueDependencyRead(t),
 		IssueDependencyWrite(t),
 
@@ -263,6 +289,7 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		MarkAllNotificationsRead(t),
 		ManageNotificationSubscription(t),
 		ManageRepositoryNotificationSubscription(t),
+		SetIssueSubscription(t),
 
 		// Discussion tools
 		ListDiscussions(t),
@@ -293,12 +320,15 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		ProjectsList(t),
 		ProjectsGet(t),
 		ProjectsWrite(t),
+		ListIssueProjects(t),
 
 		// Label tools
 		GetLabel(t),
 		GetLabelForLabelsToolset(t),
 		ListLabels(t),
+		ListLabelsOnIssue(t),
 		LabelWrite(t),
+		SuggestLabels(t),
 
 		// UI tools (insiders only)
 		UIGet(t),
@@ -315,6 +345,7 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		GranularAddSubIssue(t),
 		GranularRemoveSubIssue(t),
 		GranularReprioritizeSubIssue(t),
+		GranularMoveSubIssue(t),
 		GranularSetIssueFields(t),
 		GranularAddIssueReaction(t),
 		GranularAddIssueCommentReaction(t),
@@ -12,7 +12,7 @@ func generateContextToolsetInstructions(_ *inventory.Inventory) string {
 func generateIssuesToolsetInstructions(_ *inventory.Inventory) string {
 	return `## Issues
 
-Check 'list_issue_types' first for organizations to use proper issue types. Use 'search_issues' before creating new issues to avoid duplicates. Always set 'state_reason' when closing issues.`
+Check 'list_issue_types' first for organizations to use proper issue types. Use 'search_issues' before creating new issues to avoid duplicates. Always set 'state_reason' when closing issues. GitHub API failures, such as a missing issue or insufficient permissions, are returned as tool results with isError set and the API's message, not as protocol errors.`
 }
 
 func generatePullRequestsToolsetInstructions(inv *inventory.Inventory) string {
@@ -45,6 +45,8 @@ Iteration fields: Use create_iteration_field to add a new ITERATION field (e.g.
 
 Status updates: Use list_project_status_updates to read recent project status updates (newest first). Use get_project_status_update with a node ID to get a single update. Use create_project_status_update to create a new status update for a project.
 
+Workflows: Use list_project_workflows to check which built-in automations (e.g. "Item closed" setting Status to Done) are enabled. Workflows are configured in the project settings and cannot be changed through these tools.
+
 Field usage:
 	- Call list_project_fields first to understand available fields and get IDs/types before filtering.
 	- Use EXACT returned field names (case-insensitive match). Don't invent names or IDs.
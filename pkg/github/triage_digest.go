@@ -0,0 +1,325 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TriageDigestSectionSize is the number of issues listed in each section of
+// get_triage_digest; the total count covers all matching issues.
+const TriageDigestSectionSize = 10
+
+const (
+	defaultTriageDigestSince     = "7 days ago"
+	defaultTriageLabel           = "needs-triage"
+	defaultHighPriorityLabel     = "high-priority"
+	defaultTriageDigestStaleDays = 14
+)
+
+// TriageDigestIssue is an issue listed in a get_triage_digest section.
+type TriageDigestIssue struct {
+	Number          int      `json:"number"`
+	Title           string   `json:"title"`
+	HTMLURL         string   `json:"html_url"`
+	Reactions       int      `json:"reactions"`
+	Comments        int      `json:"comments"`
+	CreatedAt       string   `json:"created_at,omitempty"`
+	UpdatedAt       string   `json:"updated_at,omitempty"`
+	DaysSinceUpdate int      `json:"days_since_update,omitempty"`
+	Labels          []string `json:"labels,omitempty"`
+	Assignees       []string `json:"assignees,omitempty"`
+}
+
+// TriageDigestSection is the total number of issues matching one digest
+// query and the first TriageDigestSectionSize of them.
+type TriageDigestSection struct {
+	TotalCount int                 `json:"total_count"`
+	Issues     []TriageDigestIssue `json:"issues"`
+}
+
+// TriageDigest is the output type for get_triage_digest.
+type TriageDigest struct {
+	Repository  string `json:"repository"`
+	Since       string `json:"since"`
+	StaleCutoff string `json:"stale_cutoff"`
+	// Opened lists the issues opened since Since with the most reactions first.
+	Opened            TriageDigestSection `json:"opened"`
+	Closed            TriageDigestSection `json:"closed"`
+	NeedsTriage       TriageDigestSection `json:"needs_triage"`
+	StaleHighPriority TriageDigestSection `json:"stale_high_priority"`
+}
+
+// GetTriageDigest creates a tool that aggregates a repository's issue activity
+// for a triage report.
+func GetTriageDigest(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "get_triage_digest",
+			Description: t("TOOL_GET_TRIAGE_DIGEST_DESCRIPTION", fmt.Sprintf("Summarize a repository's issue activity for triage: issues opened since a date (most reactions first), issues closed since then, open issues awaiting triage with no assignee, and open high-priority issues that have gone stale. Each section has a total count and up to %d issues. Uses four search requests.", TriageDigestSectionSize)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_TRIAGE_DIGEST_USER_TITLE", "Get triage digest"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"since": {
+						Type:        "string",
+						Description: "Start of the digest period: an ISO 8601 timestamp (YYYY-MM-DDTHH:MM:SSZ or YYYY-MM-DD) or a relative expression such as '7 days ago'",
+						Default:     json.RawMessage(`"` + defaultTriageDigestSince + `"`),
+					},
+					"triage_label": {
+						Type:        "string",
+						Description: "Label marking issues that await triage",
+						Default:     json.RawMessage(`"` + defaultTriageLabel + `"`),
+					},
+					"priority_label": {
+						Type:        "string",
+						Description: "Label marking high-priority issues",
+						Default:     json.RawMessage(`"` + defaultHighPriorityLabel + `"`),
+					},
+					"stale_days": {
+						Type:        "number",
+						Description: "High-priority issues not updated in at least this many days are reported as stale",
+						Default:     json.RawMessage(fmt.Sprint(defaultTriageDigestStaleDays)),
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sinceArg, err := OptionalParam[string](args, "since")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if sinceArg == "" {
+				sinceArg = defaultTriageDigestSince
+			}
+			since, err := parseFlexibleTimestamp(sinceArg)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("invalid since: %v", err)), nil, nil
+			}
+			triageLabel, err := OptionalParam[string](args, "triage_label")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if triageLabel == "" {
+				triageLabel = defaultTriageLabel
+			}
+			priorityLabel, err := OptionalParam[string](args, "priority_label")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if priorityLabel == "" {
+				priorityLabel = defaultHighPriorityLabel
+			}
+			staleDays, err := OptionalIntParamWithDefault(args, "stale_days", defaultTriageDigestStaleDays)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if staleDays < 1 {
+				return utils.NewToolResultError("stale_days must be at least 1"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			now := time.Now().UTC()
+			sinceQuery := since.UTC().Format(time.RFC3339)
+			cutoff := now.AddDate(0, 0, -staleDays).Format(isoDateLayout)
+			repoQuery := fmt.Sprintf("repo:%s/%s is:issue", owner, repo)
+
+			digest := TriageDigest{
+				Repository:  owner + "/" + repo,
+				Since:       sinceQuery,
+				StaleCutoff: cutoff,
+			}
+			queries := []struct {
+				query   string
+				sort    string
+				order   string
+				section *TriageDigestSection
+			}{
+				{fmt.Sprintf("%s created:>=%s", repoQuery, sinceQuery), "reactions", "desc", &digest.Opened},
+				{fmt.Sprintf("%s is:closed closed:>=%s", repoQuery, sinceQuery), "updated", "desc", &digest.Closed},
+				{fmt.Sprintf("%s is:open label:%q no:assignee", repoQuery, triageLabel), "created", "asc", &digest.NeedsTriage},
+				{fmt.Sprintf("%s is:open label:%q updated:<=%s", repoQuery, priorityLabel, cutoff), "updated", "asc", &digest.StaleHighPriority},
+			}
+			for _, q := range queries {
+				section, errResult, err := searchTriageDigestSection(ctx, client, q.query, q.sort, q.order, now)
+				if errResult != nil || err != nil {
+					return errResult, nil, err
+				}
+				*q.section = section
+			}
+
+			callResult := MarshalledTextResult(digest)
+			callResult = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, callResult, ifc.LabelListIssues)
+			return callResult, nil, nil
+		})
+}
+
+// searchTriageDigestSection runs one get_triage_digest search. On failure it
+// returns the tool error result to surface.
+func searchTriageDigestSection(ctx context.Context, client *github.Client, query, sort, order string, now time.Time) (TriageDigestSection, *mcp.CallToolResult, error) {
+	result, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{
+		Sort:        sort,
+		Order:       order,
+		ListOptions: github.ListOptions{PerPage: TriageDigestSectionSize},
+	})
+	if err != nil {
+		return TriageDigestSection{}, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to search issues for triage digest", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return TriageDigestSection{}, nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return TriageDigestSection{}, ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to search issues for triage digest", resp, body), nil
+	}
+
+	section := TriageDigestSection{
+		TotalCount: result.GetTotal(),
+		Issues:     make([]TriageDigestIssue, 0, len(result.Issues)),
+	}
+	for _, issue := range result.Issues {
+		if issue == nil {
+			continue
+		}
+		m := convertToMinimalIssue(issue)
+		entry := TriageDigestIssue{
+			Number:    m.Number,
+			Title:     sanitize.Sanitize(m.Title),
+			HTMLURL:   m.HTMLURL,
+			Comments:  m.Comments,
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+			Labels:    labelNames(m.Labels),
+			Assignees: m.Assignees,
+		}
+		if m.Reactions != nil {
+			entry.Reactions = m.Reactions.TotalCount
+		}
+		if issue.UpdatedAt != nil {
+			entry.DaysSinceUpdate = int(now.Sub(issue.UpdatedAt.Time).Hours() / 24)
+		}
+		section.Issues = append(section.Issues, entry)
+	}
+	return section, nil, nil
+}
+
+// TriageDigestPrompt creates a prompt that walks the model through writing a
+// weekly triage report from get_triage_digest.
+func TriageDigestPrompt(t translations.TranslationHelperFunc) inventory.ServerPrompt {
+	return inventory.NewServerPrompt(
+		ToolsetMetadataIssues,
+		mcp.Prompt{
+			Name:        "TriageDigest",
+			Description: t("PROMPT_TRIAGE_DIGEST_DESCRIPTION", "Produce a weekly triage report for a GitHub repository."),
+			Arguments: []*mcp.PromptArgument{
+				{
+					Name:        "repo",
+					Description: "The repository to report on (owner/repo).",
+					Required:    true,
+				},
+				{
+					Name:        "since",
+					Description: "Start of the reporting period, e.g. 2024-06-01 or '7 days ago' (default: 7 days ago).",
+					Required:    false,
+				},
+			},
+		},
+		func(_ context.Context, request *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			repo := request.Params.Arguments["repo"]
+			since := request.Params.Arguments["since"]
+			if since == "" {
+				since = defaultTriageDigestSince
+			}
+			owner, name, ok := strings.Cut(repo, "/")
+			if !ok || owner == "" || name == "" {
+				return nil, fmt.Errorf("repo must be in the form owner/repo, got %q", repo)
+			}
+
+			messages := []*mcp.PromptMessage{
+				{
+					Role: "user",
+					Content: &mcp.TextContent{
+						Text: "You are a triage assistant for a GitHub repository. Your task is to write a concise weekly triage report. Gather the data with a single call to the `get_triage_digest` tool; only use `issue_read` if you need more detail on a specific issue. Do not modify any issues.",
+					},
+				},
+				{
+					Role: "user",
+					Content: &mcp.TextContent{
+						Text: fmt.Sprintf("Please call `get_triage_digest` with owner %q, repo %q and since %q.", owner, name, since),
+					},
+				},
+				{
+					Role: "assistant",
+					Content: &mcp.TextContent{
+						Text: fmt.Sprintf("Sure! I will fetch the triage digest for %s since %s.", repo, since),
+					},
+				},
+				{
+					Role: "user",
+					Content: &mcp.TextContent{
+						Text: "Write the report in Markdown with these sections, linking every issue you mention by its `html_url`:\n" +
+							"1. **Summary**: `opened.total_count` issues opened and `closed.total_count` closed during the period, and the net change.\n" +
+							"2. **Most active new issues**: the issues in `opened.issues`, which are ordered by `reactions`, with one line each on what they are about.\n" +
+							"3. **Needs triage**: the `needs_triage.total_count` open, unassigned issues awaiting triage; for those listed in `needs_triage.issues`, suggest labels or an owner where the title makes it clear.\n" +
+							"4. **Stale high priority**: the `stale_high_priority.total_count` high-priority issues not updated since `stale_cutoff`, oldest first with `days_since_update`.\n" +
+							"5. **Recommendations**: at most three concrete follow-ups for the team.\n" +
+							"If a section has no issues, say so in one line. When a total count exceeds the issues listed, mention how many more there are.",
+					},
+				},
+				{
+					Role: "assistant",
+					Content: &mcp.TextContent{
+						Text: "Understood. I will build each section from the digest fields and keep the report short and actionable.",
+					},
+				},
+			}
+			return &mcp.GetPromptResult{
+				Messages: messages,
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,149 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetTriageDigest(t *testing.T) {
+	serverTool := GetTriageDigest(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_triage_digest", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo"})
+
+	var calls atomic.Int32
+	var queries []string
+	searchHandler := func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		q := r.URL.Query().Get("q")
+		queries = append(queries, q)
+		assert.Equal(t, "10", r.URL.Query().Get("per_page"))
+
+		result := &github.IssuesSearchResult{Total: github.Ptr(0), Issues: []*github.Issue{}}
+		switch {
+		case strings.Contains(q, "created:>="):
+			assert.Equal(t, "reactions", r.URL.Query().Get("sort"))
+			result = &github.IssuesSearchResult{
+				Total: github.Ptr(12),
+				Issues: []*github.Issue{
+					{
+						Number:    github.Ptr(7),
+						Title:     github.Ptr("Crash on start"),
+						HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/7"),
+						Reactions: &github.Reactions{TotalCount: github.Ptr(9)},
+						Comments:  github.Ptr(3),
+					},
+				},
+			}
+		case strings.Contains(q, "is:closed"):
+			result = &github.IssuesSearchResult{Total: github.Ptr(5), Issues: []*github.Issue{}}
+		case strings.Contains(q, `label:"needs-triage"`):
+			result = &github.IssuesSearchResult{
+				Total: github.Ptr(1),
+				Issues: []*github.Issue{
+					{Number: github.Ptr(8), Title: github.Ptr("Docs typo"), HTMLURL: github.Ptr("https://github.com/owner/repo/issues/8")},
+				},
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(result)
+	}
+
+	deps := BaseDeps{
+		Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetSearchIssues: searchHandler,
+		})),
+	}
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"since": "2024-06-01",
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	// The digest must stay within its budget of five API calls.
+	assert.LessOrEqual(t, int(calls.Load()), 5)
+	require.Len(t, queries, 4)
+	assert.Equal(t, []string{
+		"repo:owner/repo is:issue created:>=2024-06-01T00:00:00Z",
+		"repo:owner/repo is:issue is:closed closed:>=2024-06-01T00:00:00Z",
+		`repo:owner/repo is:issue is:open label:"needs-triage" no:assignee`,
+	}, queries[:3])
+	assert.Contains(t, queries[3], `repo:owner/repo is:issue is:open label:"high-priority" updated:<=`)
+
+	var digest TriageDigest
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &digest))
+	assert.Equal(t, "owner/repo", digest.Repository)
+	assert.Equal(t, "2024-06-01T00:00:00Z", digest.Since)
+	assert.Equal(t, 12, digest.Opened.TotalCount)
+	require.Len(t, digest.Opened.Issues, 1)
+	assert.Equal(t, 9, digest.Opened.Issues[0].Reactions)
+	assert.Equal(t, 5, digest.Closed.TotalCount)
+	assert.Empty(t, digest.Closed.Issues)
+	assert.Equal(t, 1, digest.NeedsTriage.TotalCount)
+	assert.Equal(t, 0, digest.StaleHighPriority.TotalCount)
+}
+
+func Test_GetTriageDigest_SearchFailure(t *testing.T) {
+	var calls atomic.Int32
+	deps := BaseDeps{
+		Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetSearchIssues: func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+			},
+		})),
+	}
+	serverTool := GetTriageDigest(translations.NullTranslationHelper)
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getErrorResult(t, result).Text, "failed to search issues for triage digest")
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func Test_TriageDigestPrompt(t *testing.T) {
+	prompt := TriageDigestPrompt(translations.NullTranslationHelper)
+	assert.Equal(t, "TriageDigest", prompt.Prompt.Name)
+
+	result, err := prompt.Handler(context.Background(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{Arguments: map[string]string{"repo": "octo-org/api"}},
+	})
+	require.NoError(t, err)
+
+	var text strings.Builder
+	for _, msg := range result.Messages {
+		text.WriteString(msg.Content.(*mcp.TextContent).Text)
+		text.WriteString("\n")
+	}
+	assert.Contains(t, text.String(), "`get_triage_digest` with owner \"octo-org\", repo \"api\" and since \"7 days ago\"")
+	for _, field := range []string{"opened.total_count", "closed.total_count", "needs_triage.issues", "stale_high_priority.total_count", "stale_cutoff"} {
+		assert.Contains(t, text.String(), field)
+	}
+
+	_, err = prompt.Handler(context.Background(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{Arguments: map[string]string{"repo": "api"}},
+	})
+	assert.Error(t, err)
+}
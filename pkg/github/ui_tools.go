@@ -7,7 +7,6 @@ import (
 	"io"
 	"net/http"
 	"sort"
-	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/inventory"
@@ -523,7 +522,7 @@ func uiGetReviewers(ctx context.Context, deps ToolDependencies, args map[string]
 	users := make([]map[string]string, 0, len(allCollaborators))
 	for _, user := range allCollaborators {
 		login := user.GetLogin()
-		if user.GetType() == "Bot" || strings.HasSuffix(login, "[bot]") {
+		if isBotUser(user) {
 			continue
 		}
 		users = append(users, map[string]string{
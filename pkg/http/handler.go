@@ -203,6 +203,8 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Version:           h.config.Version,
 		Translator:        h.t,
 		ContentWindowSize: h.config.ContentWindowSize,
+		DefaultPageSize:   h.config.DefaultPageSize,
+		MaxResponseBytes:  h.config.MaxResponseBytes,
 		Logger:            h.logger,
 		RepoAccessTTL:     h.config.RepoAccessCacheTTL,
 		// Explicitly set empty capabilities. inv.ForMCPRequest currently returns nothing for Initialize.
@@ -69,6 +69,21 @@ type ServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// DefaultPageSize overrides the default page size of list tools when the
+	// caller omits perPage. Zero keeps each tool's own default.
+	DefaultPageSize int
+
+	// MaxResponseBytes caps the serialized size of large list and search
+	// results; bigger results are replaced by a notice suggesting pagination.
+	// Zero uses DefaultMaxResponseBytes.
+	MaxResponseBytes int
+
+	// AllowedRepos and DeniedRepos restrict the repositories tools may operate
+	// on, as owner/repo patterns where either part may be a glob (e.g.
+	// octo-org/*). Empty lists permit every repository the token can reach.
+	AllowedRepos []string
+	DeniedRepos  []string
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
@@ -137,6 +152,11 @@ func RunHTTPServer(cfg ServerConfig) error {
 		repoAccessOpts = append(repoAccessOpts, lockdown.WithTTL(*cfg.RepoAccessCacheTTL))
 	}
 
+	repoPolicy, err := github.NewRepoPolicy(cfg.AllowedRepos, cfg.DeniedRepos)
+	if err != nil {
+		return err
+	}
+
 	featureChecker := createHTTPFeatureChecker(cfg.EnabledFeatures, cfg.InsidersMode)
 
 	obs, err := observability.NewExporters(logger, metrics.NewNoopMetrics())
@@ -151,6 +171,9 @@ func RunHTTPServer(cfg ServerConfig) error {
 		repoAccessOpts,
 		t,
 		cfg.ContentWindowSize,
+		cfg.DefaultPageSize,
+		cfg.MaxResponseBytes,
+		repoPolicy,
 		featureChecker,
 		obs,
 	)
@@ -0,0 +1,42 @@
+package transport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+)
+
+// GraphQLErrorsTransport is an http.RoundTripper that records the errors
+// array of GraphQL responses for requests made with a context set up by
+// errors.ContextWithVerboseErrors. The GraphQL client keeps only the message
+// and locations of each error; the recorded copy lets verbose tool errors
+// also report the error path and extensions. Other requests pass through
+// untouched.
+type GraphQLErrorsTransport struct {
+	// Transport is the underlying HTTP transport. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *GraphQLErrorsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil || !ghErrors.VerboseErrorsEnabled(req.Context()) {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	ghErrors.RecordGraphQLErrorBody(req.Context(), body)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
@@ -0,0 +1,66 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLErrorsTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":null,"errors":[{"type":"NOT_FOUND","path":["repository"],"locations":[{"line":1,"column":2}],"extensions":{"code":"undefinedField"},"message":"Could not resolve to a Repository with the name 'octo/missing'."}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := githubv4.NewEnterpriseClient(server.URL, &http.Client{
+		Transport: &GraphQLErrorsTransport{Transport: http.DefaultTransport},
+	})
+
+	query := func(ctx context.Context) ghErrors.GraphQLErrorDetail {
+		t.Helper()
+		var q struct {
+			Viewer struct {
+				Login githubv4.String
+			}
+		}
+		err := client.Query(ctx, &q, nil)
+		require.Error(t, err)
+
+		result := ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get repository", err)
+		require.True(t, result.IsError)
+		var payload ghErrors.GraphQLErrorPayload
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &payload))
+		require.Len(t, payload.Errors, 1)
+		return payload.Errors[0]
+	}
+
+	t.Run("concise by default", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, ghErrors.GraphQLErrorDetail{
+			Type:    ghErrors.GraphQLErrorTypeNotFound,
+			Message: "Could not resolve to a Repository with the name 'octo/missing'.",
+		}, query(context.Background()))
+	})
+
+	t.Run("verbose errors include path, locations and extensions", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, ghErrors.GraphQLErrorDetail{
+			Type:       ghErrors.GraphQLErrorTypeNotFound,
+			Message:    "Could not resolve to a Repository with the name 'octo/missing'.",
+			Path:       []any{"repository"},
+			Locations:  []ghErrors.GraphQLErrorLocation{{Line: 1, Column: 2}},
+			Extensions: map[string]any{"code": "undefinedField"},
+		}, query(ghErrors.ContextWithVerboseErrors(context.Background())))
+	})
+}
@@ -0,0 +1,83 @@
+package transport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+)
+
+// GraphQLRateLimitTransport is an http.RoundTripper that turns GitHub GraphQL
+// rate limit responses into a *errors.GraphQLRateLimitError. The GraphQL
+// client discards response headers, so without this transport the
+// Retry-After and X-RateLimit-Reset values are lost before the error reaches
+// the tool handler.
+type GraphQLRateLimitTransport struct {
+	// Transport is the underlying HTTP transport. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *GraphQLRateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	retryAfter := ghErrors.ParseRetryAfter(resp.Header)
+	exhausted := resp.Header.Get("X-RateLimit-Remaining") == "0"
+	switch {
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+		if retryAfter == 0 && !exhausted {
+			return resp, nil
+		}
+	case resp.StatusCode == http.StatusOK && exhausted:
+		// GitHub answers a primary rate limit with 200 and a RATE_LIMITED
+		// error; the last request allowed in a window also has zero
+		// remaining, so only the body can tell them apart.
+	default:
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	details := ghErrors.ParseGraphQLErrorBody(body)
+	if resp.StatusCode == http.StatusOK && !hasRateLimitedError(details) {
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return resp, nil
+	}
+
+	rateLimitErr := &ghErrors.GraphQLRateLimitError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: retryAfter,
+		Errors:     details,
+	}
+	for i := range rateLimitErr.Errors {
+		rateLimitErr.Errors[i].Type = ghErrors.GraphQLErrorTypeRateLimited
+	}
+	if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		rateLimitErr.ResetAt = time.Unix(reset, 0)
+	}
+	return nil, rateLimitErr
+}
+
+func hasRateLimitedError(details []ghErrors.GraphQLErrorDetail) bool {
+	for _, d := range details {
+		if d.Type == ghErrors.GraphQLErrorTypeRateLimited {
+			return true
+		}
+	}
+	return false
+}
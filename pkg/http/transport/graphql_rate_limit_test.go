@@ -0,0 +1,100 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/shurcooL/githubv4"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLRateLimitTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		status          int
+		headers         map[string]string
+		body            string
+		expectRateLimit bool
+		expectedRetry   time.Duration
+		expectedResetAt time.Time
+	}{
+		{
+			name:            "403 with Retry-After",
+			status:          http.StatusForbidden,
+			headers:         map[string]string{"Retry-After": "45"},
+			body:            `{"message":"You have exceeded a secondary rate limit."}`,
+			expectRateLimit: true,
+			expectedRetry:   45 * time.Second,
+		},
+		{
+			name:            "200 with RATE_LIMITED error",
+			status:          http.StatusOK,
+			headers:         map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1700000000"},
+			body:            `{"errors":[{"type":"RATE_LIMITED","message":"API rate limit exceeded for user ID 1."}]}`,
+			expectRateLimit: true,
+			expectedResetAt: time.Unix(1700000000, 0),
+		},
+		{
+			name:    "last request in the window succeeds",
+			status:  http.StatusOK,
+			headers: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1700000000"},
+			body:    `{"data":{"viewer":{"login":"octocat"}}}`,
+		},
+		{
+			name:   "403 without rate limit headers",
+			status: http.StatusForbidden,
+			body:   `{"message":"Resource not accessible by integration"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				for k, v := range tc.headers {
+					w.Header().Set(k, v)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, &http.Client{
+				Transport: &GraphQLRateLimitTransport{Transport: http.DefaultTransport},
+			})
+			var q struct {
+				Viewer struct {
+					Login githubv4.String
+				}
+			}
+			err := client.Query(context.Background(), &q, nil)
+
+			var rateLimitErr *ghErrors.GraphQLRateLimitError
+			if !tc.expectRateLimit {
+				assert.False(t, errors.As(err, &rateLimitErr))
+				if tc.status == http.StatusOK {
+					require.NoError(t, err)
+					assert.Equal(t, "octocat", string(q.Viewer.Login))
+				}
+				return
+			}
+
+			require.ErrorAs(t, err, &rateLimitErr)
+			assert.Equal(t, tc.status, rateLimitErr.StatusCode)
+			assert.Equal(t, tc.expectedRetry, rateLimitErr.RetryAfter)
+			assert.True(t, tc.expectedResetAt.Equal(rateLimitErr.ResetAt))
+			require.NotEmpty(t, rateLimitErr.Errors)
+			assert.Equal(t, ghErrors.GraphQLErrorTypeRateLimited, rateLimitErr.Errors[0].Type)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package utils //nolint:revive //TODO: figure out a better name for this package
+
+// BatchStatus is the outcome of one entry of a batch tool call.
+type BatchStatus string
+
+const (
+	// BatchSucceeded marks an entry whose operation was applied.
+	BatchSucceeded BatchStatus = "succeeded"
+	// BatchFailed marks an entry whose operation was attempted and failed.
+	BatchFailed BatchStatus = "failed"
+	// BatchSkipped marks an entry that was deliberately not attempted, such
+	// as a duplicate of an earlier entry.
+	BatchSkipped BatchStatus = "skipped"
+)
+
+// BatchEntry is the per-entry result of a batch tool call. Each entry reports
+// its own outcome so the summary counts always agree with the entries.
+type BatchEntry interface {
+	BatchStatus() BatchStatus
+}
+
+// BatchResult is the response of a tool that applies one operation to many
+// entries, such as labeling several issues at once. It leads with counts of
+// the entries that succeeded, failed and were skipped, followed by each
+// entry's result in request order. Tools that need extra fields embed it.
+type BatchResult[T BatchEntry] struct {
+	Total     int `json:"total"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Skipped   int `json:"skipped"`
+	Results   []T `json:"results"`
+}
+
+// NewBatchResult returns an empty BatchResult with room for size entries.
+func NewBatchResult[T BatchEntry](size int) *BatchResult[T] {
+	return &BatchResult[T]{Results: make([]T, 0, size)}
+}
+
+// Add appends an entry and counts it under its outcome. Entries with an
+// unknown outcome are counted as failed rather than silently dropped from
+// the summary.
+func (b *BatchResult[T]) Add(entry T) {
+	b.Results = append(b.Results, entry)
+	b.Total++
+	switch entry.BatchStatus() {
+	case BatchSucceeded:
+		b.Succeeded++
+	case BatchSkipped:
+		b.Skipped++
+	default:
+		b.Failed++
+	}
+}
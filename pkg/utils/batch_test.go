@@ -0,0 +1,77 @@
+package utils //nolint:revive //TODO: figure out a better name for this package
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBatchEntry struct {
+	ID     int         `json:"id"`
+	Status BatchStatus `json:"status"`
+}
+
+func (e testBatchEntry) BatchStatus() BatchStatus { return e.Status }
+
+func TestBatchResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []testBatchEntry
+		want    BatchResult[testBatchEntry]
+	}{
+		{
+			name: "empty batch",
+			want: BatchResult[testBatchEntry]{Results: []testBatchEntry{}},
+		},
+		{
+			name: "mixed outcomes",
+			entries: []testBatchEntry{
+				{ID: 1, Status: BatchSucceeded},
+				{ID: 2, Status: BatchFailed},
+				{ID: 3, Status: BatchSkipped},
+				{ID: 4, Status: BatchSucceeded},
+			},
+			want: BatchResult[testBatchEntry]{Total: 4, Succeeded: 2, Failed: 1, Skipped: 1},
+		},
+		{
+			name:    "unknown outcome counts as failed",
+			entries: []testBatchEntry{{ID: 1}},
+			want:    BatchResult[testBatchEntry]{Total: 1, Failed: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			batch := NewBatchResult[testBatchEntry](len(tc.entries))
+			for _, entry := range tc.entries {
+				batch.Add(entry)
+			}
+
+			assert.Equal(t, tc.want.Total, batch.Total)
+			assert.Equal(t, tc.want.Succeeded, batch.Succeeded)
+			assert.Equal(t, tc.want.Failed, batch.Failed)
+			assert.Equal(t, tc.want.Skipped, batch.Skipped)
+			assert.Equal(t, batch.Total, batch.Succeeded+batch.Failed+batch.Skipped)
+			assert.Equal(t, len(tc.entries), len(batch.Results))
+			for i, entry := range tc.entries {
+				assert.Equal(t, entry, batch.Results[i])
+			}
+		})
+	}
+}
+
+func TestBatchResult_JSON(t *testing.T) {
+	batch := NewBatchResult[testBatchEntry](0)
+	batch.Add(testBatchEntry{ID: 1, Status: BatchSucceeded})
+
+	out, err := json.Marshal(batch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"total":1,"succeeded":1,"failed":0,"skipped":0,"results":[{"id":1,"status":"succeeded"}]}`, string(out))
+
+	// An empty batch still reports an empty list of results, not null.
+	out, err = json.Marshal(NewBatchResult[testBatchEntry](0))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"total":0,"succeeded":0,"failed":0,"skipped":0,"results":[]}`, string(out))
+}